@@ -295,9 +295,21 @@ func (c *Client) CreateAPIKey(description string) (*APIKey, error) {
 
 // CreateAPIKeyContext creates a new API key for the authenticated user.
 func (c *Client) CreateAPIKeyContext(ctx context.Context, description string) (*APIKey, error) {
-	body, err := c.request.Post(ctx, "/v1/api-keys", &APIKeyCreationRequest{
+	return c.CreateScopedAPIKeyContext(ctx, &APIKeyCreationRequest{
 		Description: description,
 	})
+}
+
+// CreateScopedAPIKey creates a new API key with scopes and an optional expiration date.
+func (c *Client) CreateScopedAPIKey(apiKeyCreationRequest *APIKeyCreationRequest) (*APIKey, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreateScopedAPIKeyContext(ctx, apiKeyCreationRequest)
+}
+
+// CreateScopedAPIKeyContext creates a new API key with scopes and an optional expiration date.
+func (c *Client) CreateScopedAPIKeyContext(ctx context.Context, apiKeyCreationRequest *APIKeyCreationRequest) (*APIKey, error) {
+	body, err := c.request.Post(ctx, "/v1/api-keys", apiKeyCreationRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -323,6 +335,58 @@ func (c *Client) DeleteAPIKeyContext(ctx context.Context, apiKeyID int64) error
 	return c.request.Delete(ctx, fmt.Sprintf("/v1/api-keys/%d", apiKeyID))
 }
 
+// AuditLog fetches the audit log entries matching the filter, the most recent first (admin only).
+func (c *Client) AuditLog(filter *AuditLogFilter) (*AuditLogResultSet, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.AuditLogContext(ctx, filter)
+}
+
+// AuditLogContext fetches the audit log entries matching the filter, the most recent first (admin only).
+func (c *Client) AuditLogContext(ctx context.Context, filter *AuditLogFilter) (*AuditLogResultSet, error) {
+	path := "/v1/audit-log"
+	if filter != nil {
+		values := url.Values{}
+
+		if filter.UserID > 0 {
+			values.Set("user_id", strconv.FormatInt(filter.UserID, 10))
+		}
+
+		if filter.Action != "" {
+			values.Set("action", filter.Action)
+		}
+
+		if filter.Search != "" {
+			values.Set("search", filter.Search)
+		}
+
+		if filter.Limit > 0 {
+			values.Set("limit", strconv.Itoa(filter.Limit))
+		}
+
+		if filter.Offset > 0 {
+			values.Set("offset", strconv.Itoa(filter.Offset))
+		}
+
+		if len(values) > 0 {
+			path = path + "?" + values.Encode()
+		}
+	}
+
+	body, err := c.request.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result AuditLogResultSet
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
 // MarkAllAsRead marks all unread entries as read for a given user.
 func (c *Client) MarkAllAsRead(userID int64) error {
 	ctx, cancel := withDefaultTimeout()
@@ -526,7 +590,23 @@ func (c *Client) UpdateCategoryWithOptionsContext(ctx context.Context, categoryI
 	return category, nil
 }
 
-// MarkCategoryAsRead marks all unread entries in a category as read.
+// MoveCategory moves a category and its subcategories under another category.
+// A zero parentID moves the category to the top level.
+func (c *Client) MoveCategory(categoryID, parentID int64) (*Category, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.MoveCategoryContext(ctx, categoryID, parentID)
+}
+
+// MoveCategoryContext moves a category and its subcategories under another category.
+// A zero parentID moves the category to the top level.
+func (c *Client) MoveCategoryContext(ctx context.Context, categoryID, parentID int64) (*Category, error) {
+	return c.UpdateCategoryWithOptionsContext(ctx, categoryID, &CategoryModificationRequest{
+		ParentID: new(parentID),
+	})
+}
+
+// MarkCategoryAsRead marks all unread entries in a category as read, including the entries of its subcategories.
 func (c *Client) MarkCategoryAsRead(categoryID int64) error {
 	ctx, cancel := withDefaultTimeout()
 	defer cancel()
@@ -587,6 +667,418 @@ func (c *Client) RefreshCategoryContext(ctx context.Context, categoryID int64) e
 	return err
 }
 
+// Labels retrieves the list of labels.
+func (c *Client) Labels() (Labels, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.LabelsContext(ctx)
+}
+
+// LabelsContext retrieves the list of labels.
+func (c *Client) LabelsContext(ctx context.Context) (Labels, error) {
+	body, err := c.request.Get(ctx, "/v1/labels")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var labels Labels
+	if err := json.NewDecoder(body).Decode(&labels); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return labels, nil
+}
+
+// LabelsWithCounters fetches the labels with their respective entry count.
+func (c *Client) LabelsWithCounters() (Labels, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.LabelsWithCountersContext(ctx)
+}
+
+// LabelsWithCountersContext fetches the labels with their respective entry count.
+func (c *Client) LabelsWithCountersContext(ctx context.Context) (Labels, error) {
+	body, err := c.request.Get(ctx, "/v1/labels?counts=true")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var labels Labels
+	if err := json.NewDecoder(body).Decode(&labels); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return labels, nil
+}
+
+// CreateLabel creates a new label.
+func (c *Client) CreateLabel(title string) (*Label, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreateLabelContext(ctx, title)
+}
+
+// CreateLabelContext creates a new label.
+func (c *Client) CreateLabelContext(ctx context.Context, title string) (*Label, error) {
+	body, err := c.request.Post(ctx, "/v1/labels", &LabelCreationRequest{
+		Title: title,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var label *Label
+	if err := json.NewDecoder(body).Decode(&label); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return label, nil
+}
+
+// UpdateLabel renames a label.
+func (c *Client) UpdateLabel(labelID int64, title string) (*Label, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.UpdateLabelContext(ctx, labelID, title)
+}
+
+// UpdateLabelContext renames a label.
+func (c *Client) UpdateLabelContext(ctx context.Context, labelID int64, title string) (*Label, error) {
+	body, err := c.request.Put(ctx, fmt.Sprintf("/v1/labels/%d", labelID), &LabelModificationRequest{
+		Title: new(title),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var label *Label
+	if err := json.NewDecoder(body).Decode(&label); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return label, nil
+}
+
+// DeleteLabel removes a label.
+func (c *Client) DeleteLabel(labelID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.DeleteLabelContext(ctx, labelID)
+}
+
+// DeleteLabelContext removes a label.
+func (c *Client) DeleteLabelContext(ctx context.Context, labelID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/labels/%d", labelID))
+}
+
+// MergeLabels moves all entries of a label to another label and removes the source label.
+func (c *Client) MergeLabels(sourceLabelID, destinationLabelID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.MergeLabelsContext(ctx, sourceLabelID, destinationLabelID)
+}
+
+// MergeLabelsContext moves all entries of a label to another label and removes the source label.
+func (c *Client) MergeLabelsContext(ctx context.Context, sourceLabelID, destinationLabelID int64) error {
+	_, err := c.request.Put(ctx, fmt.Sprintf("/v1/labels/%d/merge", sourceLabelID), &LabelMergeRequest{
+		DestinationLabelID: destinationLabelID,
+	})
+	return err
+}
+
+// SavedSearches retrieves the list of saved searches.
+func (c *Client) SavedSearches() (SavedSearches, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.SavedSearchesContext(ctx)
+}
+
+// SavedSearchesContext retrieves the list of saved searches.
+func (c *Client) SavedSearchesContext(ctx context.Context) (SavedSearches, error) {
+	body, err := c.request.Get(ctx, "/v1/saved-searches")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearches SavedSearches
+	if err := json.NewDecoder(body).Decode(&savedSearches); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearches, nil
+}
+
+// SavedSearchesWithCounters fetches the saved searches with their respective unread count.
+func (c *Client) SavedSearchesWithCounters() (SavedSearches, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.SavedSearchesWithCountersContext(ctx)
+}
+
+// SavedSearchesWithCountersContext fetches the saved searches with their respective unread count.
+func (c *Client) SavedSearchesWithCountersContext(ctx context.Context) (SavedSearches, error) {
+	body, err := c.request.Get(ctx, "/v1/saved-searches?counts=true")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearches SavedSearches
+	if err := json.NewDecoder(body).Decode(&savedSearches); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearches, nil
+}
+
+// SavedSearch gets a saved search.
+func (c *Client) SavedSearch(savedSearchID int64) (*SavedSearch, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.SavedSearchContext(ctx, savedSearchID)
+}
+
+// SavedSearchContext gets a saved search.
+func (c *Client) SavedSearchContext(ctx context.Context, savedSearchID int64) (*SavedSearch, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/saved-searches/%d", savedSearchID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearch *SavedSearch
+	if err := json.NewDecoder(body).Decode(&savedSearch); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearch, nil
+}
+
+// CreateSavedSearch creates a new saved search.
+func (c *Client) CreateSavedSearch(createRequest *SavedSearchCreationRequest) (*SavedSearch, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreateSavedSearchContext(ctx, createRequest)
+}
+
+// CreateSavedSearchContext creates a new saved search.
+func (c *Client) CreateSavedSearchContext(ctx context.Context, createRequest *SavedSearchCreationRequest) (*SavedSearch, error) {
+	body, err := c.request.Post(ctx, "/v1/saved-searches", createRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearch *SavedSearch
+	if err := json.NewDecoder(body).Decode(&savedSearch); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearch, nil
+}
+
+// UpdateSavedSearch updates a saved search.
+func (c *Client) UpdateSavedSearch(savedSearchID int64, savedSearchChanges *SavedSearchModificationRequest) (*SavedSearch, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.UpdateSavedSearchContext(ctx, savedSearchID, savedSearchChanges)
+}
+
+// UpdateSavedSearchContext updates a saved search.
+func (c *Client) UpdateSavedSearchContext(ctx context.Context, savedSearchID int64, savedSearchChanges *SavedSearchModificationRequest) (*SavedSearch, error) {
+	body, err := c.request.Put(ctx, fmt.Sprintf("/v1/saved-searches/%d", savedSearchID), savedSearchChanges)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearch *SavedSearch
+	if err := json.NewDecoder(body).Decode(&savedSearch); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearch, nil
+}
+
+// DeleteSavedSearch removes a saved search.
+func (c *Client) DeleteSavedSearch(savedSearchID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.DeleteSavedSearchContext(ctx, savedSearchID)
+}
+
+// DeleteSavedSearchContext removes a saved search.
+func (c *Client) DeleteSavedSearchContext(ctx context.Context, savedSearchID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/saved-searches/%d", savedSearchID))
+}
+
+// PublishedFeeds retrieves the list of published feeds.
+func (c *Client) PublishedFeeds() (PublishedFeeds, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.PublishedFeedsContext(ctx)
+}
+
+// PublishedFeedsContext retrieves the list of published feeds.
+func (c *Client) PublishedFeedsContext(ctx context.Context) (PublishedFeeds, error) {
+	body, err := c.request.Get(ctx, "/v1/published-feeds")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var publishedFeeds PublishedFeeds
+	if err := json.NewDecoder(body).Decode(&publishedFeeds); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return publishedFeeds, nil
+}
+
+// CreatePublishedFeed publishes a new feed.
+func (c *Client) CreatePublishedFeed(createRequest *PublishedFeedCreationRequest) (*PublishedFeed, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreatePublishedFeedContext(ctx, createRequest)
+}
+
+// CreatePublishedFeedContext publishes a new feed.
+func (c *Client) CreatePublishedFeedContext(ctx context.Context, createRequest *PublishedFeedCreationRequest) (*PublishedFeed, error) {
+	body, err := c.request.Post(ctx, "/v1/published-feeds", createRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var publishedFeed *PublishedFeed
+	if err := json.NewDecoder(body).Decode(&publishedFeed); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return publishedFeed, nil
+}
+
+// DeletePublishedFeed removes a published feed and revokes its token.
+func (c *Client) DeletePublishedFeed(publishedFeedID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.DeletePublishedFeedContext(ctx, publishedFeedID)
+}
+
+// DeletePublishedFeedContext removes a published feed and revokes its token.
+func (c *Client) DeletePublishedFeedContext(ctx context.Context, publishedFeedID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/published-feeds/%d", publishedFeedID))
+}
+
+// ActionRules retrieves the list of action rules.
+func (c *Client) ActionRules() (ActionRules, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ActionRulesContext(ctx)
+}
+
+// ActionRulesContext retrieves the list of action rules.
+func (c *Client) ActionRulesContext(ctx context.Context) (ActionRules, error) {
+	body, err := c.request.Get(ctx, "/v1/action-rules")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var actionRules ActionRules
+	if err := json.NewDecoder(body).Decode(&actionRules); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return actionRules, nil
+}
+
+// ActionRule gets an action rule.
+func (c *Client) ActionRule(actionRuleID int64) (*ActionRule, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ActionRuleContext(ctx, actionRuleID)
+}
+
+// ActionRuleContext gets an action rule.
+func (c *Client) ActionRuleContext(ctx context.Context, actionRuleID int64) (*ActionRule, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/action-rules/%d", actionRuleID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var actionRule *ActionRule
+	if err := json.NewDecoder(body).Decode(&actionRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return actionRule, nil
+}
+
+// CreateActionRule creates a new action rule.
+func (c *Client) CreateActionRule(createRequest *ActionRuleCreationRequest) (*ActionRule, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreateActionRuleContext(ctx, createRequest)
+}
+
+// CreateActionRuleContext creates a new action rule.
+func (c *Client) CreateActionRuleContext(ctx context.Context, createRequest *ActionRuleCreationRequest) (*ActionRule, error) {
+	body, err := c.request.Post(ctx, "/v1/action-rules", createRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var actionRule *ActionRule
+	if err := json.NewDecoder(body).Decode(&actionRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return actionRule, nil
+}
+
+// UpdateActionRule updates an action rule.
+func (c *Client) UpdateActionRule(actionRuleID int64, actionRuleChanges *ActionRuleModificationRequest) (*ActionRule, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.UpdateActionRuleContext(ctx, actionRuleID, actionRuleChanges)
+}
+
+// UpdateActionRuleContext updates an action rule.
+func (c *Client) UpdateActionRuleContext(ctx context.Context, actionRuleID int64, actionRuleChanges *ActionRuleModificationRequest) (*ActionRule, error) {
+	body, err := c.request.Put(ctx, fmt.Sprintf("/v1/action-rules/%d", actionRuleID), actionRuleChanges)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var actionRule *ActionRule
+	if err := json.NewDecoder(body).Decode(&actionRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return actionRule, nil
+}
+
+// DeleteActionRule removes an action rule.
+func (c *Client) DeleteActionRule(actionRuleID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.DeleteActionRuleContext(ctx, actionRuleID)
+}
+
+// DeleteActionRuleContext removes an action rule.
+func (c *Client) DeleteActionRuleContext(ctx context.Context, actionRuleID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/action-rules/%d", actionRuleID))
+}
+
 // Feeds gets all feeds.
 func (c *Client) Feeds() (Feeds, error) {
 	ctx, cancel := withDefaultTimeout()
@@ -742,20 +1234,66 @@ func (c *Client) ImportFeedEntry(feedID int64, payload any) (int64, error) {
 		return 0, fmt.Errorf("miniflux: json error (%v)", err)
 	}
 
-	return response.ID, nil
+	return response.ID, nil
+}
+
+// MarkFeedAsRead marks all unread entries of the feed as read.
+func (c *Client) MarkFeedAsRead(feedID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.MarkFeedAsReadContext(ctx, feedID)
+}
+
+// MarkFeedAsReadContext marks all unread entries of the feed as read.
+func (c *Client) MarkFeedAsReadContext(ctx context.Context, feedID int64) error {
+	_, err := c.request.Put(ctx, fmt.Sprintf("/v1/feeds/%d/mark-all-as-read", feedID), nil)
+	return err
+}
+
+// PreviewFeedFilters reports which of the latest entries of the feed would be blocked by the given filters.
+func (c *Client) PreviewFeedFilters(feedID int64, previewRequest *FilterPreviewRequest) (*FilterPreview, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.PreviewFeedFiltersContext(ctx, feedID, previewRequest)
+}
+
+// PreviewFeedFiltersContext reports which of the latest entries of the feed would be blocked by the given filters.
+func (c *Client) PreviewFeedFiltersContext(ctx context.Context, feedID int64, previewRequest *FilterPreviewRequest) (*FilterPreview, error) {
+	body, err := c.request.Post(ctx, fmt.Sprintf("/v1/feeds/%d/filter-preview", feedID), previewRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var preview *FilterPreview
+	if err := json.NewDecoder(body).Decode(&preview); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return preview, nil
 }
 
-// MarkFeedAsRead marks all unread entries of the feed as read.
-func (c *Client) MarkFeedAsRead(feedID int64) error {
+// FeedHistory returns the latest refresh attempts of a feed.
+func (c *Client) FeedHistory(feedID int64) (FeedRefreshHistory, error) {
 	ctx, cancel := withDefaultTimeout()
 	defer cancel()
-	return c.MarkFeedAsReadContext(ctx, feedID)
+	return c.FeedHistoryContext(ctx, feedID)
 }
 
-// MarkFeedAsReadContext marks all unread entries of the feed as read.
-func (c *Client) MarkFeedAsReadContext(ctx context.Context, feedID int64) error {
-	_, err := c.request.Put(ctx, fmt.Sprintf("/v1/feeds/%d/mark-all-as-read", feedID), nil)
-	return err
+// FeedHistoryContext returns the latest refresh attempts of a feed.
+func (c *Client) FeedHistoryContext(ctx context.Context, feedID int64) (FeedRefreshHistory, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/feeds/%d/history", feedID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var history FeedRefreshHistory
+	if err := json.NewDecoder(body).Decode(&history); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return history, nil
 }
 
 // RefreshAllFeeds refreshes all feeds.
@@ -1012,6 +1550,31 @@ func (c *Client) CategoryEntriesContext(ctx context.Context, categoryID int64, f
 	return &result, nil
 }
 
+// SavedSearchEntries fetches entries matching a saved search.
+func (c *Client) SavedSearchEntries(savedSearchID int64, filter *Filter) (*EntryResultSet, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.SavedSearchEntriesContext(ctx, savedSearchID, filter)
+}
+
+// SavedSearchEntriesContext fetches entries matching a saved search.
+func (c *Client) SavedSearchEntriesContext(ctx context.Context, savedSearchID int64, filter *Filter) (*EntryResultSet, error) {
+	path := buildFilterQueryString(fmt.Sprintf("/v1/saved-searches/%d/entries", savedSearchID), filter)
+
+	body, err := c.request.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
 // UpdateEntries updates the status of a list of entries.
 func (c *Client) UpdateEntries(entryIDs []int64, status string) error {
 	ctx, cancel := withDefaultTimeout()
@@ -1071,6 +1634,35 @@ func (c *Client) UpdateEntryContext(ctx context.Context, entryID int64, entryCha
 	return entry, nil
 }
 
+// UpdateEntryLabels replaces the labels attached to an entry.
+func (c *Client) UpdateEntryLabels(entryID int64, labelIDs []int64) (*Entry, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.UpdateEntryLabelsContext(ctx, entryID, labelIDs)
+}
+
+// UpdateEntryLabelsContext replaces the labels attached to an entry, no labels removes all of them.
+func (c *Client) UpdateEntryLabelsContext(ctx context.Context, entryID int64, labelIDs []int64) (*Entry, error) {
+	if labelIDs == nil {
+		labelIDs = []int64{}
+	}
+
+	body, err := c.request.Put(ctx, fmt.Sprintf("/v1/entries/%d/labels", entryID), &EntryLabelsUpdateRequest{
+		LabelIDs: labelIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var entry *Entry
+	if err := json.NewDecoder(body).Decode(&entry); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return entry, nil
+}
+
 // ToggleStarred toggles the starred flag of an entry.
 func (c *Client) ToggleStarred(entryID int64) error {
 	ctx, cancel := withDefaultTimeout()
@@ -1123,6 +1715,334 @@ func (c *Client) FetchEntryOriginalContentContext(ctx context.Context, entryID i
 	return response.Content, nil
 }
 
+// EntryArchive fetches the offline copy of an entry.
+func (c *Client) EntryArchive(entryID int64) (*EntryArchive, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.EntryArchiveContext(ctx, entryID)
+}
+
+// EntryArchiveContext fetches the offline copy of an entry.
+func (c *Client) EntryArchiveContext(ctx context.Context, entryID int64) (*EntryArchive, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/entries/%d/archive", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var archive *EntryArchive
+	if err := json.NewDecoder(body).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return archive, nil
+}
+
+// EntryRevisions fetches the previous versions of an entry, the most recent first.
+func (c *Client) EntryRevisions(entryID int64) (EntryRevisions, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.EntryRevisionsContext(ctx, entryID)
+}
+
+// EntryRevisionsContext fetches the previous versions of an entry, the most recent first.
+func (c *Client) EntryRevisionsContext(ctx context.Context, entryID int64) (EntryRevisions, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/entries/%d/revisions", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var revisions EntryRevisions
+	if err := json.NewDecoder(body).Decode(&revisions); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return revisions, nil
+}
+
+// ReadLaterEntries fetches the entries of the read later queue, in the order of the queue.
+func (c *Client) ReadLaterEntries(filter *Filter) (*EntryResultSet, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ReadLaterEntriesContext(ctx, filter)
+}
+
+// ReadLaterEntriesContext fetches the entries of the read later queue, in the order of the queue.
+func (c *Client) ReadLaterEntriesContext(ctx context.Context, filter *Filter) (*EntryResultSet, error) {
+	body, err := c.request.Get(ctx, buildFilterQueryString("/v1/read-later", filter))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// AddToReadLater appends entries at the end of the read later queue.
+func (c *Client) AddToReadLater(entryIDs []int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.AddToReadLaterContext(ctx, entryIDs)
+}
+
+// AddToReadLaterContext appends entries at the end of the read later queue.
+func (c *Client) AddToReadLaterContext(ctx context.Context, entryIDs []int64) error {
+	type payload struct {
+		EntryIDs []int64 `json:"entry_ids"`
+	}
+
+	_, err := c.request.Post(ctx, "/v1/read-later", &payload{EntryIDs: entryIDs})
+	return err
+}
+
+// MoveReadLaterEntry moves an entry to the given position of the read later queue, starting at 1.
+func (c *Client) MoveReadLaterEntry(entryID int64, position int) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.MoveReadLaterEntryContext(ctx, entryID, position)
+}
+
+// MoveReadLaterEntryContext moves an entry to the given position of the read later queue, starting at 1.
+func (c *Client) MoveReadLaterEntryContext(ctx context.Context, entryID int64, position int) error {
+	type payload struct {
+		Position int `json:"position"`
+	}
+
+	_, err := c.request.Put(ctx, fmt.Sprintf("/v1/read-later/%d", entryID), &payload{Position: position})
+	return err
+}
+
+// RemoveFromReadLater removes an entry from the read later queue.
+func (c *Client) RemoveFromReadLater(entryID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.RemoveFromReadLaterContext(ctx, entryID)
+}
+
+// RemoveFromReadLaterContext removes an entry from the read later queue.
+func (c *Client) RemoveFromReadLaterContext(ctx context.Context, entryID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/read-later/%d", entryID))
+}
+
+// Highlights fetches the highlights of the user matching the search of the filter, the most recent first.
+func (c *Client) Highlights(filter *Filter) (*HighlightResultSet, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.HighlightsContext(ctx, filter)
+}
+
+// HighlightsContext fetches the highlights of the user matching the search of the filter, the most recent first.
+func (c *Client) HighlightsContext(ctx context.Context, filter *Filter) (*HighlightResultSet, error) {
+	body, err := c.request.Get(ctx, buildFilterQueryString("/v1/highlights", filter))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result HighlightResultSet
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// EntryHighlights fetches the highlights of an entry, in the order of the text.
+func (c *Client) EntryHighlights(entryID int64) (Highlights, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.EntryHighlightsContext(ctx, entryID)
+}
+
+// EntryHighlightsContext fetches the highlights of an entry, in the order of the text.
+func (c *Client) EntryHighlightsContext(ctx context.Context, entryID int64) (Highlights, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/entries/%d/highlights", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlights Highlights
+	if err := json.NewDecoder(body).Decode(&highlights); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlights, nil
+}
+
+// Highlight fetches a single highlight.
+func (c *Client) Highlight(highlightID int64) (*Highlight, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.HighlightContext(ctx, highlightID)
+}
+
+// HighlightContext fetches a single highlight.
+func (c *Client) HighlightContext(ctx context.Context, highlightID int64) (*Highlight, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/highlights/%d", highlightID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlight *Highlight
+	if err := json.NewDecoder(body).Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlight, nil
+}
+
+// CreateHighlight highlights a passage of an entry.
+func (c *Client) CreateHighlight(entryID int64, highlightCreationRequest *HighlightCreationRequest) (*Highlight, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreateHighlightContext(ctx, entryID, highlightCreationRequest)
+}
+
+// CreateHighlightContext highlights a passage of an entry.
+func (c *Client) CreateHighlightContext(ctx context.Context, entryID int64, highlightCreationRequest *HighlightCreationRequest) (*Highlight, error) {
+	body, err := c.request.Post(ctx, fmt.Sprintf("/v1/entries/%d/highlights", entryID), highlightCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlight *Highlight
+	if err := json.NewDecoder(body).Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlight, nil
+}
+
+// UpdateHighlight updates the note of a highlight.
+func (c *Client) UpdateHighlight(highlightID int64, highlightChanges *HighlightModificationRequest) (*Highlight, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.UpdateHighlightContext(ctx, highlightID, highlightChanges)
+}
+
+// UpdateHighlightContext updates the note of a highlight.
+func (c *Client) UpdateHighlightContext(ctx context.Context, highlightID int64, highlightChanges *HighlightModificationRequest) (*Highlight, error) {
+	body, err := c.request.Put(ctx, fmt.Sprintf("/v1/highlights/%d", highlightID), highlightChanges)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlight *Highlight
+	if err := json.NewDecoder(body).Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlight, nil
+}
+
+// RemoveHighlight deletes a highlight.
+func (c *Client) RemoveHighlight(highlightID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.RemoveHighlightContext(ctx, highlightID)
+}
+
+// RemoveHighlightContext deletes a highlight.
+func (c *Client) RemoveHighlightContext(ctx context.Context, highlightID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/highlights/%d", highlightID))
+}
+
+// ArchiveEntry stores an offline copy of an entry web page and its images.
+func (c *Client) ArchiveEntry(entryID int64) (*EntryArchive, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ArchiveEntryContext(ctx, entryID)
+}
+
+// ArchiveEntryContext stores an offline copy of an entry web page and its images.
+func (c *Client) ArchiveEntryContext(ctx context.Context, entryID int64) (*EntryArchive, error) {
+	body, err := c.request.Post(ctx, fmt.Sprintf("/v1/entries/%d/archive", entryID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var archive *EntryArchive
+	if err := json.NewDecoder(body).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return archive, nil
+}
+
+// ExportEntryArchiveHTML exports the offline copy of an entry as a self-contained HTML document.
+func (c *Client) ExportEntryArchiveHTML(entryID int64) ([]byte, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ExportEntryArchiveHTMLContext(ctx, entryID)
+}
+
+// ExportEntryArchiveHTMLContext exports the offline copy of an entry as a self-contained HTML document.
+func (c *Client) ExportEntryArchiveHTMLContext(ctx context.Context, entryID int64) ([]byte, error) {
+	return c.download(ctx, fmt.Sprintf("/v1/entries/%d/archive.html", entryID))
+}
+
+// ExportEntryArchiveEPUB exports the offline copy of an entry as an EPUB book.
+func (c *Client) ExportEntryArchiveEPUB(entryID int64) ([]byte, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ExportEntryArchiveEPUBContext(ctx, entryID)
+}
+
+// ExportEntryArchiveEPUBContext exports the offline copy of an entry as an EPUB book.
+func (c *Client) ExportEntryArchiveEPUBContext(ctx context.Context, entryID int64) ([]byte, error) {
+	return c.download(ctx, fmt.Sprintf("/v1/entries/%d/archive.epub", entryID))
+}
+
+// ExportCategoryEPUB exports the entries of a category as an EPUB book.
+func (c *Client) ExportCategoryEPUB(categoryID int64) ([]byte, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ExportCategoryEPUBContext(ctx, categoryID)
+}
+
+// ExportCategoryEPUBContext exports the entries of a category as an EPUB book.
+func (c *Client) ExportCategoryEPUBContext(ctx context.Context, categoryID int64) ([]byte, error) {
+	return c.download(ctx, fmt.Sprintf("/v1/categories/%d/export.epub", categoryID))
+}
+
+// ExportEntriesEPUB exports the given entries as an EPUB book.
+func (c *Client) ExportEntriesEPUB(entryIDs []int64) ([]byte, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ExportEntriesEPUBContext(ctx, entryIDs)
+}
+
+// ExportEntriesEPUBContext exports the given entries as an EPUB book.
+func (c *Client) ExportEntriesEPUBContext(ctx context.Context, entryIDs []int64) ([]byte, error) {
+	values := make([]string, 0, len(entryIDs))
+	for _, entryID := range entryIDs {
+		values = append(values, strconv.FormatInt(entryID, 10))
+	}
+
+	return c.download(ctx, "/v1/entries/export.epub?entry_ids="+strings.Join(values, ","))
+}
+
+func (c *Client) download(ctx context.Context, path string) ([]byte, error) {
+	body, err := c.request.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
 // FetchCounters fetches feed counters.
 func (c *Client) FetchCounters() (*FeedCounters, error) {
 	ctx, cancel := withDefaultTimeout()
@@ -1290,6 +2210,10 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("feed_id", strconv.FormatInt(filter.FeedID, 10))
 		}
 
+		if filter.LabelID > 0 {
+			values.Set("label_id", strconv.FormatInt(filter.LabelID, 10))
+		}
+
 		if filter.GloballyVisible {
 			values.Set("globally_visible", "true")
 		}
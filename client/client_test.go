@@ -398,6 +398,38 @@ func TestCreateAPIKey(t *testing.T) {
 	}
 }
 
+func TestCreateScopedAPIKey(t *testing.T) {
+	expiresAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	request := &APIKeyCreationRequest{
+		Description: "dashboard",
+		Scopes:      []string{APIKeyScopeReadOnly},
+		ExpiresAt:   &expiresAt,
+	}
+	expected := &APIKey{
+		ID:          42,
+		Token:       "some-token",
+		Description: "dashboard",
+		Scopes:      []string{APIKeyScopeReadOnly},
+		ExpiresAt:   &expiresAt,
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/api-keys", func(r io.Reader) {
+					expectFromJSON(t, r, request)
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.CreateScopedAPIKeyContext(t.Context(), request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
 func TestDeleteAPIKey(t *testing.T) {
 	client := NewClientWithOptions(
 		"http://mf",
@@ -411,6 +443,25 @@ func TestDeleteAPIKey(t *testing.T) {
 	}
 }
 
+func TestAuditLog(t *testing.T) {
+	userID := int64(1)
+	expected := &AuditLogResultSet{Total: 1, Entries: AuditLogEntries{{ID: 1, UserID: &userID, Username: "admin", Action: "login", Target: "password", IP: "127.0.0.1"}}}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/audit-log?action=login&limit=10&user_id=1", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.AuditLogContext(t.Context(), &AuditLogFilter{UserID: 1, Action: "login", Limit: 10})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
 func TestMarkAllAsRead(t *testing.T) {
 	client := NewClientWithOptions(
 		"http://mf",
@@ -576,6 +627,66 @@ func TestCreateCategoryWithOptions(t *testing.T) {
 	}
 }
 
+func TestCreateCategoryWithFeedDefaults(t *testing.T) {
+	feedDefaults := &FeedDefaults{
+		UserAgent: "custom user agent",
+		Crawler:   true,
+	}
+	expected := &Category{
+		ID:           1,
+		Title:        "Example",
+		FeedDefaults: feedDefaults,
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/categories", func(r io.Reader) {
+					expectFromJSON(t, r, &CategoryCreationRequest{
+						Title:        "Example",
+						FeedDefaults: feedDefaults,
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.CreateCategoryWithOptions(&CategoryCreationRequest{
+		Title:        "Example",
+		FeedDefaults: feedDefaults,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestMoveCategory(t *testing.T) {
+	expected := &Category{
+		ID:       2,
+		Title:    "Example",
+		ParentID: 1,
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPut, "http://mf/v1/categories/2", func(r io.Reader) {
+					expectFromJSON(t, r, &CategoryModificationRequest{
+						ParentID: new(int64(1)),
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.MoveCategory(2, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
 func TestUpdateCategory(t *testing.T) {
 	expected := &Category{
 		ID:    1,
@@ -688,9 +799,297 @@ func TestRefreshCategory(t *testing.T) {
 				expectRequest(t, http.MethodPut, "http://mf/v1/categories/1/refresh", nil, req)
 				return jsonResponseFrom(t, http.StatusOK, http.Header{}, nil)
 			})))
-	if err := client.RefreshCategoryContext(t.Context(), 1); err != nil {
+	if err := client.RefreshCategoryContext(t.Context(), 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestLabels(t *testing.T) {
+	expected := Labels{
+		{
+			ID:    1,
+			Title: "Example",
+		},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/labels", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.LabelsContext(t.Context())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestCreateLabel(t *testing.T) {
+	expected := &Label{
+		ID:    1,
+		Title: "Example",
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/labels", func(r io.Reader) {
+					expectFromJSON(t, r, &LabelCreationRequest{
+						Title: "Example",
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.CreateLabelContext(t.Context(), "Example")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestMergeLabels(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPut, "http://mf/v1/labels/1/merge", func(r io.Reader) {
+					expectFromJSON(t, r, &LabelMergeRequest{
+						DestinationLabelID: 2,
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, nil)
+			})))
+	if err := client.MergeLabelsContext(t.Context(), 1, 2); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestDeleteLabel(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodDelete, "http://mf/v1/labels/1", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, nil)
+			})))
+	if err := client.DeleteLabelContext(t.Context(), 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestCreateSavedSearch(t *testing.T) {
+	expected := &SavedSearch{
+		ID:     1,
+		Title:  "Go",
+		Query:  "golang",
+		Status: "unread",
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/saved-searches", func(r io.Reader) {
+					expectFromJSON(t, r, &SavedSearchCreationRequest{
+						Title:  "Go",
+						Query:  "golang",
+						Status: "unread",
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.CreateSavedSearchContext(t.Context(), &SavedSearchCreationRequest{
+		Title:  "Go",
+		Query:  "golang",
+		Status: "unread",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestPublishedFeeds(t *testing.T) {
+	expected := PublishedFeeds{
+		{ID: 1, Token: "token1", Kind: PublishedFeedKindStarred},
+		{ID: 2, Token: "token2", Kind: PublishedFeedKindCategory, CategoryID: 3},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/published-feeds", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.PublishedFeedsContext(t.Context())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestCreatePublishedFeed(t *testing.T) {
+	expected := &PublishedFeed{
+		ID:    1,
+		Token: "token",
+		Kind:  PublishedFeedKindTag,
+		Tag:   "golang",
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/published-feeds", func(r io.Reader) {
+					expectFromJSON(t, r, &PublishedFeedCreationRequest{
+						Kind: PublishedFeedKindTag,
+						Tag:  "golang",
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.CreatePublishedFeedContext(t.Context(), &PublishedFeedCreationRequest{
+		Kind: PublishedFeedKindTag,
+		Tag:  "golang",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestDeletePublishedFeed(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodDelete, "http://mf/v1/published-feeds/1", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, nil)
+			})))
+	if err := client.DeletePublishedFeedContext(t.Context(), 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestSavedSearchesWithCounters(t *testing.T) {
+	totalUnread := 3
+	expected := SavedSearches{
+		{
+			ID:          1,
+			Title:       "Go",
+			Query:       "golang",
+			TotalUnread: &totalUnread,
+		},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/saved-searches?counts=true", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.SavedSearchesWithCountersContext(t.Context())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestSavedSearchEntries(t *testing.T) {
+	expected := &EntryResultSet{
+		Total: 1,
+		Entries: Entries{
+			{
+				ID:    1,
+				Title: "Example",
+			},
+		},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/saved-searches/1/entries?limit=10&offset=0", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.SavedSearchEntriesContext(t.Context(), 1, &Filter{Limit: 10})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %s, got %s", asJSON(expected), asJSON(res))
+	}
+}
+
+func TestCreateActionRule(t *testing.T) {
+	expected := &ActionRule{
+		ID:         1,
+		Expression: `title ~ "release notes"`,
+		Action:     ActionRuleMarkAsRead,
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/action-rules", func(r io.Reader) {
+					expectFromJSON(t, r, &ActionRuleCreationRequest{
+						Expression: `title ~ "release notes"`,
+						Action:     ActionRuleMarkAsRead,
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.CreateActionRuleContext(t.Context(), &ActionRuleCreationRequest{
+		Expression: `title ~ "release notes"`,
+		Action:     ActionRuleMarkAsRead,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestUpdateActionRule(t *testing.T) {
+	priority := 10
+	expected := &ActionRule{
+		ID:         1,
+		Expression: `tag == "security"`,
+		Action:     ActionRuleSetPriority,
+		Priority:   priority,
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPut, "http://mf/v1/action-rules/1", func(r io.Reader) {
+					expectFromJSON(t, r, &ActionRuleModificationRequest{
+						Priority: &priority,
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.UpdateActionRuleContext(t.Context(), 1, &ActionRuleModificationRequest{
+		Priority: &priority,
+	})
+	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
 }
 
 func TestFeeds(t *testing.T) {
@@ -880,6 +1279,61 @@ func TestMarkFeedAsRead(t *testing.T) {
 	}
 }
 
+func TestPreviewFeedFilters(t *testing.T) {
+	rules := "EntryTitle=(?i)release"
+	expected := &FilterPreview{
+		Total:   2,
+		Blocked: 1,
+		Entries: []*FilterPreviewEntry{
+			{EntryID: 2, Title: "Release 1.0", Blocked: true, MatchedRule: rules},
+			{EntryID: 1, Title: "Hello"},
+		},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/feeds/1/filter-preview", func(r io.Reader) {
+					expectFromJSON(t, r, &FilterPreviewRequest{
+						BlockFilterEntryRules: &rules,
+						Limit:                 10,
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.PreviewFeedFiltersContext(t.Context(), 1, &FilterPreviewRequest{
+		BlockFilterEntryRules: &rules,
+		Limit:                 10,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %s, got %s", asJSON(expected), asJSON(res))
+	}
+}
+
+func TestFeedHistory(t *testing.T) {
+	expected := FeedRefreshHistory{
+		{ID: 2, FeedID: 1, CheckedAt: time.Unix(43, 0).UTC(), StatusCode: http.StatusNotModified, Duration: 12, NotModified: true},
+		{ID: 1, FeedID: 1, CheckedAt: time.Unix(42, 0).UTC(), StatusCode: http.StatusOK, Duration: 250, BodySize: 2048, NewEntries: 3, UpdatedEntries: 1},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/feeds/1/history", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.FeedHistoryContext(t.Context(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %s, got %s", asJSON(expected), asJSON(res))
+	}
+}
+
 func TestRefreshAllFeeds(t *testing.T) {
 	client := NewClientWithOptions(
 		"http://mf",
@@ -1155,6 +1609,53 @@ func TestUpdateEntry(t *testing.T) {
 	}
 }
 
+func TestUpdateEntryLabels(t *testing.T) {
+	expected := &Entry{
+		ID:     1,
+		Title:  "Example",
+		Labels: Labels{{ID: 2, Title: "Later"}},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPut, "http://mf/v1/entries/1/labels", func(r io.Reader) {
+					expectFromJSON(t, r, &EntryLabelsUpdateRequest{
+						LabelIDs: []int64{2},
+					})
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.UpdateEntryLabelsContext(t.Context(), 1, []int64{2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %s, got %s", asJSON(expected), asJSON(res))
+	}
+}
+
+func TestUpdateEntryLabelsWithoutLabels(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPut, "http://mf/v1/entries/1/labels", func(r io.Reader) {
+					body, err := io.ReadAll(r)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if string(body) != `{"label_ids":[]}` {
+						t.Fatalf("Expected an empty list of labels, got %s", body)
+					}
+				}, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, &Entry{ID: 1})
+			})))
+	if _, err := client.UpdateEntryLabelsContext(t.Context(), 1, nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
 func TestToggleStarred(t *testing.T) {
 	client := NewClientWithOptions(
 		"http://mf",
@@ -1203,6 +1704,293 @@ func TestFetchEntryOriginalContent(t *testing.T) {
 	}
 }
 
+func TestEntryArchive(t *testing.T) {
+	expected := &EntryArchive{EntryID: 1, Title: "Example", MediaCount: 2}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/entries/1/archive", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.EntryArchiveContext(t.Context(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestEntryRevisions(t *testing.T) {
+	expected := EntryRevisions{
+		{ID: 2, EntryID: 1, Title: "Second title", Content: "<p>Second</p>"},
+		{ID: 1, EntryID: 1, Title: "First title", Content: "<p>First</p>"},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/entries/1/revisions", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.EntryRevisionsContext(t.Context(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestReadLaterEntries(t *testing.T) {
+	expected := &EntryResultSet{Total: 1, Entries: Entries{{ID: 1, Title: "Example", ReadLater: true}}}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/read-later?limit=10&offset=0", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.ReadLaterEntriesContext(t.Context(), &Filter{Limit: 10})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestAddToReadLater(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/read-later", nil, req)
+				expectFromJSON(t, req.Body, &struct {
+					EntryIDs []int64 `json:"entry_ids"`
+				}{
+					EntryIDs: []int64{1, 2},
+				})
+				return jsonResponseFrom(t, http.StatusNoContent, http.Header{}, nil)
+			})))
+	if err := client.AddToReadLaterContext(t.Context(), []int64{1, 2}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestMoveReadLaterEntry(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPut, "http://mf/v1/read-later/1", nil, req)
+				expectFromJSON(t, req.Body, &struct {
+					Position int `json:"position"`
+				}{
+					Position: 3,
+				})
+				return jsonResponseFrom(t, http.StatusNoContent, http.Header{}, nil)
+			})))
+	if err := client.MoveReadLaterEntryContext(t.Context(), 1, 3); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestRemoveFromReadLater(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodDelete, "http://mf/v1/read-later/1", nil, req)
+				return jsonResponseFrom(t, http.StatusNoContent, http.Header{}, nil)
+			})))
+	if err := client.RemoveFromReadLaterContext(t.Context(), 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestHighlights(t *testing.T) {
+	expected := &HighlightResultSet{Total: 1, Highlights: Highlights{{ID: 1, EntryID: 2, Text: "passage", EntryTitle: "Example"}}}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/highlights?limit=10&offset=0&search=passage", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.HighlightsContext(t.Context(), &Filter{Search: "passage", Limit: 10})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestEntryHighlights(t *testing.T) {
+	expected := Highlights{{ID: 1, EntryID: 2, Text: "first"}, {ID: 3, EntryID: 2, Text: "second"}}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/entries/2/highlights", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.EntryHighlightsContext(t.Context(), 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestCreateHighlight(t *testing.T) {
+	expected := &Highlight{ID: 1, EntryID: 2, Text: "passage", StartOffset: 10, EndOffset: 17, Note: "note"}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/entries/2/highlights", nil, req)
+				expectFromJSON(t, req.Body, &HighlightCreationRequest{Text: "passage", StartOffset: 10, Note: "note"})
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.CreateHighlightContext(t.Context(), 2, &HighlightCreationRequest{Text: "passage", StartOffset: 10, Note: "note"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestUpdateHighlight(t *testing.T) {
+	note := "updated"
+	expected := &Highlight{ID: 1, EntryID: 2, Text: "passage", Note: note}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPut, "http://mf/v1/highlights/1", nil, req)
+				expectFromJSON(t, req.Body, &HighlightModificationRequest{Note: &note})
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.UpdateHighlightContext(t.Context(), 1, &HighlightModificationRequest{Note: &note})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestRemoveHighlight(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodDelete, "http://mf/v1/highlights/1", nil, req)
+				return jsonResponseFrom(t, http.StatusNoContent, http.Header{}, nil)
+			})))
+	if err := client.RemoveHighlightContext(t.Context(), 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestArchiveEntry(t *testing.T) {
+	expected := &EntryArchive{EntryID: 1, Title: "Example"}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/entries/1/archive", nil, req)
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.ArchiveEntryContext(t.Context(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestExportEntryArchive(t *testing.T) {
+	expected := []byte("archive")
+	for _, format := range []string{"html", "epub"} {
+		client := NewClientWithOptions(
+			"http://mf",
+			WithHTTPClient(
+				newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+					expectRequest(t, http.MethodGet, "http://mf/v1/entries/1/archive."+format, nil, req)
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewBuffer(expected)),
+						Header:     http.Header{},
+					}
+				})))
+
+		var res []byte
+		var err error
+		if format == "html" {
+			res, err = client.ExportEntryArchiveHTMLContext(t.Context(), 1)
+		} else {
+			res, err = client.ExportEntryArchiveEPUBContext(t.Context(), 1)
+		}
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !bytes.Equal(res, expected) {
+			t.Fatalf("Expected %s, got %s", expected, res)
+		}
+	}
+}
+
+func TestExportCategoryEPUB(t *testing.T) {
+	expected := []byte("book")
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/categories/1/export.epub", nil, req)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBuffer(expected)),
+					Header:     http.Header{},
+				}
+			})))
+	res, err := client.ExportCategoryEPUBContext(t.Context(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !bytes.Equal(res, expected) {
+		t.Fatalf("Expected %s, got %s", expected, res)
+	}
+}
+
+func TestExportEntriesEPUB(t *testing.T) {
+	expected := []byte("book")
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/entries/export.epub?entry_ids=1,2", nil, req)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBuffer(expected)),
+					Header:     http.Header{},
+				}
+			})))
+	res, err := client.ExportEntriesEPUBContext(t.Context(), []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !bytes.Equal(res, expected) {
+		t.Fatalf("Expected %s, got %s", expected, res)
+	}
+}
+
 func TestFetchCounters(t *testing.T) {
 	expected := &FeedCounters{
 		ReadCounters: map[int64]int{
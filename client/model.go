@@ -44,9 +44,11 @@ type User struct {
 	MediaPlaybackRate         float64    `json:"media_playback_rate"`
 	BlockFilterEntryRules     string     `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules      string     `json:"keep_filter_entry_rules"`
+	BlockFilterExpression     string     `json:"block_filter_expression"`
 	ExternalFontHosts         string     `json:"external_font_hosts"`
 	AlwaysOpenExternalLinks   bool       `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab bool       `json:"open_external_links_in_new_tab"`
+	RemoveReadLaterOnRead     bool       `json:"remove_read_later_on_read"`
 }
 
 func (u User) String() string {
@@ -88,9 +90,11 @@ type UserModificationRequest struct {
 	MediaPlaybackRate         *float64 `json:"media_playback_rate"`
 	BlockFilterEntryRules     *string  `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules      *string  `json:"keep_filter_entry_rules"`
+	BlockFilterExpression     *string  `json:"block_filter_expression,omitempty"`
 	ExternalFontHosts         *string  `json:"external_font_hosts"`
 	AlwaysOpenExternalLinks   *bool    `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab *bool    `json:"open_external_links_in_new_tab"`
+	RemoveReadLaterOnRead     *bool    `json:"remove_read_later_on_read"`
 }
 
 // Users represents a list of users.
@@ -98,12 +102,15 @@ type Users []User
 
 // Category represents a feed category.
 type Category struct {
-	ID           int64  `json:"id"`
-	Title        string `json:"title"`
-	UserID       int64  `json:"user_id,omitempty"`
-	HideGlobally bool   `json:"hide_globally,omitempty"`
-	FeedCount    *int   `json:"feed_count,omitempty"`
-	TotalUnread  *int   `json:"total_unread,omitempty"`
+	ID                    int64         `json:"id"`
+	Title                 string        `json:"title"`
+	UserID                int64         `json:"user_id,omitempty"`
+	HideGlobally          bool          `json:"hide_globally,omitempty"`
+	ParentID              int64         `json:"parent_id,omitempty"`
+	BlockFilterExpression string        `json:"block_filter_expression,omitempty"`
+	FeedDefaults          *FeedDefaults `json:"feed_defaults,omitempty"`
+	FeedCount             *int          `json:"feed_count,omitempty"`
+	TotalUnread           *int          `json:"total_unread,omitempty"`
 }
 
 func (c Category) String() string {
@@ -113,16 +120,210 @@ func (c Category) String() string {
 // Categories represents a list of categories.
 type Categories []*Category
 
+// FeedDefaults represents the feed settings inherited from a category.
+// Feeds use them for the settings they leave empty or disabled.
+type FeedDefaults struct {
+	ScraperRules                string `json:"scraper_rules"`
+	RewriteRules                string `json:"rewrite_rules"`
+	UrlRewriteRules             string `json:"urlrewrite_rules"`
+	BlocklistRules              string `json:"blocklist_rules"`
+	KeeplistRules               string `json:"keeplist_rules"`
+	BlockFilterEntryRules       string `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules        string `json:"keep_filter_entry_rules"`
+	UserAgent                   string `json:"user_agent"`
+	Cookie                      string `json:"cookie"`
+	ProxyURL                    string `json:"proxy_url"`
+	AppriseServiceURLs          string `json:"apprise_service_urls"`
+	WebhookURL                  string `json:"webhook_url"`
+	NtfyTopic                   string `json:"ntfy_topic"`
+	Crawler                     bool   `json:"crawler"`
+	IgnoreEntryUpdates          bool   `json:"ignore_entry_updates"`
+	IgnoreHTTPCache             bool   `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates bool   `json:"allow_self_signed_certificates"`
+	FetchViaProxy               bool   `json:"fetch_via_proxy"`
+	DisableHTTP2                bool   `json:"disable_http2"`
+	NoMediaPlayer               bool   `json:"no_media_player"`
+	NtfyEnabled                 bool   `json:"ntfy_enabled"`
+	PushoverEnabled             bool   `json:"pushover_enabled"`
+}
+
 // CategoryCreationRequest represents the request to create a category.
 type CategoryCreationRequest struct {
-	Title        string `json:"title"`
-	HideGlobally bool   `json:"hide_globally"`
+	Title                 string        `json:"title"`
+	HideGlobally          bool          `json:"hide_globally"`
+	ParentID              int64         `json:"parent_id,omitempty"`
+	BlockFilterExpression string        `json:"block_filter_expression,omitempty"`
+	FeedDefaults          *FeedDefaults `json:"feed_defaults,omitempty"`
 }
 
 // CategoryModificationRequest represents the request to update a category.
+// FeedDefaults replaces all the defaults of the category.
+// Changing ParentID moves the category with its subcategories, zero moves it to the top level.
 type CategoryModificationRequest struct {
-	Title        *string `json:"title"`
-	HideGlobally *bool   `json:"hide_globally"`
+	Title                 *string       `json:"title"`
+	HideGlobally          *bool         `json:"hide_globally"`
+	ParentID              *int64        `json:"parent_id,omitempty"`
+	BlockFilterExpression *string       `json:"block_filter_expression,omitempty"`
+	FeedDefaults          *FeedDefaults `json:"feed_defaults,omitempty"`
+}
+
+// Label represents a user-defined label attached to entries.
+type Label struct {
+	ID         int64  `json:"id"`
+	Title      string `json:"title"`
+	UserID     int64  `json:"user_id,omitempty"`
+	EntryCount *int   `json:"entry_count,omitempty"`
+}
+
+func (l Label) String() string {
+	return fmt.Sprintf("#%d %s", l.ID, l.Title)
+}
+
+// Labels represents a list of labels.
+type Labels []*Label
+
+// LabelCreationRequest represents the request to create a label.
+type LabelCreationRequest struct {
+	Title string `json:"title"`
+}
+
+// LabelModificationRequest represents the request to rename a label.
+type LabelModificationRequest struct {
+	Title *string `json:"title"`
+}
+
+// LabelMergeRequest represents the request to merge a label into another one.
+type LabelMergeRequest struct {
+	DestinationLabelID int64 `json:"destination_label_id"`
+}
+
+// EntryLabelsUpdateRequest represents the request to replace the labels of an entry.
+type EntryLabelsUpdateRequest struct {
+	LabelIDs []int64 `json:"label_ids"`
+}
+
+// SavedSearch represents a persisted search query, displayed as a virtual feed.
+type SavedSearch struct {
+	ID          int64  `json:"id"`
+	UserID      int64  `json:"user_id,omitempty"`
+	Title       string `json:"title"`
+	Query       string `json:"query"`
+	Status      string `json:"status"`
+	Starred     bool   `json:"starred"`
+	CategoryID  int64  `json:"category_id"`
+	FeedID      int64  `json:"feed_id"`
+	MaxAgeDays  int    `json:"max_age_days"`
+	TotalUnread *int   `json:"total_unread,omitempty"`
+}
+
+func (s SavedSearch) String() string {
+	return fmt.Sprintf("#%d %s", s.ID, s.Title)
+}
+
+// SavedSearches represents a list of saved searches.
+type SavedSearches []*SavedSearch
+
+// SavedSearchCreationRequest represents the request to create a saved search.
+type SavedSearchCreationRequest struct {
+	Title      string `json:"title"`
+	Query      string `json:"query"`
+	Status     string `json:"status,omitempty"`
+	Starred    bool   `json:"starred,omitempty"`
+	CategoryID int64  `json:"category_id,omitempty"`
+	FeedID     int64  `json:"feed_id,omitempty"`
+	MaxAgeDays int    `json:"max_age_days,omitempty"`
+}
+
+// SavedSearchModificationRequest represents the request to update a saved search.
+type SavedSearchModificationRequest struct {
+	Title      *string `json:"title,omitempty"`
+	Query      *string `json:"query,omitempty"`
+	Status     *string `json:"status,omitempty"`
+	Starred    *bool   `json:"starred,omitempty"`
+	CategoryID *int64  `json:"category_id,omitempty"`
+	FeedID     *int64  `json:"feed_id,omitempty"`
+	MaxAgeDays *int    `json:"max_age_days,omitempty"`
+}
+
+// Entries selected by a published feed.
+const (
+	PublishedFeedKindStarred  = "starred"
+	PublishedFeedKindShared   = "shared"
+	PublishedFeedKindCategory = "category"
+	PublishedFeedKindTag      = "tag"
+	PublishedFeedKindLabel    = "label"
+)
+
+// PublishedFeed represents a list of entries published as Atom, RSS and JSON Feed.
+// The feeds are available without authentication at /publish/{token}/atom.xml, /publish/{token}/rss.xml and /publish/{token}/feed.json.
+type PublishedFeed struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	Token      string    `json:"token"`
+	Kind       string    `json:"kind"`
+	CategoryID int64     `json:"category_id"`
+	LabelID    int64     `json:"label_id"`
+	Tag        string    `json:"tag"`
+	Title      string    `json:"title"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (p PublishedFeed) String() string {
+	return fmt.Sprintf("#%d %s", p.ID, p.Kind)
+}
+
+// PublishedFeeds represents a list of published feeds.
+type PublishedFeeds []*PublishedFeed
+
+// PublishedFeedCreationRequest represents the request to publish a feed.
+type PublishedFeedCreationRequest struct {
+	Kind       string `json:"kind"`
+	CategoryID int64  `json:"category_id,omitempty"`
+	LabelID    int64  `json:"label_id,omitempty"`
+	Tag        string `json:"tag,omitempty"`
+	Title      string `json:"title,omitempty"`
+}
+
+// Actions applied to new entries matching an action rule.
+const (
+	ActionRuleMarkAsRead  = "mark_as_read"
+	ActionRuleStar        = "star"
+	ActionRuleAddLabel    = "add_label"
+	ActionRuleSendEntry   = "send_entry"
+	ActionRuleSetPriority = "set_priority"
+)
+
+// ActionRule represents a filter expression associated with an action applied to new entries.
+type ActionRule struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id,omitempty"`
+	Expression string `json:"expression"`
+	Action     string `json:"action"`
+	LabelID    int64  `json:"label_id"`
+	Priority   int    `json:"priority"`
+}
+
+func (a ActionRule) String() string {
+	return fmt.Sprintf("#%d %s: %s", a.ID, a.Action, a.Expression)
+}
+
+// ActionRules represents a list of action rules.
+type ActionRules []*ActionRule
+
+// ActionRuleCreationRequest represents the request to create an action rule.
+type ActionRuleCreationRequest struct {
+	Expression string `json:"expression"`
+	Action     string `json:"action"`
+	LabelID    int64  `json:"label_id,omitempty"`
+	Priority   int    `json:"priority,omitempty"`
+}
+
+// ActionRuleModificationRequest represents the request to update an action rule.
+type ActionRuleModificationRequest struct {
+	Expression *string `json:"expression,omitempty"`
+	Action     *string `json:"action,omitempty"`
+	LabelID    *int64  `json:"label_id,omitempty"`
+	Priority   *int    `json:"priority,omitempty"`
 }
 
 // Subscription represents a feed subscription.
@@ -166,8 +367,10 @@ type Feed struct {
 	KeeplistRules               string    `json:"keeplist_rules"`
 	BlockFilterEntryRules       string    `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        string    `json:"keep_filter_entry_rules"`
+	BlockFilterExpression       string    `json:"block_filter_expression"`
 	Crawler                     bool      `json:"crawler"`
 	IgnoreEntryUpdates          bool      `json:"ignore_entry_updates"`
+	ArchiveEntries              bool      `json:"archive_entries"`
 	UserAgent                   string    `json:"user_agent"`
 	Cookie                      string    `json:"cookie"`
 	Username                    string    `json:"username"`
@@ -183,7 +386,11 @@ type Feed struct {
 	NtfyTopic                   string    `json:"ntfy_topic"`
 	PushoverEnabled             bool      `json:"pushover_enabled"`
 	PushoverPriority            int       `json:"pushover_priority"`
+	PendingFeedURL              string    `json:"pending_feed_url"`
+	PendingFeedURLCount         int       `json:"pending_feed_url_count"`
 	Icon                        *FeedIcon `json:"icon"`
+	InheritedSettings           []string  `json:"inherited_settings,omitempty"`
+	OverriddenSettings          []string  `json:"overridden_settings"`
 }
 
 // FeedCreationRequest represents the request to create a feed.
@@ -208,6 +415,7 @@ type FeedCreationRequest struct {
 	KeeplistRules               string `json:"keeplist_rules"`
 	BlockFilterEntryRules       string `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        string `json:"keep_filter_entry_rules"`
+	BlockFilterExpression       string `json:"block_filter_expression,omitempty"`
 	HideGlobally                bool   `json:"hide_globally"`
 	DisableHTTP2                bool   `json:"disable_http2"`
 	ProxyURL                    string `json:"proxy_url"`
@@ -215,34 +423,83 @@ type FeedCreationRequest struct {
 
 // FeedModificationRequest represents the request to update a feed.
 type FeedModificationRequest struct {
-	FeedURL                     *string `json:"feed_url"`
-	SiteURL                     *string `json:"site_url"`
-	Title                       *string `json:"title"`
-	Description                 *string `json:"description"`
-	ScraperRules                *string `json:"scraper_rules"`
-	RewriteRules                *string `json:"rewrite_rules"`
-	UrlRewriteRules             *string `json:"urlrewrite_rules"`
-	BlocklistRules              *string `json:"blocklist_rules"`
-	KeeplistRules               *string `json:"keeplist_rules"`
-	BlockFilterEntryRules       *string `json:"block_filter_entry_rules"`
-	KeepFilterEntryRules        *string `json:"keep_filter_entry_rules"`
-	Crawler                     *bool   `json:"crawler"`
-	IgnoreEntryUpdates          *bool   `json:"ignore_entry_updates"`
-	UserAgent                   *string `json:"user_agent"`
-	Cookie                      *string `json:"cookie"`
-	Username                    *string `json:"username"`
-	Password                    *string `json:"password"`
-	CategoryID                  *int64  `json:"category_id"`
-	Disabled                    *bool   `json:"disabled"`
-	NoMediaPlayer               *bool   `json:"no_media_player"`
-	IgnoreHTTPCache             *bool   `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates *bool   `json:"allow_self_signed_certificates"`
-	FetchViaProxy               *bool   `json:"fetch_via_proxy"`
-	HideGlobally                *bool   `json:"hide_globally"`
-	DisableHTTP2                *bool   `json:"disable_http2"`
-	ProxyURL                    *string `json:"proxy_url"`
+	FeedURL                     *string   `json:"feed_url"`
+	SiteURL                     *string   `json:"site_url"`
+	Title                       *string   `json:"title"`
+	Description                 *string   `json:"description"`
+	ScraperRules                *string   `json:"scraper_rules"`
+	RewriteRules                *string   `json:"rewrite_rules"`
+	UrlRewriteRules             *string   `json:"urlrewrite_rules"`
+	BlocklistRules              *string   `json:"blocklist_rules"`
+	KeeplistRules               *string   `json:"keeplist_rules"`
+	BlockFilterEntryRules       *string   `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules        *string   `json:"keep_filter_entry_rules"`
+	BlockFilterExpression       *string   `json:"block_filter_expression,omitempty"`
+	Crawler                     *bool     `json:"crawler"`
+	IgnoreEntryUpdates          *bool     `json:"ignore_entry_updates"`
+	ArchiveEntries              *bool     `json:"archive_entries"`
+	UserAgent                   *string   `json:"user_agent"`
+	Cookie                      *string   `json:"cookie"`
+	Username                    *string   `json:"username"`
+	Password                    *string   `json:"password"`
+	CategoryID                  *int64    `json:"category_id"`
+	Disabled                    *bool     `json:"disabled"`
+	NoMediaPlayer               *bool     `json:"no_media_player"`
+	IgnoreHTTPCache             *bool     `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates *bool     `json:"allow_self_signed_certificates"`
+	FetchViaProxy               *bool     `json:"fetch_via_proxy"`
+	HideGlobally                *bool     `json:"hide_globally"`
+	DisableHTTP2                *bool     `json:"disable_http2"`
+	ProxyURL                    *string   `json:"proxy_url"`
+	OverriddenSettings          *[]string `json:"overridden_settings,omitempty"`
+}
+
+// FilterPreviewRequest represents the filter settings to evaluate against the latest entries of a feed.
+// Settings left nil are taken from the feed.
+type FilterPreviewRequest struct {
+	BlocklistRules        *string `json:"blocklist_rules,omitempty"`
+	KeeplistRules         *string `json:"keeplist_rules,omitempty"`
+	BlockFilterEntryRules *string `json:"block_filter_entry_rules,omitempty"`
+	KeepFilterEntryRules  *string `json:"keep_filter_entry_rules,omitempty"`
+	BlockFilterExpression *string `json:"block_filter_expression,omitempty"`
+	Limit                 int     `json:"limit,omitempty"`
+}
+
+// FilterPreviewEntry is the decision the filters would take for an entry.
+type FilterPreviewEntry struct {
+	EntryID     int64  `json:"entry_id"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	Blocked     bool   `json:"blocked"`
+	MatchedRule string `json:"matched_rule"`
 }
 
+// FilterPreview represents the result of a filter preview.
+type FilterPreview struct {
+	Total   int                   `json:"total"`
+	Blocked int                   `json:"blocked"`
+	Entries []*FilterPreviewEntry `json:"entries"`
+}
+
+// FeedRefresh represents a refresh attempt of a feed.
+// StatusCode is zero when no HTTP response has been received.
+type FeedRefresh struct {
+	ID             int64     `json:"id"`
+	FeedID         int64     `json:"feed_id"`
+	CheckedAt      time.Time `json:"checked_at"`
+	StatusCode     int       `json:"status_code"`
+	Duration       int64     `json:"duration_ms"`
+	BodySize       int64     `json:"body_size"`
+	NotModified    bool      `json:"not_modified"`
+	Shared         bool      `json:"shared"`
+	NewEntries     int       `json:"new_entries"`
+	UpdatedEntries int       `json:"updated_entries"`
+	ErrorMessage   string    `json:"error_message"`
+}
+
+// FeedRefreshHistory represents the refresh attempts of a feed, most recent first.
+type FeedRefreshHistory []*FeedRefresh
+
 // FeedIcon represents the feed icon.
 type FeedIcon struct {
 	ID       int64  `json:"id"`
@@ -276,10 +533,16 @@ type Entry struct {
 	ShareCode   string     `json:"share_code"`
 	Enclosures  Enclosures `json:"enclosures,omitempty"`
 	Tags        []string   `json:"tags"`
+	Labels      Labels     `json:"labels"`
 	ReadingTime int        `json:"reading_time"`
+	Priority    int        `json:"priority"`
 	UserID      int64      `json:"user_id"`
 	FeedID      int64      `json:"feed_id"`
 	Starred     bool       `json:"starred"`
+	ReadLater   bool       `json:"read_later"`
+
+	// RevisionCount is the number of times a feed update changed the title or the content of the entry.
+	RevisionCount int `json:"revision_count"`
 }
 
 // EntryModificationRequest represents a request to modify an entry.
@@ -291,6 +554,71 @@ type EntryModificationRequest struct {
 // Entries represents a list of entries.
 type Entries []*Entry
 
+// EntryRevision represents the title and the content of an entry before a feed update changed them.
+type EntryRevision struct {
+	ID        int64     `json:"id"`
+	EntryID   int64     `json:"entry_id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EntryRevisions represents a list of entry revisions.
+type EntryRevisions []*EntryRevision
+
+// Highlight represents a passage of an entry highlighted by the user, with an optional note.
+type Highlight struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	EntryID     int64     `json:"entry_id"`
+	Text        string    `json:"text"`
+	Prefix      string    `json:"prefix"`
+	Suffix      string    `json:"suffix"`
+	StartOffset int       `json:"start_offset"`
+	EndOffset   int       `json:"end_offset"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	FeedID      int64     `json:"feed_id,omitempty"`
+	EntryTitle  string    `json:"entry_title,omitempty"`
+	EntryURL    string    `json:"entry_url,omitempty"`
+}
+
+// Highlights represents a list of highlights.
+type Highlights []*Highlight
+
+// HighlightResultSet represents the response when fetching the highlights of the user.
+type HighlightResultSet struct {
+	Total      int        `json:"total"`
+	Highlights Highlights `json:"highlights"`
+}
+
+// HighlightCreationRequest represents the request to highlight a passage of an entry.
+// The start offset is counted in characters from the beginning of the text of the entry content.
+type HighlightCreationRequest struct {
+	Text        string `json:"text"`
+	Prefix      string `json:"prefix,omitempty"`
+	Suffix      string `json:"suffix,omitempty"`
+	StartOffset int    `json:"start_offset"`
+	Note        string `json:"note,omitempty"`
+}
+
+// HighlightModificationRequest represents the request to update the note of a highlight.
+type HighlightModificationRequest struct {
+	Note *string `json:"note"`
+}
+
+// EntryArchive represents the offline copy of an entry web page.
+type EntryArchive struct {
+	EntryID    int64     `json:"entry_id"`
+	UserID     int64     `json:"user_id"`
+	URL        string    `json:"url"`
+	Title      string    `json:"title"`
+	Content    string    `json:"content"`
+	MediaCount int       `json:"media_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
 // Enclosure represents an attachment.
 type Enclosure struct {
 	ID               int64  `json:"id"`
@@ -333,6 +661,7 @@ type Filter struct {
 	Search          string
 	CategoryID      int64
 	FeedID          int64
+	LabelID         int64
 	Statuses        []string
 	Tags            []string
 	GloballyVisible bool
@@ -375,16 +704,59 @@ type APIKey struct {
 	UserID      int64      `json:"user_id"`
 	Token       string     `json:"token"`
 	Description string     `json:"description"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"`
 	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  string     `json:"last_used_ip"`
 	CreatedAt   time.Time  `json:"created_at"`
 }
 
+// API key scopes, an API key without scopes has full access.
+const (
+	APIKeyScopeReadOnly     = "read-only"
+	APIKeyScopeEntriesWrite = "entries-write"
+	APIKeyScopeFeedsAdmin   = "feeds-admin"
+	APIKeyScopeIntegrations = "integrations"
+)
+
 // APIKeys represents a collection of API keys.
 type APIKeys []*APIKey
 
 // APIKeyCreationRequest represents the request to create an API key.
 type APIKeyCreationRequest struct {
-	Description string `json:"description"`
+	Description string     `json:"description"`
+	Scopes      []string   `json:"scopes,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// AuditLogEntry represents a security related action recorded in the audit log.
+type AuditLogEntry struct {
+	ID        int64     `json:"id"`
+	UserID    *int64    `json:"user_id"`
+	Username  string    `json:"username"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuditLogEntries represents a list of audit log entries.
+type AuditLogEntries []*AuditLogEntry
+
+// AuditLogResultSet represents the response when fetching the audit log.
+type AuditLogResultSet struct {
+	Total   int             `json:"total"`
+	Entries AuditLogEntries `json:"entries"`
+}
+
+// AuditLogFilter is used to filter the audit log, zero values are ignored.
+type AuditLogFilter struct {
+	UserID int64
+	Action string
+	Search string
+	Limit  int
+	Offset int
 }
 
 // SetOptionalField returns a pointer to the given value so optional request fields can be marked as set.
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) getActionRulesHandler(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.store.ActionRules(request.UserID(r))
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, rules)
+}
+
+func (h *handler) getActionRuleHandler(w http.ResponseWriter, r *http.Request) {
+	actionRuleID := request.RouteInt64Param(r, "actionRuleID")
+	if actionRuleID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid action rule ID"))
+		return
+	}
+
+	rule, err := h.store.ActionRule(request.UserID(r), actionRuleID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	response.JSON(w, r, rule)
+}
+
+func (h *handler) createActionRuleHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var actionRuleCreationRequest model.ActionRuleCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&actionRuleCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateActionRuleCreation(h.store, userID, &actionRuleCreationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	rule, err := h.store.CreateActionRule(userID, &actionRuleCreationRequest)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, rule)
+}
+
+func (h *handler) updateActionRuleHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	actionRuleID := request.RouteInt64Param(r, "actionRuleID")
+	if actionRuleID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid action rule ID"))
+		return
+	}
+
+	rule, err := h.store.ActionRule(userID, actionRuleID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	var actionRuleModificationRequest model.ActionRuleModificationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&actionRuleModificationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateActionRuleModification(h.store, userID, rule, &actionRuleModificationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	actionRuleModificationRequest.Patch(rule)
+
+	if err := h.store.UpdateActionRule(rule); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, rule)
+}
+
+func (h *handler) removeActionRuleHandler(w http.ResponseWriter, r *http.Request) {
+	actionRuleID := request.RouteInt64Param(r, "actionRuleID")
+	if actionRuleID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid action rule ID"))
+		return
+	}
+
+	if err := h.store.RemoveActionRule(request.UserID(r), actionRuleID); err != nil {
+		if errors.Is(err, storage.ErrActionRuleNotFound) {
+			response.JSONNotFound(w, r)
+			return
+		}
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
@@ -6,6 +6,7 @@ package api // import "miniflux.app/v2/internal/api"
 import (
 	"net/http"
 
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
 	"miniflux.app/v2/internal/worker"
 )
@@ -21,58 +22,107 @@ func NewHandler(store *storage.Storage, pool *worker.Pool) http.Handler {
 	handler := &handler{store: store, pool: pool}
 	middleware := newMiddleware(store)
 
+	// Every API key can read, the endpoints changing data require a scope, and the account management requires a key without scopes.
+	withScope := func(scope string, fn http.HandlerFunc) http.Handler {
+		return middleware.restrictAPIKey(func(apiKey *model.APIKey) bool { return apiKey.HasScope(scope) }, fn)
+	}
+	withFullAccess := func(fn http.HandlerFunc) http.Handler {
+		return middleware.restrictAPIKey((*model.APIKey).HasFullAccess, fn)
+	}
+
 	mux := http.NewServeMux()
-	mux.HandleFunc("POST /v1/users", handler.createUserHandler)
-	mux.HandleFunc("GET /v1/users", handler.usersHandler)
-	mux.HandleFunc("GET /v1/users/{identifier}", handler.dispatchUserLookupHandler)
-	mux.HandleFunc("PUT /v1/users/{userID}", handler.updateUserHandler)
-	mux.HandleFunc("DELETE /v1/users/{userID}", handler.removeUserHandler)
-	mux.HandleFunc("PUT /v1/users/{userID}/mark-all-as-read", handler.markUserAsReadHandler)
+	mux.Handle("POST /v1/users", withFullAccess(handler.createUserHandler))
+	mux.Handle("GET /v1/users", withFullAccess(handler.usersHandler))
+	mux.Handle("GET /v1/users/{identifier}", withFullAccess(handler.dispatchUserLookupHandler))
+	mux.Handle("PUT /v1/users/{userID}", withFullAccess(handler.updateUserHandler))
+	mux.Handle("DELETE /v1/users/{userID}", withFullAccess(handler.removeUserHandler))
+	mux.Handle("PUT /v1/users/{userID}/mark-all-as-read", withScope(model.APIKeyScopeEntriesWrite, handler.markUserAsReadHandler))
 	mux.HandleFunc("GET /v1/me", handler.currentUserHandler)
-	mux.HandleFunc("POST /v1/categories", handler.createCategoryHandler)
+	mux.Handle("GET /v1/audit-log", withFullAccess(handler.getAuditLogHandler))
+	mux.Handle("POST /v1/categories", withScope(model.APIKeyScopeFeedsAdmin, handler.createCategoryHandler))
 	mux.HandleFunc("GET /v1/categories", handler.getCategoriesHandler)
-	mux.HandleFunc("PUT /v1/categories/{categoryID}", handler.updateCategoryHandler)
-	mux.HandleFunc("DELETE /v1/categories/{categoryID}", handler.removeCategoryHandler)
-	mux.HandleFunc("PUT /v1/categories/{categoryID}/mark-all-as-read", handler.markCategoryAsReadHandler)
+	mux.Handle("PUT /v1/categories/{categoryID}", withScope(model.APIKeyScopeFeedsAdmin, handler.updateCategoryHandler))
+	mux.Handle("DELETE /v1/categories/{categoryID}", withScope(model.APIKeyScopeFeedsAdmin, handler.removeCategoryHandler))
+	mux.Handle("PUT /v1/categories/{categoryID}/mark-all-as-read", withScope(model.APIKeyScopeEntriesWrite, handler.markCategoryAsReadHandler))
 	mux.HandleFunc("GET /v1/categories/{categoryID}/feeds", handler.getCategoryFeedsHandler)
-	mux.HandleFunc("PUT /v1/categories/{categoryID}/refresh", handler.refreshCategoryHandler)
+	mux.Handle("PUT /v1/categories/{categoryID}/refresh", withScope(model.APIKeyScopeFeedsAdmin, handler.refreshCategoryHandler))
 	mux.HandleFunc("GET /v1/categories/{categoryID}/entries", handler.getCategoryEntriesHandler)
 	mux.HandleFunc("GET /v1/categories/{categoryID}/entries/{entryID}", handler.getCategoryEntryHandler)
-	mux.HandleFunc("POST /v1/discover", handler.discoverSubscriptionsHandler)
-	mux.HandleFunc("POST /v1/feeds", handler.createFeedHandler)
+	mux.HandleFunc("GET /v1/categories/{categoryID}/export.epub", handler.exportCategoryEPUBHandler)
+	mux.Handle("POST /v1/labels", withScope(model.APIKeyScopeEntriesWrite, handler.createLabelHandler))
+	mux.HandleFunc("GET /v1/labels", handler.getLabelsHandler)
+	mux.Handle("PUT /v1/labels/{labelID}", withScope(model.APIKeyScopeEntriesWrite, handler.updateLabelHandler))
+	mux.Handle("DELETE /v1/labels/{labelID}", withScope(model.APIKeyScopeEntriesWrite, handler.removeLabelHandler))
+	mux.Handle("PUT /v1/labels/{labelID}/merge", withScope(model.APIKeyScopeEntriesWrite, handler.mergeLabelsHandler))
+	mux.Handle("POST /v1/saved-searches", withScope(model.APIKeyScopeEntriesWrite, handler.createSavedSearchHandler))
+	mux.HandleFunc("GET /v1/saved-searches", handler.getSavedSearchesHandler)
+	mux.HandleFunc("GET /v1/saved-searches/{savedSearchID}", handler.getSavedSearchHandler)
+	mux.Handle("PUT /v1/saved-searches/{savedSearchID}", withScope(model.APIKeyScopeEntriesWrite, handler.updateSavedSearchHandler))
+	mux.Handle("DELETE /v1/saved-searches/{savedSearchID}", withScope(model.APIKeyScopeEntriesWrite, handler.removeSavedSearchHandler))
+	mux.HandleFunc("GET /v1/saved-searches/{savedSearchID}/entries", handler.getSavedSearchEntriesHandler)
+	mux.Handle("POST /v1/published-feeds", withScope(model.APIKeyScopeFeedsAdmin, handler.createPublishedFeedHandler))
+	mux.HandleFunc("GET /v1/published-feeds", handler.getPublishedFeedsHandler)
+	mux.Handle("DELETE /v1/published-feeds/{publishedFeedID}", withScope(model.APIKeyScopeFeedsAdmin, handler.removePublishedFeedHandler))
+	mux.Handle("POST /v1/action-rules", withScope(model.APIKeyScopeFeedsAdmin, handler.createActionRuleHandler))
+	mux.HandleFunc("GET /v1/action-rules", handler.getActionRulesHandler)
+	mux.HandleFunc("GET /v1/action-rules/{actionRuleID}", handler.getActionRuleHandler)
+	mux.Handle("PUT /v1/action-rules/{actionRuleID}", withScope(model.APIKeyScopeFeedsAdmin, handler.updateActionRuleHandler))
+	mux.Handle("DELETE /v1/action-rules/{actionRuleID}", withScope(model.APIKeyScopeFeedsAdmin, handler.removeActionRuleHandler))
+	mux.Handle("POST /v1/discover", withScope(model.APIKeyScopeFeedsAdmin, handler.discoverSubscriptionsHandler))
+	mux.Handle("POST /v1/feeds", withScope(model.APIKeyScopeFeedsAdmin, handler.createFeedHandler))
 	mux.HandleFunc("GET /v1/feeds", handler.getFeedsHandler)
 	mux.HandleFunc("GET /v1/feeds/counters", handler.fetchCountersHandler)
-	mux.HandleFunc("PUT /v1/feeds/refresh", handler.refreshAllFeedsHandler)
-	mux.HandleFunc("PUT /v1/feeds/{feedID}/refresh", handler.refreshFeedHandler)
+	mux.HandleFunc("GET /v1/events", handler.streamEventsHandler)
+	mux.Handle("PUT /v1/feeds/refresh", withScope(model.APIKeyScopeFeedsAdmin, handler.refreshAllFeedsHandler))
+	mux.Handle("PUT /v1/feeds/{feedID}/refresh", withScope(model.APIKeyScopeFeedsAdmin, handler.refreshFeedHandler))
 	mux.HandleFunc("GET /v1/feeds/{feedID}", handler.getFeedHandler)
-	mux.HandleFunc("PUT /v1/feeds/{feedID}", handler.updateFeedHandler)
-	mux.HandleFunc("DELETE /v1/feeds/{feedID}", handler.removeFeedHandler)
+	mux.Handle("PUT /v1/feeds/{feedID}", withScope(model.APIKeyScopeFeedsAdmin, handler.updateFeedHandler))
+	mux.Handle("DELETE /v1/feeds/{feedID}", withScope(model.APIKeyScopeFeedsAdmin, handler.removeFeedHandler))
 	mux.HandleFunc("GET /v1/feeds/{feedID}/icon", handler.getIconByFeedIDHandler)
-	mux.HandleFunc("PUT /v1/feeds/{feedID}/mark-all-as-read", handler.markFeedAsReadHandler)
+	mux.Handle("PUT /v1/feeds/{feedID}/mark-all-as-read", withScope(model.APIKeyScopeEntriesWrite, handler.markFeedAsReadHandler))
+	mux.Handle("POST /v1/feeds/{feedID}/filter-preview", withScope(model.APIKeyScopeFeedsAdmin, handler.previewFeedFiltersHandler))
+	mux.HandleFunc("GET /v1/feeds/{feedID}/history", handler.getFeedHistoryHandler)
 	mux.HandleFunc("GET /v1/export", handler.exportFeedsHandler)
-	mux.HandleFunc("POST /v1/import", handler.importFeedsHandler)
+	mux.Handle("POST /v1/import", withScope(model.APIKeyScopeFeedsAdmin, handler.importFeedsHandler))
 	mux.HandleFunc("GET /v1/feeds/{feedID}/entries", handler.getFeedEntriesHandler)
-	mux.HandleFunc("POST /v1/feeds/{feedID}/entries/import", handler.importFeedEntryHandler)
+	mux.Handle("POST /v1/feeds/{feedID}/entries/import", withScope(model.APIKeyScopeEntriesWrite, handler.importFeedEntryHandler))
 	mux.HandleFunc("GET /v1/feeds/{feedID}/entries/{entryID}", handler.getFeedEntryHandler)
 	mux.HandleFunc("GET /v1/entries/ids", handler.getEntryIDsHandler)
+	mux.HandleFunc("GET /v1/entries/export.epub", handler.exportEntriesEPUBHandler)
 	mux.HandleFunc("GET /v1/entries", handler.getEntriesHandler)
-	mux.HandleFunc("PUT /v1/entries", handler.setEntryStatusAndStarredHandler)
+	mux.Handle("PUT /v1/entries", withScope(model.APIKeyScopeEntriesWrite, handler.setEntryStatusAndStarredHandler))
 	mux.HandleFunc("GET /v1/entries/{entryID}", handler.getEntryHandler)
-	mux.HandleFunc("PUT /v1/entries/{entryID}", handler.updateEntryHandler)
-	mux.HandleFunc("PUT /v1/entries/{entryID}/bookmark", handler.toggleStarredHandler)
-	mux.HandleFunc("PUT /v1/entries/{entryID}/star", handler.toggleStarredHandler)
-	mux.HandleFunc("POST /v1/entries/{entryID}/save", handler.saveEntryHandler)
+	mux.Handle("PUT /v1/entries/{entryID}", withScope(model.APIKeyScopeEntriesWrite, handler.updateEntryHandler))
+	mux.Handle("PUT /v1/entries/{entryID}/bookmark", withScope(model.APIKeyScopeEntriesWrite, handler.toggleStarredHandler))
+	mux.Handle("PUT /v1/entries/{entryID}/star", withScope(model.APIKeyScopeEntriesWrite, handler.toggleStarredHandler))
+	mux.Handle("POST /v1/entries/{entryID}/save", withScope(model.APIKeyScopeIntegrations, handler.saveEntryHandler))
 	mux.HandleFunc("GET /v1/entries/{entryID}/fetch-content", handler.fetchContentHandler)
-	mux.HandleFunc("PUT /v1/flush-history", handler.flushHistoryHandler)
-	mux.HandleFunc("DELETE /v1/flush-history", handler.flushHistoryHandler)
+	mux.HandleFunc("GET /v1/entries/{entryID}/archive", handler.getEntryArchiveHandler)
+	mux.Handle("POST /v1/entries/{entryID}/archive", withScope(model.APIKeyScopeEntriesWrite, handler.createEntryArchiveHandler))
+	mux.HandleFunc("GET /v1/entries/{entryID}/archive.html", handler.exportEntryArchiveHTMLHandler)
+	mux.HandleFunc("GET /v1/entries/{entryID}/archive.epub", handler.exportEntryArchiveEPUBHandler)
+	mux.HandleFunc("GET /v1/entries/{entryID}/revisions", handler.getEntryRevisionsHandler)
+	mux.Handle("PUT /v1/entries/{entryID}/labels", withScope(model.APIKeyScopeEntriesWrite, handler.updateEntryLabelsHandler))
+	mux.HandleFunc("GET /v1/entries/{entryID}/highlights", handler.getEntryHighlightsHandler)
+	mux.Handle("POST /v1/entries/{entryID}/highlights", withScope(model.APIKeyScopeEntriesWrite, handler.createHighlightHandler))
+	mux.HandleFunc("GET /v1/read-later", handler.getReadLaterEntriesHandler)
+	mux.Handle("POST /v1/read-later", withScope(model.APIKeyScopeEntriesWrite, handler.addToReadLaterHandler))
+	mux.Handle("PUT /v1/read-later/{entryID}", withScope(model.APIKeyScopeEntriesWrite, handler.moveReadLaterEntryHandler))
+	mux.Handle("DELETE /v1/read-later/{entryID}", withScope(model.APIKeyScopeEntriesWrite, handler.removeFromReadLaterHandler))
+	mux.HandleFunc("GET /v1/highlights", handler.getHighlightsHandler)
+	mux.HandleFunc("GET /v1/highlights/{highlightID}", handler.getHighlightHandler)
+	mux.Handle("PUT /v1/highlights/{highlightID}", withScope(model.APIKeyScopeEntriesWrite, handler.updateHighlightHandler))
+	mux.Handle("DELETE /v1/highlights/{highlightID}", withScope(model.APIKeyScopeEntriesWrite, handler.removeHighlightHandler))
+	mux.Handle("PUT /v1/flush-history", withScope(model.APIKeyScopeEntriesWrite, handler.flushHistoryHandler))
+	mux.Handle("DELETE /v1/flush-history", withScope(model.APIKeyScopeEntriesWrite, handler.flushHistoryHandler))
 	mux.HandleFunc("GET /v1/icons/{iconID}", handler.getIconByIconIDHandler)
 	mux.HandleFunc("GET /v1/enclosures/{enclosureID}", handler.getEnclosureByIDHandler)
-	mux.HandleFunc("PUT /v1/enclosures/{enclosureID}", handler.updateEnclosureByIDHandler)
+	mux.Handle("PUT /v1/enclosures/{enclosureID}", withScope(model.APIKeyScopeEntriesWrite, handler.updateEnclosureByIDHandler))
 	mux.HandleFunc("GET /v1/integrations/status", handler.getIntegrationsStatusHandler)
 	mux.HandleFunc("GET /v1/version", handler.versionHandler)
-	mux.HandleFunc("POST /v1/api-keys", handler.createAPIKeyHandler)
-	mux.HandleFunc("GET /v1/api-keys", handler.getAPIKeysHandler)
-	mux.HandleFunc("DELETE /v1/api-keys/{apiKeyID}", handler.deleteAPIKeyHandler)
+	mux.Handle("POST /v1/api-keys", withFullAccess(handler.createAPIKeyHandler))
+	mux.Handle("GET /v1/api-keys", withFullAccess(handler.getAPIKeysHandler))
+	mux.Handle("DELETE /v1/api-keys/{apiKeyID}", withFullAccess(handler.deleteAPIKeyHandler))
 
 	return middleware.withCORSHeaders(middleware.validateAPIKeyAuth(middleware.validateBasicAuth(mux)))
 }
@@ -10,6 +10,7 @@ import (
 	"io"
 	"math/rand/v2"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 
@@ -1080,6 +1081,67 @@ func TestCreateCategoryWithOptions(t *testing.T) {
 	}
 }
 
+func TestCategoryFeedDefaultsAreInherited(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	category, err := regularUserClient.CreateCategoryWithOptions(&miniflux.CategoryCreationRequest{
+		Title: "My category",
+		FeedDefaults: &miniflux.FeedDefaults{
+			ScraperRules: "article",
+			Crawler:      true,
+		},
+	})
+	if err != nil {
+		t.Fatalf(`Creating a category with feed defaults should not raise an error: %v`, err)
+	}
+
+	if category.FeedDefaults == nil || category.FeedDefaults.ScraperRules != "article" || !category.FeedDefaults.Crawler {
+		t.Fatalf(`Invalid feed defaults, got %+v`, category.FeedDefaults)
+	}
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL:    testConfig.testFeedURL,
+		CategoryID: category.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := regularUserClient.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.ScraperRules != "" || feed.Crawler {
+		t.Errorf(`The inherited settings should not be saved on the feed, got %q and %v`, feed.ScraperRules, feed.Crawler)
+	}
+
+	if !slices.Contains(feed.InheritedSettings, "scraper_rules") || !slices.Contains(feed.InheritedSettings, "crawler") {
+		t.Errorf(`Invalid inherited settings, got %v`, feed.InheritedSettings)
+	}
+
+	if _, err := regularUserClient.UpdateCategoryWithOptions(category.ID, &miniflux.CategoryModificationRequest{
+		FeedDefaults: &miniflux.FeedDefaults{BlocklistRules: "(?i"},
+	}); err == nil {
+		t.Fatal(`Invalid blocklist rules in the feed defaults should raise an error`)
+	}
+}
+
 func TestUpdateCategoryEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -1436,6 +1498,89 @@ func TestMarkCategoryAsReadEndpoint(t *testing.T) {
 	}
 }
 
+func TestNestedCategoriesEndpoints(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	parentCategory, err := regularUserClient.CreateCategory("Parent category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	childCategory, err := regularUserClient.CreateCategoryWithOptions(&miniflux.CategoryCreationRequest{
+		Title:    "Child category",
+		ParentID: parentCategory.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if childCategory.ParentID != parentCategory.ID {
+		t.Fatalf(`Invalid parent ID, got %d instead of %d`, childCategory.ParentID, parentCategory.ID)
+	}
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL:    testConfig.testFeedURL,
+		CategoryID: childCategory.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	categories, err := regularUserClient.CategoriesWithCounters()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, category := range categories {
+		if category.ID == parentCategory.ID && (category.TotalUnread == nil || *category.TotalUnread == 0) {
+			t.Errorf(`The unread counter of the parent category should include the subcategories`)
+		}
+	}
+
+	if _, err := regularUserClient.MoveCategory(parentCategory.ID, childCategory.ID); err == nil {
+		t.Fatal(`Moving a category into one of its subcategories should raise an error`)
+	}
+
+	if err := regularUserClient.MarkCategoryAsRead(parentCategory.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := regularUserClient.FeedEntries(feedID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range results.Entries {
+		if entry.Status != miniflux.EntryStatusRead {
+			t.Errorf(`Status for entry %d was %q instead of %q`, entry.ID, entry.Status, miniflux.EntryStatusRead)
+		}
+	}
+
+	movedCategory, err := regularUserClient.MoveCategory(childCategory.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if movedCategory.ParentID != 0 {
+		t.Errorf(`The category should be moved to the top level, got parent ID %d`, movedCategory.ParentID)
+	}
+}
+
 func TestCreateFeedEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -1952,6 +2097,61 @@ func TestRefreshFeedEndpoint(t *testing.T) {
 	}
 }
 
+func TestFeedHistoryEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL: testConfig.testFeedURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := regularUserClient.RefreshFeed(feedID); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := regularUserClient.FeedHistory(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history) != 1 {
+		t.Fatalf(`Expected 1 refresh attempt, got %d`, len(history))
+	}
+
+	if history[0].FeedID != feedID {
+		t.Errorf(`Invalid feed ID, got %d instead of %d`, history[0].FeedID, feedID)
+	}
+
+	if history[0].StatusCode != 200 {
+		t.Errorf(`Invalid status code, got %d`, history[0].StatusCode)
+	}
+
+	if history[0].ErrorMessage != "" {
+		t.Errorf(`Unexpected error message: %q`, history[0].ErrorMessage)
+	}
+
+	if _, err := adminClient.FeedHistory(feedID); !errors.Is(err, miniflux.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound for another user's feed, got %v`, err)
+	}
+}
+
 func TestGetFeedEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -2560,6 +2760,19 @@ func TestGetAllEntriesEndpointWithFilter(t *testing.T) {
 		t.Fatalf(`Invalid total, got %d`, searchedEntries.Total)
 	}
 
+	titleSearchedEntries, err := regularUserClient.Entries(&miniflux.Filter{Search: `title:"2.0.8" -is:starred`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if titleSearchedEntries.Total != 1 {
+		t.Fatalf(`Invalid total, got %d`, titleSearchedEntries.Total)
+	}
+
+	if _, err := regularUserClient.Entries(&miniflux.Filter{Search: "before:yesterday"}); err == nil {
+		t.Fatal(`Using an invalid search query should raise an error`)
+	}
+
 	if _, err := regularUserClient.Entries(&miniflux.Filter{Status: "invalid"}); err == nil {
 		t.Fatal(`Using invalid status should raise an error`)
 	}
@@ -3265,6 +3478,248 @@ func TestToggleStarredEndpoint(t *testing.T) {
 	}
 }
 
+func TestLabelsEndpoints(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	label, err := regularUserClient.CreateLabel("To Read")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if label.ID == 0 {
+		t.Errorf(`Invalid labelID, got "%v"`, label.ID)
+	}
+
+	if label.Title != "To Read" {
+		t.Errorf(`Invalid title, got %q`, label.Title)
+	}
+
+	if _, err := regularUserClient.CreateLabel("to read"); err == nil {
+		t.Fatal(`Duplicated label titles should not be allowed`)
+	}
+
+	updatedLabel, err := regularUserClient.UpdateLabel(label.ID, "Later")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedLabel.Title != "Later" {
+		t.Errorf(`Invalid title, got %q`, updatedLabel.Title)
+	}
+
+	labels, err := regularUserClient.Labels()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(labels) != 1 || labels[0].Title != "Later" {
+		t.Fatalf(`Invalid labels, got %v`, labels)
+	}
+
+	if err := adminClient.DeleteLabel(label.ID); err == nil {
+		t.Fatal(`Users should not be able to delete labels of other users`)
+	}
+
+	if err := regularUserClient.DeleteLabel(label.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateEntryLabelsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL: testConfig.testFeedURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := regularUserClient.FeedEntries(feedID, nil)
+	if err != nil {
+		t.Fatalf(`Failed to get entries: %v`, err)
+	}
+
+	sourceLabel, err := regularUserClient.CreateLabel("Source")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	destinationLabel, err := regularUserClient.CreateLabel("Destination")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := regularUserClient.UpdateEntryLabels(result.Entries[0].ID, []int64{sourceLabel.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entry.Labels) != 1 || entry.Labels[0].ID != sourceLabel.ID {
+		t.Fatalf(`Invalid entry labels, got %v`, entry.Labels)
+	}
+
+	if _, err := regularUserClient.UpdateEntryLabels(result.Entries[0].ID, []int64{123456789}); err == nil {
+		t.Fatal(`Unknown label IDs should be rejected`)
+	}
+
+	if err := regularUserClient.MergeLabels(sourceLabel.ID, destinationLabel.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	labeledEntries, err := regularUserClient.Entries(&miniflux.Filter{LabelID: destinationLabel.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if labeledEntries.Total != 1 || labeledEntries.Entries[0].ID != result.Entries[0].ID {
+		t.Fatalf(`Invalid labeled entries, got %d entries`, labeledEntries.Total)
+	}
+
+	entry, err = regularUserClient.UpdateEntryLabels(result.Entries[0].ID, []int64{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entry.Labels) != 0 {
+		t.Fatalf(`Entry labels should be empty, got %v`, entry.Labels)
+	}
+}
+
+func TestActionRulesEndpoints(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	if _, err := regularUserClient.CreateActionRule(&miniflux.ActionRuleCreationRequest{Expression: `title ~`, Action: miniflux.ActionRuleStar}); err == nil {
+		t.Fatal(`Invalid expressions should not be allowed`)
+	}
+
+	if _, err := regularUserClient.CreateActionRule(&miniflux.ActionRuleCreationRequest{Expression: `title ~ "."`, Action: "archive"}); err == nil {
+		t.Fatal(`Invalid actions should not be allowed`)
+	}
+
+	if _, err := regularUserClient.CreateActionRule(&miniflux.ActionRuleCreationRequest{Expression: `title ~ "."`, Action: miniflux.ActionRuleAddLabel, LabelID: 123456}); err == nil {
+		t.Fatal(`Unknown labels should not be allowed`)
+	}
+
+	label, err := regularUserClient.CreateLabel("Automatic")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, request := range []*miniflux.ActionRuleCreationRequest{
+		{Expression: `url ~ "."`, Action: miniflux.ActionRuleMarkAsRead},
+		{Expression: `url ~ "."`, Action: miniflux.ActionRuleStar},
+		{Expression: `url ~ "."`, Action: miniflux.ActionRuleAddLabel, LabelID: label.ID},
+		{Expression: `url ~ "."`, Action: miniflux.ActionRuleSetPriority, Priority: 1},
+	} {
+		if _, err := regularUserClient.CreateActionRule(request); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rules, err := regularUserClient.ActionRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 4 {
+		t.Fatalf(`Invalid number of action rules, got %d`, len(rules))
+	}
+
+	priority := 5
+	updatedRule, err := regularUserClient.UpdateActionRule(rules[3].ID, &miniflux.ActionRuleModificationRequest{Priority: &priority})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedRule.Priority != 5 || updatedRule.Action != miniflux.ActionRuleSetPriority {
+		t.Errorf(`Invalid action rule, got %+v`, updatedRule)
+	}
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL: testConfig.testFeedURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := regularUserClient.FeedEntries(feedID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total == 0 {
+		t.Fatal(`The feed should have entries`)
+	}
+
+	for _, entry := range result.Entries {
+		if entry.Status != miniflux.EntryStatusRead || !entry.Starred || entry.Priority != 5 {
+			t.Errorf(`Action rules were not applied to entry #%d: status=%q starred=%v priority=%d`, entry.ID, entry.Status, entry.Starred, entry.Priority)
+		}
+
+		if len(entry.Labels) != 1 || entry.Labels[0].ID != label.ID {
+			t.Errorf(`The label was not added to entry #%d, got %v`, entry.ID, entry.Labels)
+		}
+	}
+
+	if err := adminClient.DeleteActionRule(rules[0].ID); err == nil {
+		t.Fatal(`Users should not be able to delete action rules of other users`)
+	}
+
+	if err := regularUserClient.DeleteActionRule(rules[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := regularUserClient.ActionRule(rules[0].ID); err == nil {
+		t.Fatal(`Deleted action rules should not be found`)
+	}
+}
+
 func TestSaveEntryEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -3469,3 +3924,66 @@ func TestImportFeedEntryEndpoint(t *testing.T) {
 		t.Fatalf("expected same entry ID on re-import, got %d and %d", firstID, secondID)
 	}
 }
+
+func TestPreviewFeedFiltersEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL: testConfig.testFeedURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blockEverything := "EntryURL=."
+	preview, err := regularUserClient.PreviewFeedFilters(feedID, &miniflux.FilterPreviewRequest{
+		BlockFilterEntryRules: &blockEverything,
+		Limit:                 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if preview.Total == 0 || preview.Total > 5 {
+		t.Fatalf(`Invalid number of previewed entries, got %d`, preview.Total)
+	}
+
+	if preview.Blocked != preview.Total {
+		t.Errorf(`All entries should be blocked, got %d out of %d`, preview.Blocked, preview.Total)
+	}
+
+	for _, entry := range preview.Entries {
+		if !entry.Blocked || entry.MatchedRule != blockEverything {
+			t.Errorf(`Invalid preview for entry #%d, got %+v`, entry.EntryID, entry)
+		}
+	}
+
+	preview, err = regularUserClient.PreviewFeedFilters(feedID, &miniflux.FilterPreviewRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if preview.Blocked != 0 {
+		t.Errorf(`No entry should be blocked by the stored filters, got %d`, preview.Blocked)
+	}
+
+	invalidRules := "EntryTitle"
+	if _, err := regularUserClient.PreviewFeedFilters(feedID, &miniflux.FilterPreviewRequest{BlockFilterEntryRules: &invalidRules}); err == nil {
+		t.Fatal(`Invalid filter rules should be rejected`)
+	}
+}
@@ -6,6 +6,7 @@ package api // import "miniflux.app/v2/internal/api"
 import (
 	json_parser "encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"miniflux.app/v2/internal/http/request"
@@ -29,12 +30,14 @@ func (h *handler) createAPIKeyHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	apiKey, err := h.store.CreateAPIKey(userID, apiKeyCreationRequest.Description)
+	apiKey, err := h.store.CreateAPIKey(userID, &apiKeyCreationRequest)
 	if err != nil {
 		response.JSONServerError(w, r, err)
 		return
 	}
 
+	h.recordAuditLog(r, model.AuditLogActionAPIKeyCreated, fmt.Sprintf("api_key:%d", apiKey.ID))
+
 	response.JSONCreated(w, r, apiKey)
 }
 
@@ -64,5 +67,7 @@ func (h *handler) deleteAPIKeyHandler(w http.ResponseWriter, r *http.Request) {
 		response.JSONServerError(w, r, err)
 		return
 	}
+
+	h.recordAuditLog(r, model.AuditLogActionAPIKeyDeleted, fmt.Sprintf("api_key:%d", apiKeyID))
 	response.NoContent(w, r)
 }
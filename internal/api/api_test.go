@@ -4,12 +4,16 @@
 package api // import "miniflux.app/v2/internal/api"
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"runtime"
 	"testing"
 
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/version"
 )
 
@@ -132,6 +136,46 @@ func TestGetEntryIDsHandlerRejectsInvalidStatusParam(t *testing.T) {
 	}
 }
 
+func TestFetchContentHandlerRequiresEntriesWriteScopeToUpdateContent(t *testing.T) {
+	h := &handler{}
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/entries/1/fetch-content?update_content=true", nil)
+	r.SetPathValue("entryID", "1")
+	r = r.WithContext(context.WithValue(r.Context(), request.APIKeyContextKey, &model.APIKey{
+		ID:     1,
+		UserID: 1,
+		Scopes: []string{model.APIKeyScopeReadOnly},
+	}))
+	w := httptest.NewRecorder()
+
+	// The request must be rejected before the entry is loaded from the store.
+	h.fetchContentHandler(w, r)
+
+	if got := w.Code; got != http.StatusForbidden {
+		t.Fatalf(`Unexpected status code, got %d instead of %d`, got, http.StatusForbidden)
+	}
+}
+
+func TestCreateEntryArchiveHandlerWhenOfflineArchiveIsDisabled(t *testing.T) {
+	var err error
+	config.Opts, err = config.NewConfigParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	h := &handler{}
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/entries/1/archive", nil)
+	r.SetPathValue("entryID", "1")
+	w := httptest.NewRecorder()
+
+	h.createEntryArchiveHandler(w, r)
+
+	if got := w.Code; got != http.StatusNotFound {
+		t.Fatalf(`Unexpected status code, got %d instead of %d`, got, http.StatusNotFound)
+	}
+}
+
 func TestParseEntryIDsParamsDefaults(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/v1/entries/ids", nil)
 	limit, offset := parseEntryIDsParams(r)
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"miniflux.app/v2/internal/audit"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) getAuditLogHandler(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		response.JSONForbidden(w, r)
+		return
+	}
+
+	action := request.QueryStringParam(r, "action", "")
+	if action != "" && !slices.Contains(model.AuditLogActions(), action) {
+		response.JSONBadRequest(w, r, fmt.Errorf("invalid audit log action: %q", action))
+		return
+	}
+
+	userID := request.QueryInt64Param(r, "user_id", 0)
+	search := strings.TrimSpace(request.QueryStringParam(r, "search", ""))
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := validator.ValidateRange(offset, limit); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	entries, err := h.store.AuditLog(userID, action, search, limit, offset)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	count, err := h.store.CountAuditLog(userID, action, search)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, &auditLogResponse{Total: count, Entries: entries})
+}
+
+// recordAuditLog appends the action of the authenticated user to the audit log.
+func (h *handler) recordAuditLog(r *http.Request, action, target string) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		slog.Error("Unable to fetch the user of the audit log entry",
+			slog.Int64("user_id", request.UserID(r)),
+			slog.Any("error", err),
+		)
+	}
+
+	audit.Record(h.store, r, user, action, target)
+}
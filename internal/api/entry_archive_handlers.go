@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/epub"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/archiver"
+)
+
+func (h *handler) getEntryArchiveHandler(w http.ResponseWriter, r *http.Request) {
+	_, archive, mediaList, ok := h.findEntryArchive(w, r)
+	if !ok {
+		return
+	}
+
+	archive.Content = archiver.RewriteImages(archive.Content, mediaList, func(media *model.ArchivedMedia) string {
+		return mediaproxy.ProxifyAbsoluteURL(media.URL)
+	})
+
+	response.JSON(w, r, archive)
+}
+
+func (h *handler) createEntryArchiveHandler(w http.ResponseWriter, r *http.Request) {
+	if !config.Opts.OfflineArchive() {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	entryID := request.RouteInt64Param(r, "entryID")
+	if entryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid entry ID"))
+		return
+	}
+
+	entry, err := h.store.NewEntryQueryBuilder(request.UserID(r)).
+		WithEntryIDs(entryID).
+		GetEntry()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	archive, err := archiver.ArchiveEntry(h.store, entry, config.Opts.OfflineArchiveMaxSizePerUser())
+	if errors.Is(err, archiver.ErrQuotaExceeded) {
+		response.JSONForbidden(w, r)
+		return
+	}
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, archive)
+}
+
+func (h *handler) exportEntryArchiveHTMLHandler(w http.ResponseWriter, r *http.Request) {
+	entry, archive, mediaList, ok := h.findEntryArchive(w, r)
+	if !ok {
+		return
+	}
+
+	document, err := archiver.ExportHTML(entry, archive, mediaList)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	builder := response.NewBuilder(w, r)
+	builder.WithHeader("Content-Type", "text/html; charset=utf-8")
+	builder.WithHeader("Content-Security-Policy", response.ContentSecurityPolicyForUntrustedContent)
+	builder.WithAttachment(fmt.Sprintf("entry-%d.html", entry.ID))
+	builder.WithBodyAsBytes(document)
+	builder.Write()
+}
+
+func (h *handler) exportEntryArchiveEPUBHandler(w http.ResponseWriter, r *http.Request) {
+	entry, archive, mediaList, ok := h.findEntryArchive(w, r)
+	if !ok {
+		return
+	}
+
+	var buffer bytes.Buffer
+	if err := archiver.ExportEPUB(&buffer, entry, archive, mediaList); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	builder := response.NewBuilder(w, r)
+	builder.WithHeader("Content-Type", epub.MimeType)
+	builder.WithAttachment(fmt.Sprintf("entry-%d.epub", entry.ID))
+	builder.WithBodyAsBytes(buffer.Bytes())
+	builder.WithoutCompression()
+	builder.Write()
+}
+
+// findEntryArchive writes the error response and returns false when the entry or its archive cannot be found.
+func (h *handler) findEntryArchive(w http.ResponseWriter, r *http.Request) (*model.Entry, *model.EntryArchive, model.ArchivedMediaList, bool) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+	if entryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid entry ID"))
+		return nil, nil, nil, false
+	}
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).
+		WithEntryIDs(entryID).
+		GetEntry()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return nil, nil, nil, false
+	}
+
+	if entry == nil {
+		response.JSONNotFound(w, r)
+		return nil, nil, nil, false
+	}
+
+	archive, err := h.store.EntryArchive(userID, entryID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return nil, nil, nil, false
+	}
+
+	if archive == nil {
+		response.JSONNotFound(w, r)
+		return nil, nil, nil, false
+	}
+
+	mediaList, err := h.store.EntryArchiveMedia(userID, entryID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return nil, nil, nil, false
+	}
+
+	return entry, archive, mediaList, true
+}
@@ -102,7 +102,7 @@ func (h *handler) getFeedEntriesHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	h.findEntries(w, r, feedID, 0)
+	h.findEntries(w, r, feedID, 0, nil)
 }
 
 func (h *handler) getCategoryEntriesHandler(w http.ResponseWriter, r *http.Request) {
@@ -111,14 +111,14 @@ func (h *handler) getCategoryEntriesHandler(w http.ResponseWriter, r *http.Reque
 		response.JSONBadRequest(w, r, errors.New("invalid category ID"))
 		return
 	}
-	h.findEntries(w, r, 0, categoryID)
+	h.findEntries(w, r, 0, categoryID, nil)
 }
 
 func (h *handler) getEntriesHandler(w http.ResponseWriter, r *http.Request) {
-	h.findEntries(w, r, 0, 0)
+	h.findEntries(w, r, 0, 0, nil)
 }
 
-func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int64, categoryID int64) {
+func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int64, categoryID int64, savedSearch *model.SavedSearch) {
 	statuses := request.QueryStringParamList(r, "status")
 	for _, status := range statuses {
 		if err := validator.ValidateEntryStatus(status); err != nil {
@@ -173,6 +173,24 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 		}
 	}
 
+	labelID := request.QueryInt64Param(r, "label_id", 0)
+	if labelID > 0 {
+		label, err := h.store.Label(userID, labelID)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+		if label == nil {
+			response.JSONBadRequest(w, r, errors.New("invalid label ID"))
+			return
+		}
+	}
+
+	if validationErr := validator.ValidateSearchQuery(request.QueryStringParam(r, "search", "")); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
 	tags := request.QueryStringParamList(r, "tags")
 
 	builder := h.store.NewEntryQueryBuilder(userID).
@@ -183,6 +201,7 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 		WithOffset(offset).
 		WithLimit(limit).
 		WithTags(tags...).
+		WithLabelID(labelID).
 		WithEnclosures()
 
 	if request.HasQueryParam(r, "globally_visible") {
@@ -193,6 +212,10 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 		}
 	}
 
+	if savedSearch != nil {
+		builder = builder.WithSavedSearch(savedSearch)
+	}
+
 	builder = configureFilters(builder, r)
 
 	entries, count, err := builder.GetEntriesWithCount()
@@ -473,6 +496,13 @@ func (h *handler) fetchContentHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Updating the stored content changes the entry, unlike fetching it.
+	shouldUpdateContent := request.QueryBoolParam(r, "update_content", false)
+	if apiKey := request.APIKey(r); shouldUpdateContent && apiKey != nil && !apiKey.HasScope(model.APIKeyScopeEntriesWrite) {
+		response.JSONForbidden(w, r)
+		return
+	}
+
 	entry, err := h.store.NewEntryQueryBuilder(loggedUserID).
 		WithEntryIDs(entryID).
 		GetEntry()
@@ -515,7 +545,6 @@ func (h *handler) fetchContentHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	shouldUpdateContent := request.QueryBoolParam(r, "update_content", false)
 	if shouldUpdateContent {
 		if err := h.store.UpdateEntryTitleAndContent(entry); err != nil {
 			response.JSONServerError(w, r, err)
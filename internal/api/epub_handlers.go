@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"miniflux.app/v2/internal/epub"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/archiver"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) exportEntriesEPUBHandler(w http.ResponseWriter, r *http.Request) {
+	entryIDs, err := request.QueryInt64ParamList(r, "entry_ids")
+	if err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if len(entryIDs) == 0 {
+		response.JSONBadRequest(w, r, errors.New("the entry_ids parameter is required"))
+		return
+	}
+
+	if len(entryIDs) > archiver.MaxBookEntries {
+		response.JSONBadRequest(w, r, fmt.Errorf("a book cannot contain more than %d entries", archiver.MaxBookEntries))
+		return
+	}
+
+	entries, err := h.store.NewEntryQueryBuilder(request.UserID(r)).
+		WithEntryIDs(entryIDs...).
+		WithSorting("published_at", "ASC").
+		GetEntries()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if len(entries) == 0 {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	title := request.QueryStringParam(r, "title", "Miniflux "+time.Now().Format("2006-01-02"))
+	h.writeEPUB(w, r, title, entries)
+}
+
+func (h *handler) exportCategoryEPUBHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	if categoryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid category ID"))
+		return
+	}
+
+	category, err := h.store.Category(userID, categoryID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	statuses := request.QueryStringParamList(r, "status")
+	for _, status := range statuses {
+		if err := validator.ValidateEntryStatus(status); err != nil {
+			response.JSONBadRequest(w, r, err)
+			return
+		}
+	}
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	if err := validator.ValidateRange(0, limit); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID).
+		WithCategoryID(categoryID).
+		WithStatuses(statuses...).
+		WithSorting("published_at", "ASC").
+		WithLimitAndMaximum(limit, archiver.MaxBookEntries)
+
+	if request.HasQueryParam(r, "starred") {
+		builder.WithStarred(request.QueryBoolParam(r, "starred", false))
+	}
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	h.writeEPUB(w, r, category.Title, entries)
+}
+
+func (h *handler) writeEPUB(w http.ResponseWriter, r *http.Request, title string, entries model.Entries) {
+	var buffer bytes.Buffer
+	if err := archiver.ExportBook(&buffer, h.store, title, entries); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	builder := response.NewBuilder(w, r)
+	builder.WithHeader("Content-Type", epub.MimeType)
+	builder.WithAttachment(title + ".epub")
+	builder.WithBodyAsBytes(buffer.Bytes())
+	builder.WithoutCompression()
+	builder.Write()
+}
@@ -15,6 +15,7 @@ import (
 	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/model"
 	feedHandler "miniflux.app/v2/internal/reader/handler"
+	"miniflux.app/v2/internal/reader/processor"
 	"miniflux.app/v2/internal/validator"
 )
 
@@ -272,3 +273,90 @@ func (h *handler) removeFeedHandler(w http.ResponseWriter, r *http.Request) {
 
 	response.NoContent(w, r)
 }
+
+func (h *handler) previewFeedFiltersHandler(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	if feedID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid feed ID"))
+		return
+	}
+
+	var filterPreviewRequest model.FilterPreviewRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&filterPreviewRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateFilterPreview(&filterPreviewRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	userID := request.UserID(r)
+	user, err := h.store.UserByID(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	feed, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	limit := filterPreviewRequest.Limit
+	if limit == 0 {
+		limit = model.DefaultFilterPreviewLimit
+	}
+
+	entries, err := h.store.NewEntryQueryBuilder(userID).
+		WithFeedID(feedID).
+		WithSorting("published_at", "desc").
+		WithLimit(limit).
+		GetEntries()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	filterPreviewRequest.Patch(feed)
+	response.JSON(w, r, processor.PreviewFilters(user, feed, entries))
+}
+
+func (h *handler) getFeedHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	if feedID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid feed ID"))
+		return
+	}
+
+	userID := request.UserID(r)
+	exists, err := h.store.FeedExists(userID, feedID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	if !exists {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	history, err := h.store.FeedRefreshHistory(userID, feedID, config.Opts.FeedRefreshHistorySize())
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, history)
+}
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"miniflux.app/v2/internal/highlight"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/integration"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) getHighlightsHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	search := strings.TrimSpace(request.QueryStringParam(r, "search", ""))
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := validator.ValidateRange(offset, limit); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	highlights, err := h.store.Highlights(userID, search, limit, offset)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	count, err := h.store.CountHighlights(userID, search)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, &highlightsResponse{Total: count, Highlights: highlights})
+}
+
+func (h *handler) getEntryHighlightsHandler(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	if entryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid entry ID"))
+		return
+	}
+
+	highlights, err := h.store.EntryHighlights(request.UserID(r), entryID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, highlights)
+}
+
+func (h *handler) getHighlightHandler(w http.ResponseWriter, r *http.Request) {
+	highlightID := request.RouteInt64Param(r, "highlightID")
+	if highlightID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid highlight ID"))
+		return
+	}
+
+	highlight, err := h.store.HighlightByID(request.UserID(r), highlightID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	response.JSON(w, r, highlight)
+}
+
+func (h *handler) createHighlightHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+	if entryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid entry ID"))
+		return
+	}
+
+	var highlightCreationRequest model.HighlightCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&highlightCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if err := validator.ValidateHighlightCreation(&highlightCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).
+		WithEntryIDs(entryID).
+		GetEntry()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	newHighlight := &model.Highlight{
+		UserID:      userID,
+		EntryID:     entry.ID,
+		Text:        highlightCreationRequest.Text,
+		Prefix:      highlightCreationRequest.Prefix,
+		Suffix:      highlightCreationRequest.Suffix,
+		StartOffset: highlightCreationRequest.StartOffset,
+		Note:        highlightCreationRequest.Note,
+	}
+
+	if err := highlight.Anchor(entry.Content, newHighlight); err != nil {
+		if errors.Is(err, highlight.ErrTextNotFound) {
+			response.JSONBadRequest(w, r, err)
+			return
+		}
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateHighlight(newHighlight); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	userIntegrations, err := h.store.Integration(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	go integration.SendHighlight(entry, newHighlight, userIntegrations)
+
+	response.JSONCreated(w, r, newHighlight)
+}
+
+func (h *handler) updateHighlightHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	highlightID := request.RouteInt64Param(r, "highlightID")
+	if highlightID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid highlight ID"))
+		return
+	}
+
+	var highlightModificationRequest model.HighlightModificationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&highlightModificationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	originalHighlight, err := h.store.HighlightByID(userID, highlightID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if originalHighlight == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	highlightModificationRequest.Patch(originalHighlight)
+	if err := h.store.UpdateHighlight(originalHighlight); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).
+		WithEntryIDs(originalHighlight.EntryID).
+		WithoutContent().
+		GetEntry()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	userIntegrations, err := h.store.Integration(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if entry != nil {
+		go integration.SendHighlight(entry, originalHighlight, userIntegrations)
+	}
+
+	response.JSONCreated(w, r, originalHighlight)
+}
+
+func (h *handler) removeHighlightHandler(w http.ResponseWriter, r *http.Request) {
+	highlightID := request.RouteInt64Param(r, "highlightID")
+	if highlightID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid highlight ID"))
+		return
+	}
+
+	highlight, err := h.store.HighlightByID(request.UserID(r), highlightID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveHighlight(highlight.UserID, highlight.ID); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) getLabelsHandler(w http.ResponseWriter, r *http.Request) {
+	var labels model.Labels
+	var err error
+
+	if request.QueryBoolParam(r, "counts", false) {
+		labels, err = h.store.LabelsWithEntryCount(request.UserID(r))
+	} else {
+		labels, err = h.store.Labels(request.UserID(r))
+	}
+
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, labels)
+}
+
+func (h *handler) createLabelHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var labelCreationRequest model.LabelCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&labelCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateLabelCreation(h.store, userID, &labelCreationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	label, err := h.store.CreateLabel(userID, &labelCreationRequest)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, label)
+}
+
+func (h *handler) updateLabelHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	labelID := request.RouteInt64Param(r, "labelID")
+	if labelID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid label ID"))
+		return
+	}
+
+	label, err := h.store.Label(userID, labelID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if label == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	var labelModificationRequest model.LabelModificationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&labelModificationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateLabelModification(h.store, userID, label.ID, &labelModificationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	labelModificationRequest.Patch(label)
+
+	if err := h.store.UpdateLabel(label); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, label)
+}
+
+func (h *handler) removeLabelHandler(w http.ResponseWriter, r *http.Request) {
+	labelID := request.RouteInt64Param(r, "labelID")
+	if labelID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid label ID"))
+		return
+	}
+
+	if err := h.store.RemoveLabel(request.UserID(r), labelID); err != nil {
+		if errors.Is(err, storage.ErrLabelNotFound) {
+			response.JSONNotFound(w, r)
+			return
+		}
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
+
+func (h *handler) mergeLabelsHandler(w http.ResponseWriter, r *http.Request) {
+	labelID := request.RouteInt64Param(r, "labelID")
+	if labelID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid label ID"))
+		return
+	}
+
+	var labelMergeRequest model.LabelMergeRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&labelMergeRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if labelMergeRequest.DestinationLabelID == 0 || labelMergeRequest.DestinationLabelID == labelID {
+		response.JSONBadRequest(w, r, errors.New("invalid destination label ID"))
+		return
+	}
+
+	if err := h.store.MergeLabels(request.UserID(r), labelID, labelMergeRequest.DestinationLabelID); err != nil {
+		if errors.Is(err, storage.ErrLabelNotFound) {
+			response.JSONNotFound(w, r)
+			return
+		}
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
+
+func (h *handler) updateEntryLabelsHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	entryID := request.RouteInt64Param(r, "entryID")
+	if entryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid entry ID"))
+		return
+	}
+
+	var entryLabelsUpdateRequest model.EntryLabelsUpdateRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&entryLabelsUpdateRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if err := validator.ValidateEntryLabelsUpdateRequest(&entryLabelsUpdateRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).
+		WithEntryIDs(entryID).
+		GetEntry()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	exists, err := h.store.LabelIDsExist(userID, entryLabelsUpdateRequest.LabelIDs)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if !exists {
+		response.JSONBadRequest(w, r, errors.New("invalid label ID"))
+		return
+	}
+
+	if err := h.store.SetEntryLabels(userID, entry.ID, entryLabelsUpdateRequest.LabelIDs); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	labels, err := h.store.LabelsByEntryIDs([]int64{entry.ID})
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	entry.Labels = labels[entry.ID]
+	if entry.Labels == nil {
+		entry.Labels = make(model.Labels, 0)
+	}
+
+	response.JSONCreated(w, r, entry)
+}
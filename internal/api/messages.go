@@ -18,6 +18,16 @@ type entriesResponse struct {
 	Entries model.Entries `json:"entries"`
 }
 
+type highlightsResponse struct {
+	Total      int              `json:"total"`
+	Highlights model.Highlights `json:"highlights"`
+}
+
+type auditLogResponse struct {
+	Total   int                   `json:"total"`
+	Entries model.AuditLogEntries `json:"entries"`
+}
+
 type integrationsStatusResponse struct {
 	HasIntegrations bool `json:"has_integrations"`
 }
@@ -7,9 +7,13 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
+	"time"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ratelimit"
 	"miniflux.app/v2/internal/storage"
 )
 
@@ -49,7 +53,36 @@ func (m *middleware) validateAPIKeyAuth(next http.Handler) http.Handler {
 			return
 		}
 
-		user, err := m.store.UserByAPIKey(token)
+		apiKey, err := m.store.APIKeyByToken(token)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		if apiKey == nil {
+			slog.Warn("[API] No user found with the provided API key",
+				slog.Bool("authentication_failed", true),
+				slog.String("client_ip", clientIP),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("request_uri", r.RequestURI),
+			)
+			response.JSONUnauthorized(w, r)
+			return
+		}
+
+		if apiKey.IsExpired() {
+			slog.Warn("[API] The provided API key has expired",
+				slog.Bool("authentication_failed", true),
+				slog.String("client_ip", clientIP),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("request_uri", r.RequestURI),
+				slog.Int64("api_key_id", apiKey.ID),
+			)
+			response.JSONUnauthorized(w, r)
+			return
+		}
+
+		user, err := m.store.UserByID(apiKey.UserID)
 		if err != nil {
 			response.JSONServerError(w, r, err)
 			return
@@ -75,18 +108,38 @@ func (m *middleware) validateAPIKeyAuth(next http.Handler) http.Handler {
 		)
 
 		m.store.SetLastLogin(user.ID)
-		m.store.SetAPIKeyUsedTimestamp(user.ID, token)
+		m.store.SetAPIKeyUsedTimestamp(user.ID, token, clientIP)
 
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, request.UserIDContextKey, user.ID)
 		ctx = context.WithValue(ctx, request.UserTimezoneContextKey, user.Timezone)
 		ctx = context.WithValue(ctx, request.IsAdminUserContextKey, user.IsAdmin)
 		ctx = context.WithValue(ctx, request.IsAuthenticatedContextKey, true)
+		ctx = context.WithValue(ctx, request.APIKeyContextKey, apiKey)
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// restrictAPIKey rejects the requests authenticated with an API key that is not allowed to use the endpoint.
+// The requests using Basic Authentication are not restricted.
+func (m *middleware) restrictAPIKey(allowed func(*model.APIKey) bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if apiKey := request.APIKey(r); apiKey != nil && !allowed(apiKey) {
+			slog.Warn("[API] The API key does not grant access to this endpoint",
+				slog.String("client_ip", request.ClientIP(r)),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("request_uri", r.RequestURI),
+				slog.Int64("api_key_id", apiKey.ID),
+			)
+			response.JSONForbidden(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (m *middleware) validateBasicAuth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if request.IsAuthenticated(r) {
@@ -120,6 +173,20 @@ func (m *middleware) validateBasicAuth(next http.Handler) http.Handler {
 			return
 		}
 
+		rateLimitKeys := ratelimit.LoginKeys(clientIP, username)
+		if lockedUntil := ratelimit.LockedUntil(m.store, ratelimit.SourceAPI, rateLimitKeys...); lockedUntil != nil {
+			slog.Warn("[API] Basic HTTP Authentication blocked after too many failed attempts",
+				slog.Bool("authentication_failed", true),
+				slog.String("client_ip", clientIP),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("username", username),
+				slog.String("request_uri", r.RequestURI),
+				slog.Time("locked_until", *lockedUntil),
+			)
+			response.JSONTooManyRequests(w, r, time.Until(*lockedUntil))
+			return
+		}
+
 		if err := m.store.CheckPassword(username, password); err != nil {
 			slog.Warn("[API] Invalid username or password provided during Basic HTTP Authentication",
 				slog.Bool("authentication_failed", true),
@@ -128,6 +195,8 @@ func (m *middleware) validateBasicAuth(next http.Handler) http.Handler {
 				slog.String("username", username),
 				slog.String("request_uri", r.RequestURI),
 			)
+			audit.Record(m.store, r, nil, model.AuditLogActionLoginFailed, audit.LoginFailureTarget(ratelimit.SourceAPI, username))
+			ratelimit.RecordFailure(m.store, ratelimit.SourceAPI, rateLimitKeys...)
 			response.JSONUnauthorized(w, r)
 			return
 		}
@@ -150,6 +219,26 @@ func (m *middleware) validateBasicAuth(next http.Handler) http.Handler {
 			return
 		}
 
+		// The password alone is not enough for the accounts protected by the two-factor authentication, they must use an API key.
+		twoFactorEnabled, err := m.store.HasTwoFactorAuthentication(user.ID)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		if twoFactorEnabled {
+			slog.Warn("[API] Basic HTTP Authentication rejected for a user with two-factor authentication",
+				slog.Bool("authentication_failed", true),
+				slog.String("client_ip", clientIP),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("username", username),
+				slog.String("request_uri", r.RequestURI),
+			)
+			audit.Record(m.store, r, user, model.AuditLogActionLoginFailed, ratelimit.SourceAPI)
+			response.JSONUnauthorized(w, r)
+			return
+		}
+
 		slog.Info("[API] User authenticated successfully with the Basic HTTP Authentication",
 			slog.Bool("authentication_successful", true),
 			slog.String("client_ip", clientIP),
@@ -157,6 +246,8 @@ func (m *middleware) validateBasicAuth(next http.Handler) http.Handler {
 			slog.String("username", username),
 			slog.String("request_uri", r.RequestURI),
 		)
+		audit.Record(m.store, r, user, model.AuditLogActionLogin, ratelimit.SourceAPI)
+		ratelimit.RecordSuccess(m.store, ratelimit.SourceAPI, ratelimit.UserKey(user.Username))
 
 		m.store.SetLastLogin(user.ID)
 
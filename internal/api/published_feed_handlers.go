@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) getPublishedFeedsHandler(w http.ResponseWriter, r *http.Request) {
+	publishedFeeds, err := h.store.PublishedFeeds(request.UserID(r))
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, publishedFeeds)
+}
+
+func (h *handler) createPublishedFeedHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var publishedFeedCreationRequest model.PublishedFeedCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&publishedFeedCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	publishedFeedCreationRequest.Tag = strings.TrimSpace(publishedFeedCreationRequest.Tag)
+	publishedFeedCreationRequest.Title = strings.TrimSpace(publishedFeedCreationRequest.Title)
+
+	if validationErr := validator.ValidatePublishedFeedCreation(h.store, userID, &publishedFeedCreationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	publishedFeed, err := h.store.CreatePublishedFeed(userID, &publishedFeedCreationRequest)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, publishedFeed)
+}
+
+func (h *handler) removePublishedFeedHandler(w http.ResponseWriter, r *http.Request) {
+	publishedFeedID := request.RouteInt64Param(r, "publishedFeedID")
+	if publishedFeedID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid published feed ID"))
+		return
+	}
+
+	if err := h.store.RemovePublishedFeed(request.UserID(r), publishedFeedID); err != nil {
+		if errors.Is(err, storage.ErrPublishedFeedNotFound) {
+			response.JSONNotFound(w, r)
+			return
+		}
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) getReadLaterEntriesHandler(w http.ResponseWriter, r *http.Request) {
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := validator.ValidateRange(offset, limit); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	entries, count, err := h.store.NewEntryQueryBuilder(request.UserID(r)).
+		WithReadLater().
+		WithReadLaterSorting().
+		WithOffset(offset).
+		WithLimit(limit).
+		WithEnclosures().
+		GetEntriesWithCount()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	for i := range entries {
+		entries[i].Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(entries[i].Content)
+		entries[i].Enclosures.ProxifyEnclosureURL(config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
+	}
+
+	response.JSON(w, r, &entriesResponse{Total: count, Entries: entries})
+}
+
+func (h *handler) addToReadLaterHandler(w http.ResponseWriter, r *http.Request) {
+	var additionRequest model.ReadLaterAdditionRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&additionRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if err := validator.ValidateReadLaterAdditionRequest(&additionRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.AddToReadLater(request.UserID(r), additionRequest.EntryIDs); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
+
+func (h *handler) moveReadLaterEntryHandler(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	if entryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid entry ID"))
+		return
+	}
+
+	var moveRequest model.ReadLaterMoveRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&moveRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if err := validator.ValidateReadLaterMoveRequest(&moveRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	err := h.store.MoveReadLaterEntry(request.UserID(r), entryID, moveRequest.Position)
+	switch {
+	case errors.Is(err, storage.ErrReadLaterEntryNotFound):
+		response.JSONNotFound(w, r)
+		return
+	case err != nil:
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
+
+func (h *handler) removeFromReadLaterHandler(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	if entryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid entry ID"))
+		return
+	}
+
+	if err := h.store.RemoveFromReadLater(request.UserID(r), []int64{entryID}); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
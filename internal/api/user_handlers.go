@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/model"
@@ -53,6 +54,8 @@ func (h *handler) createUserHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.recordAuditLog(r, model.AuditLogActionUserCreated, audit.UserTarget(user))
+
 	response.JSONCreated(w, r, user)
 }
 
@@ -260,6 +263,8 @@ func (h *handler) removeUserHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.recordAuditLog(r, model.AuditLogActionUserDeleted, audit.UserTarget(user))
+
 	go func() {
 		if err := h.store.RemoveUser(user.ID); err != nil {
 			slog.Error("Unable to delete user",
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit records the security related actions of the users and the administrators.
+package audit // import "miniflux.app/v2/internal/audit"
+
+import (
+	"log/slog"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// commandLineUserAgent is recorded as user agent for the actions run on the server with the command line.
+const commandLineUserAgent = "command line"
+
+// Record appends the action of the user to the audit log, with the client IP and the user agent of the request.
+// The user is nil when the actor is unknown, the attempted username is then given as target.
+// The action is not prevented when the audit log cannot be written, the error is only logged.
+func Record(store *storage.Storage, r *http.Request, user *model.User, action, target string) {
+	entry := &model.AuditLogEntry{
+		Action:    action,
+		Target:    target,
+		IP:        request.ClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	if user != nil {
+		entry.UserID = &user.ID
+		entry.Username = user.Username
+	}
+
+	create(store, entry)
+}
+
+// RecordCommandLine appends an action run with the command line to the audit log.
+func RecordCommandLine(store *storage.Storage, action, target string) {
+	create(store, &model.AuditLogEntry{
+		Action:    action,
+		Target:    target,
+		UserAgent: commandLineUserAgent,
+	})
+}
+
+// UserTarget returns the target describing a user.
+func UserTarget(user *model.User) string {
+	return "user:" + user.Username
+}
+
+// LoginFailureTarget returns the target of a failed login from the given source, with the attempted username.
+func LoginFailureTarget(source, username string) string {
+	return source + ":user:" + username
+}
+
+func create(store *storage.Storage, entry *model.AuditLogEntry) {
+	if err := store.CreateAuditLogEntry(entry); err != nil {
+		slog.Error("Unable to write the audit log",
+			slog.String("action", entry.Action),
+			slog.String("target", entry.Target),
+			slog.Any("error", err),
+		)
+	}
+}
@@ -22,6 +22,22 @@ func runCleanupTasks(store *storage.Storage) {
 		)
 	}
 
+	if nbAuditLogEntries, err := store.CleanOldAuditLog(config.Opts.CleanupRemoveAuditLogInterval()); err != nil {
+		slog.Error("Unable to clean old audit log entries", slog.Any("error", err))
+	} else {
+		slog.Info("Audit log cleanup completed",
+			slog.Int64("audit_log_entries_removed", nbAuditLogEntries),
+		)
+	}
+
+	if nbLoginAttempts, err := store.CleanOldLoginAttempts(config.Opts.LoginRateLimitMaxLockoutDuration()); err != nil {
+		slog.Error("Unable to clean old login attempts", slog.Any("error", err))
+	} else {
+		slog.Info("Login attempts cleanup completed",
+			slog.Int64("login_attempts_removed", nbLoginAttempts),
+		)
+	}
+
 	startTime := time.Now()
 	if rowsAffected, err := store.ArchiveEntries(model.EntryStatusRead, config.Opts.CleanupArchiveReadInterval(), config.Opts.CleanupArchiveBatchSize()); err != nil {
 		slog.Error("Unable to archive read entries", slog.Any("error", err))
@@ -55,4 +71,12 @@ func runCleanupTasks(store *storage.Storage) {
 			slog.Int64("orphan_icons_removed", nbIcons),
 		)
 	}
+
+	if nbMedia, err := store.CleanupOrphanArchivedMedia(); err != nil {
+		slog.Error("Unable to clean orphan archived media", slog.Any("error", err))
+	} else {
+		slog.Info("Orphan archived media cleanup completed",
+			slog.Int64("orphan_archived_media_removed", nbMedia),
+		)
+	}
 }
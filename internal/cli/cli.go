@@ -12,6 +12,7 @@ import (
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/database"
+	"miniflux.app/v2/internal/mediaproxy"
 	"miniflux.app/v2/internal/proxyrotator"
 	"miniflux.app/v2/internal/storage"
 	"miniflux.app/v2/internal/ui/static"
@@ -25,6 +26,7 @@ const (
 	flagFlushSessionsHelp    = "Flush all sessions (disconnect users)"
 	flagCreateAdminHelp      = "Create an admin user from an interactive terminal"
 	flagResetPasswordHelp    = "Reset user password"
+	flagResetTwoFactorHelp   = "Disable the two-factor authentication of a user (provide the username as argument)"
 	flagResetFeedErrorsHelp  = "Clear all feed errors for all users"
 	flagDebugModeHelp        = "Show debug logs"
 	flagConfigFileHelp       = "Load configuration file"
@@ -46,6 +48,7 @@ func Parse() {
 		flagFlushSessions        bool
 		flagCreateAdmin          bool
 		flagResetPassword        bool
+		flagResetTwoFactor       string
 		flagResetFeedErrors      bool
 		flagResetFeedNextCheckAt bool
 		flagDebugMode            bool
@@ -65,6 +68,7 @@ func Parse() {
 	flag.BoolVar(&flagFlushSessions, "flush-sessions", false, flagFlushSessionsHelp)
 	flag.BoolVar(&flagCreateAdmin, "create-admin", false, flagCreateAdminHelp)
 	flag.BoolVar(&flagResetPassword, "reset-password", false, flagResetPasswordHelp)
+	flag.StringVar(&flagResetTwoFactor, "reset-two-factor", "", flagResetTwoFactorHelp)
 	flag.BoolVar(&flagResetFeedErrors, "reset-feed-errors", false, flagResetFeedErrorsHelp)
 	flag.BoolVar(&flagResetFeedNextCheckAt, "reset-feed-next-check-at", false, flagResetNextCheckAtHelp)
 	flag.BoolVar(&flagDebugMode, "debug", false, flagDebugModeHelp)
@@ -212,6 +216,11 @@ func Parse() {
 		return
 	}
 
+	if flagResetTwoFactor != "" {
+		resetTwoFactor(store, flagResetTwoFactor)
+		return
+	}
+
 	// Run migrations and start the daemon.
 	if config.Opts.RunMigrations() {
 		if err := database.Migrate(db); err != nil {
@@ -235,6 +244,14 @@ func Parse() {
 		}
 	}
 
+	if config.Opts.MediaProxyCacheDirectory() != "" {
+		slog.Info("Initializing media proxy cache", slog.String("directory", config.Opts.MediaProxyCacheDirectory()))
+		mediaproxy.CacheInstance, err = mediaproxy.NewCache(config.Opts.MediaProxyCacheDirectory(), config.Opts.MediaProxyCacheMaxSize())
+		if err != nil {
+			printfAndExit("unable to initialize media proxy cache: %v", err)
+		}
+	}
+
 	if flagRefreshFeeds {
 		refreshFeeds(store)
 		return
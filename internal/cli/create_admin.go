@@ -6,6 +6,7 @@ package cli // import "miniflux.app/v2/internal/cli"
 import (
 	"log/slog"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
@@ -46,5 +47,6 @@ func createAdminUser(store *storage.Storage, username, password string) {
 			slog.String("username", user.Username),
 			slog.Int64("user_id", user.ID),
 		)
+		audit.RecordCommandLine(store, model.AuditLogActionUserCreated, audit.UserTarget(user))
 	}
 }
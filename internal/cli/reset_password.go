@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
 	"miniflux.app/v2/internal/validator"
@@ -35,5 +36,7 @@ func resetPassword(store *storage.Storage) {
 		printErrorAndExit(err)
 	}
 
+	audit.RecordCommandLine(store, model.AuditLogActionPasswordReset, audit.UserTarget(user))
+
 	fmt.Println("Password changed!")
 }
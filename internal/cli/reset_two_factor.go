@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli // import "miniflux.app/v2/internal/cli"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/audit"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+func resetTwoFactor(store *storage.Storage, username string) {
+	user, err := store.UserByUsername(username)
+	if err != nil {
+		printfAndExit("unable to find user: %w", err)
+	}
+
+	if user == nil {
+		printfAndExit("user %q not found", username)
+	}
+
+	if err := store.RemoveTOTP(user.ID); err != nil {
+		printErrorAndExit(err)
+	}
+
+	audit.RecordCommandLine(store, model.AuditLogActionTwoFactorDisabled, audit.UserTarget(user))
+
+	fmt.Println("Two-factor authentication disabled!")
+}
@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/reader/archiver"
 	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/websub"
 	"miniflux.app/v2/internal/worker"
 )
 
@@ -28,6 +30,23 @@ func runScheduler(store *storage.Storage, pool *worker.Pool) {
 		store,
 		config.Opts.CleanupFrequency(),
 	)
+
+	if config.Opts.OfflineArchive() {
+		go archiveScheduler(
+			store,
+			config.Opts.OfflineArchiveFrequency(),
+			config.Opts.OfflineArchiveBatchSize(),
+			config.Opts.OfflineArchiveMaxSizePerUser(),
+		)
+	}
+
+	if config.Opts.WebSub() {
+		go webSubScheduler(
+			store,
+			config.Opts.WebSubSubscriptionFrequency(),
+			config.Opts.BatchSize(),
+		)
+	}
 }
 
 func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency time.Duration, batchSize, errorLimit, limitPerHost int) {
@@ -55,3 +74,15 @@ func cleanupScheduler(store *storage.Storage, frequency time.Duration) {
 		runCleanupTasks(store)
 	}
 }
+
+func archiveScheduler(store *storage.Storage, frequency time.Duration, batchSize int, maxSizePerUser int64) {
+	for range time.Tick(frequency) {
+		archiver.ArchivePendingEntries(store, batchSize, maxSizePerUser)
+	}
+}
+
+func webSubScheduler(store *storage.Storage, frequency time.Duration, batchSize int) {
+	for range time.Tick(frequency) {
+		websub.RequestSubscriptions(store, frequency, batchSize)
+	}
+}
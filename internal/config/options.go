@@ -149,6 +149,11 @@ func NewConfigOptions() *configOptions {
 					return validateGreaterOrEqualThan(rawValue, 1)
 				},
 			},
+			"CLEANUP_REMOVE_AUDIT_LOG_DAYS": {
+				parsedDuration: time.Hour * 24 * 365,
+				rawValue:       "365",
+				valueType:      dayType,
+			},
 			"CLEANUP_REMOVE_SESSIONS_DAYS": {
 				parsedDuration: time.Hour * 24 * 30,
 				rawValue:       "30",
@@ -220,6 +225,30 @@ func NewConfigOptions() *configOptions {
 				rawValue:        "0",
 				valueType:       boolType,
 			},
+			"ENTRY_REVISION_HISTORY_SIZE": {
+				parsedIntValue: 10,
+				rawValue:       "10",
+				valueType:      intType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 0)
+				},
+			},
+			"FEED_REFRESH_HISTORY_SIZE": {
+				parsedIntValue: 50,
+				rawValue:       "50",
+				valueType:      intType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 0)
+				},
+			},
+			"FEED_URL_MIGRATION_THRESHOLD": {
+				parsedIntValue: 3,
+				rawValue:       "3",
+				valueType:      intType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 0)
+				},
+			},
 			"FETCHER_ALLOW_PRIVATE_NETWORKS": {
 				parsedBoolValue: false,
 				rawValue:        "0",
@@ -319,6 +348,30 @@ func NewConfigOptions() *configOptions {
 				rawValue:         "127.0.0.1:8080",
 				valueType:        stringListType,
 			},
+			"LOGIN_RATE_LIMIT_LOCKOUT_DURATION": {
+				parsedDuration: 60 * time.Second,
+				rawValue:       "60",
+				valueType:      secondType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
+			"LOGIN_RATE_LIMIT_MAX_ATTEMPTS": {
+				parsedIntValue: 5,
+				rawValue:       "5",
+				valueType:      intType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 0)
+				},
+			},
+			"LOGIN_RATE_LIMIT_MAX_LOCKOUT_DURATION": {
+				parsedDuration: 3600 * time.Second,
+				rawValue:       "3600",
+				valueType:      secondType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
 			"LOG_DATE_TIME": {
 				parsedBoolValue: false,
 				rawValue:        "0",
@@ -355,6 +408,24 @@ func NewConfigOptions() *configOptions {
 				rawValue:        "0",
 				valueType:       boolType,
 			},
+			"MEDIA_PROXY_CACHE_DIRECTORY": {
+				parsedStringValue: "",
+				rawValue:          "",
+				valueType:         stringType,
+			},
+			"MEDIA_PROXY_CACHE_MAX_SIZE": {
+				parsedInt64Value: 1024,
+				rawValue:         "1024",
+				valueType:        int64Type,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
+			"MEDIA_PROXY_CACHE_PREFETCH": {
+				parsedBoolValue: false,
+				rawValue:        "0",
+				valueType:       boolType,
+			},
 			"MEDIA_PROXY_CUSTOM_URL": {
 				rawValue:  "",
 				valueType: urlType,
@@ -484,6 +555,35 @@ func NewConfigOptions() *configOptions {
 				rawValue:        "0",
 				valueType:       boolType,
 			},
+			"OFFLINE_ARCHIVE": {
+				parsedBoolValue: false,
+				rawValue:        "0",
+				valueType:       boolType,
+			},
+			"OFFLINE_ARCHIVE_BATCH_SIZE": {
+				parsedIntValue: 20,
+				rawValue:       "20",
+				valueType:      intType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
+			"OFFLINE_ARCHIVE_FREQUENCY": {
+				parsedDuration: 5 * time.Minute,
+				rawValue:       "5",
+				valueType:      minuteType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
+			"OFFLINE_ARCHIVE_MAX_SIZE_PER_USER": {
+				parsedInt64Value: 1024,
+				rawValue:         "1024",
+				valueType:        int64Type,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 0)
+				},
+			},
 			"POLLING_FREQUENCY": {
 				parsedDuration: 60 * time.Minute,
 				rawValue:       "60",
@@ -508,6 +608,14 @@ func NewConfigOptions() *configOptions {
 					return validateGreaterOrEqualThan(rawValue, 0)
 				},
 			},
+			"POLLING_SHARED_FETCH_WINDOW": {
+				parsedDuration: 0,
+				rawValue:       "0",
+				valueType:      minuteType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 0)
+				},
+			},
 			"POLLING_SCHEDULER": {
 				parsedStringValue: "round_robin",
 				rawValue:          "round_robin",
@@ -597,6 +705,27 @@ func NewConfigOptions() *configOptions {
 				rawValue:        "0",
 				valueType:       boolType,
 			},
+			"WEBSUB": {
+				parsedBoolValue: false,
+				rawValue:        "0",
+				valueType:       boolType,
+			},
+			"WEBSUB_FALLBACK_POLLING_INTERVAL": {
+				parsedDuration: 1440 * time.Minute,
+				rawValue:       "1440",
+				valueType:      minuteType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
+			"WEBSUB_SUBSCRIPTION_FREQUENCY": {
+				parsedDuration: 60 * time.Minute,
+				rawValue:       "60",
+				valueType:      minuteType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
 			"WORKER_POOL_SIZE": {
 				parsedIntValue: 16,
 				rawValue:       "16",
@@ -676,6 +805,10 @@ func (c *configOptions) CleanupFrequency() time.Duration {
 	return c.options["CLEANUP_FREQUENCY_HOURS"].parsedDuration
 }
 
+func (c *configOptions) CleanupRemoveAuditLogInterval() time.Duration {
+	return c.options["CLEANUP_REMOVE_AUDIT_LOG_DAYS"].parsedDuration
+}
+
 func (c *configOptions) CleanupRemoveSessionsInterval() time.Duration {
 	return c.options["CLEANUP_REMOVE_SESSIONS_DAYS"].parsedDuration
 }
@@ -716,6 +849,18 @@ func (c *configOptions) DisableSchedulerService() bool {
 	return c.options["DISABLE_SCHEDULER_SERVICE"].parsedBoolValue
 }
 
+func (c *configOptions) EntryRevisionHistorySize() int {
+	return c.options["ENTRY_REVISION_HISTORY_SIZE"].parsedIntValue
+}
+
+func (c *configOptions) FeedRefreshHistorySize() int {
+	return c.options["FEED_REFRESH_HISTORY_SIZE"].parsedIntValue
+}
+
+func (c *configOptions) FeedURLMigrationThreshold() int {
+	return c.options["FEED_URL_MIGRATION_THRESHOLD"].parsedIntValue
+}
+
 func (c *configOptions) FetchBilibiliWatchTime() bool {
 	return c.options["FETCH_BILIBILI_WATCH_TIME"].parsedBoolValue
 }
@@ -842,6 +987,18 @@ func (c *configOptions) LogFile() string {
 	return c.options["LOG_FILE"].parsedStringValue
 }
 
+func (c *configOptions) LoginRateLimitLockoutDuration() time.Duration {
+	return c.options["LOGIN_RATE_LIMIT_LOCKOUT_DURATION"].parsedDuration
+}
+
+func (c *configOptions) LoginRateLimitMaxAttempts() int {
+	return c.options["LOGIN_RATE_LIMIT_MAX_ATTEMPTS"].parsedIntValue
+}
+
+func (c *configOptions) LoginRateLimitMaxLockoutDuration() time.Duration {
+	return c.options["LOGIN_RATE_LIMIT_MAX_LOCKOUT_DURATION"].parsedDuration
+}
+
 func (c *configOptions) LogDateTime() bool {
 	return c.options["LOG_DATE_TIME"].parsedBoolValue
 }
@@ -862,6 +1019,18 @@ func (c *configOptions) MaintenanceMode() bool {
 	return c.options["MAINTENANCE_MODE"].parsedBoolValue
 }
 
+func (c *configOptions) MediaProxyCacheDirectory() string {
+	return c.options["MEDIA_PROXY_CACHE_DIRECTORY"].parsedStringValue
+}
+
+func (c *configOptions) MediaProxyCacheMaxSize() int64 {
+	return c.options["MEDIA_PROXY_CACHE_MAX_SIZE"].parsedInt64Value * 1024 * 1024
+}
+
+func (c *configOptions) MediaProxyCachePrefetch() bool {
+	return c.options["MEDIA_PROXY_CACHE_PREFETCH"].parsedBoolValue
+}
+
 func (c *configOptions) MediaCustomProxyURL() *url.URL {
 	return c.options["MEDIA_PROXY_CUSTOM_URL"].parsedURLValue
 }
@@ -930,6 +1099,22 @@ func (c *configOptions) OAuth2UserCreation() bool {
 	return c.options["OAUTH2_USER_CREATION"].parsedBoolValue
 }
 
+func (c *configOptions) OfflineArchive() bool {
+	return c.options["OFFLINE_ARCHIVE"].parsedBoolValue
+}
+
+func (c *configOptions) OfflineArchiveBatchSize() int {
+	return c.options["OFFLINE_ARCHIVE_BATCH_SIZE"].parsedIntValue
+}
+
+func (c *configOptions) OfflineArchiveFrequency() time.Duration {
+	return c.options["OFFLINE_ARCHIVE_FREQUENCY"].parsedDuration
+}
+
+func (c *configOptions) OfflineArchiveMaxSizePerUser() int64 {
+	return c.options["OFFLINE_ARCHIVE_MAX_SIZE_PER_USER"].parsedInt64Value * 1024 * 1024
+}
+
 func (c *configOptions) PollingFrequency() time.Duration {
 	return c.options["POLLING_FREQUENCY"].parsedDuration
 }
@@ -942,6 +1127,10 @@ func (c *configOptions) PollingParsingErrorLimit() int {
 	return c.options["POLLING_PARSING_ERROR_LIMIT"].parsedIntValue
 }
 
+func (c *configOptions) PollingSharedFetchWindow() time.Duration {
+	return c.options["POLLING_SHARED_FETCH_WINDOW"].parsedDuration
+}
+
 func (c *configOptions) PollingScheduler() string {
 	return c.options["POLLING_SCHEDULER"].parsedStringValue
 }
@@ -1000,6 +1189,18 @@ func (c *configOptions) WebAuthn() bool {
 	return c.options["WEBAUTHN"].parsedBoolValue
 }
 
+func (c *configOptions) WebSub() bool {
+	return c.options["WEBSUB"].parsedBoolValue
+}
+
+func (c *configOptions) WebSubFallbackPollingInterval() time.Duration {
+	return c.options["WEBSUB_FALLBACK_POLLING_INTERVAL"].parsedDuration
+}
+
+func (c *configOptions) WebSubSubscriptionFrequency() time.Duration {
+	return c.options["WEBSUB_SUBSCRIPTION_FREQUENCY"].parsedDuration
+}
+
 func (c *configOptions) WorkerPoolSize() int {
 	return c.options["WORKER_POOL_SIZE"].parsedIntValue
 }
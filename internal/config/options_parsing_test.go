@@ -112,6 +112,58 @@ func TestWebAuthnOptionParsing(t *testing.T) {
 	}
 }
 
+func TestWebSubOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.WebSub() {
+		t.Fatalf("Expected WEBSUB to be disabled by default")
+	}
+
+	if err := configParser.parseLines([]string{"WEBSUB=1"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !configParser.options.WebSub() {
+		t.Fatalf("Expected WEBSUB to be enabled")
+	}
+}
+
+func TestWebSubFallbackPollingIntervalOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.WebSubFallbackPollingInterval().Minutes() != 1440 {
+		t.Fatalf("Expected WEBSUB_FALLBACK_POLLING_INTERVAL to be 1440 minutes by default")
+	}
+
+	if err := configParser.parseLines([]string{"WEBSUB_FALLBACK_POLLING_INTERVAL=360"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.WebSubFallbackPollingInterval().Minutes() != 360 {
+		t.Fatalf("Expected WEBSUB_FALLBACK_POLLING_INTERVAL to be 360 minutes")
+	}
+
+	if err := configParser.parseLines([]string{"WEBSUB_FALLBACK_POLLING_INTERVAL=0"}); err == nil {
+		t.Fatalf("Expected an error for an interval lower than 1 minute")
+	}
+}
+
+func TestWebSubSubscriptionFrequencyOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.WebSubSubscriptionFrequency().Minutes() != 60 {
+		t.Fatalf("Expected WEBSUB_SUBSCRIPTION_FREQUENCY to be 60 minutes by default")
+	}
+
+	if err := configParser.parseLines([]string{"WEBSUB_SUBSCRIPTION_FREQUENCY=15"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.WebSubSubscriptionFrequency().Minutes() != 15 {
+		t.Fatalf("Expected WEBSUB_SUBSCRIPTION_FREQUENCY to be 15 minutes")
+	}
+}
+
 func TestWorkerPoolSizeOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -504,6 +556,62 @@ func TestDisableSchedulerServiceOptionParsing(t *testing.T) {
 	}
 }
 
+func TestEntryRevisionHistorySizeOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.EntryRevisionHistorySize() != 10 {
+		t.Fatalf("Expected ENTRY_REVISION_HISTORY_SIZE to be 10 by default")
+	}
+
+	if err := configParser.parseLines([]string{"ENTRY_REVISION_HISTORY_SIZE=0"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.EntryRevisionHistorySize() != 0 {
+		t.Fatalf("Expected ENTRY_REVISION_HISTORY_SIZE to be 0")
+	}
+
+	if err := configParser.parseLines([]string{"ENTRY_REVISION_HISTORY_SIZE=-1"}); err == nil {
+		t.Fatal("Expected error for negative ENTRY_REVISION_HISTORY_SIZE")
+	}
+}
+
+func TestFeedRefreshHistorySizeOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.FeedRefreshHistorySize() != 50 {
+		t.Fatalf("Expected FEED_REFRESH_HISTORY_SIZE to be 50 by default")
+	}
+
+	if err := configParser.parseLines([]string{"FEED_REFRESH_HISTORY_SIZE=10"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.FeedRefreshHistorySize() != 10 {
+		t.Fatalf("Expected FEED_REFRESH_HISTORY_SIZE to be 10")
+	}
+
+	if err := configParser.parseLines([]string{"FEED_REFRESH_HISTORY_SIZE=-1"}); err == nil {
+		t.Fatal("Expected error for negative FEED_REFRESH_HISTORY_SIZE")
+	}
+}
+
+func TestFeedURLMigrationThresholdOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.FeedURLMigrationThreshold() != 3 {
+		t.Fatalf("Expected FEED_URL_MIGRATION_THRESHOLD to be 3 by default")
+	}
+
+	if err := configParser.parseLines([]string{"FEED_URL_MIGRATION_THRESHOLD=0"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.FeedURLMigrationThreshold() != 0 {
+		t.Fatalf("Expected FEED_URL_MIGRATION_THRESHOLD to be 0")
+	}
+}
+
 func TestFetchBilibiliWatchTimeOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -690,6 +798,46 @@ func TestCertKeyFileOptionParsing(t *testing.T) {
 	}
 }
 
+func TestLoginRateLimitOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.LoginRateLimitMaxAttempts() != 5 {
+		t.Fatalf("Expected LOGIN_RATE_LIMIT_MAX_ATTEMPTS to be 5 by default")
+	}
+
+	if configParser.options.LoginRateLimitLockoutDuration().Seconds() != 60 {
+		t.Fatalf("Expected LOGIN_RATE_LIMIT_LOCKOUT_DURATION to be 60 seconds by default")
+	}
+
+	if configParser.options.LoginRateLimitMaxLockoutDuration().Seconds() != 3600 {
+		t.Fatalf("Expected LOGIN_RATE_LIMIT_MAX_LOCKOUT_DURATION to be 3600 seconds by default")
+	}
+
+	if err := configParser.parseLines([]string{
+		"LOGIN_RATE_LIMIT_MAX_ATTEMPTS=0",
+		"LOGIN_RATE_LIMIT_LOCKOUT_DURATION=30",
+		"LOGIN_RATE_LIMIT_MAX_LOCKOUT_DURATION=600",
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.LoginRateLimitMaxAttempts() != 0 {
+		t.Fatalf("Expected LOGIN_RATE_LIMIT_MAX_ATTEMPTS to be 0")
+	}
+
+	if configParser.options.LoginRateLimitLockoutDuration().Seconds() != 30 {
+		t.Fatalf("Expected LOGIN_RATE_LIMIT_LOCKOUT_DURATION to be 30 seconds")
+	}
+
+	if configParser.options.LoginRateLimitMaxLockoutDuration().Seconds() != 600 {
+		t.Fatalf("Expected LOGIN_RATE_LIMIT_MAX_LOCKOUT_DURATION to be 600 seconds")
+	}
+
+	if err := configParser.parseLines([]string{"LOGIN_RATE_LIMIT_MAX_ATTEMPTS=-1"}); err == nil {
+		t.Fatalf("Expected an error for a negative LOGIN_RATE_LIMIT_MAX_ATTEMPTS")
+	}
+}
+
 func TestLogDateTimeOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -1042,6 +1190,78 @@ func TestOAuth2UserCreationOptionParsing(t *testing.T) {
 	}
 }
 
+func TestOfflineArchiveOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.OfflineArchive() {
+		t.Fatalf("Expected OFFLINE_ARCHIVE to be disabled by default")
+	}
+
+	if err := configParser.parseLines([]string{"OFFLINE_ARCHIVE=1"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !configParser.options.OfflineArchive() {
+		t.Fatalf("Expected OFFLINE_ARCHIVE to be enabled")
+	}
+}
+
+func TestOfflineArchiveMaxSizePerUserOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.OfflineArchiveMaxSizePerUser() != 1024*1024*1024 {
+		t.Fatalf("Expected OFFLINE_ARCHIVE_MAX_SIZE_PER_USER to be 1024 megabytes by default")
+	}
+
+	if err := configParser.parseLines([]string{"OFFLINE_ARCHIVE_MAX_SIZE_PER_USER=0"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.OfflineArchiveMaxSizePerUser() != 0 {
+		t.Fatalf("Expected OFFLINE_ARCHIVE_MAX_SIZE_PER_USER to be 0")
+	}
+
+	if err := configParser.parseLines([]string{"OFFLINE_ARCHIVE_MAX_SIZE_PER_USER=-1"}); err == nil {
+		t.Fatal("Expected error for a negative OFFLINE_ARCHIVE_MAX_SIZE_PER_USER")
+	}
+}
+
+func TestOfflineArchiveBatchSizeOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.OfflineArchiveBatchSize() != 20 {
+		t.Fatalf("Expected OFFLINE_ARCHIVE_BATCH_SIZE to be 20 by default")
+	}
+
+	if err := configParser.parseLines([]string{"OFFLINE_ARCHIVE_BATCH_SIZE=5"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.OfflineArchiveBatchSize() != 5 {
+		t.Fatalf("Expected OFFLINE_ARCHIVE_BATCH_SIZE to be 5")
+	}
+
+	if err := configParser.parseLines([]string{"OFFLINE_ARCHIVE_BATCH_SIZE=0"}); err == nil {
+		t.Fatal("Expected error for OFFLINE_ARCHIVE_BATCH_SIZE lower than 1")
+	}
+}
+
+func TestOfflineArchiveFrequencyOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.OfflineArchiveFrequency().Minutes() != 5 {
+		t.Fatalf("Expected OFFLINE_ARCHIVE_FREQUENCY to be 5 minutes by default")
+	}
+
+	if err := configParser.parseLines([]string{"OFFLINE_ARCHIVE_FREQUENCY=30"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.OfflineArchiveFrequency().Minutes() != 30 {
+		t.Fatalf("Expected OFFLINE_ARCHIVE_FREQUENCY to be 30 minutes")
+	}
+}
+
 func TestPollingLimitPerHostOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -1074,6 +1294,22 @@ func TestPollingParsingErrorLimitOptionParsing(t *testing.T) {
 	}
 }
 
+func TestPollingSharedFetchWindowOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.PollingSharedFetchWindow() != 0 {
+		t.Fatalf("Expected POLLING_SHARED_FETCH_WINDOW to be disabled by default")
+	}
+
+	if err := configParser.parseLines([]string{"POLLING_SHARED_FETCH_WINDOW=10"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.PollingSharedFetchWindow().Minutes() != 10 {
+		t.Fatalf("Expected POLLING_SHARED_FETCH_WINDOW to be 10 minutes")
+	}
+}
+
 func TestPollingSchedulerOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -1249,6 +1485,22 @@ func TestCleanupFrequencyOptionParsing(t *testing.T) {
 	}
 }
 
+func TestCleanupRemoveAuditLogIntervalOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.CleanupRemoveAuditLogInterval().Hours() != 24*365 {
+		t.Fatalf("Expected CLEANUP_REMOVE_AUDIT_LOG_DAYS to be 365 days by default")
+	}
+
+	if err := configParser.parseLines([]string{"CLEANUP_REMOVE_AUDIT_LOG_DAYS=90"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.CleanupRemoveAuditLogInterval().Hours() != 24*90 {
+		t.Fatalf("Expected CLEANUP_REMOVE_AUDIT_LOG_DAYS to be 90 days")
+	}
+}
+
 func TestCleanupRemoveSessionsIntervalOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -1441,6 +1693,60 @@ func TestListenAddrOptionParsing(t *testing.T) {
 	}
 }
 
+func TestMediaProxyCacheDirectoryOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.MediaProxyCacheDirectory() != "" {
+		t.Fatalf("Expected MEDIA_PROXY_CACHE_DIRECTORY to be empty by default")
+	}
+
+	if err := configParser.parseLines([]string{"MEDIA_PROXY_CACHE_DIRECTORY=/var/cache/miniflux"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.MediaProxyCacheDirectory() != "/var/cache/miniflux" {
+		t.Fatalf("Expected MEDIA_PROXY_CACHE_DIRECTORY to be '/var/cache/miniflux'")
+	}
+}
+
+func TestMediaProxyCacheMaxSizeOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.MediaProxyCacheMaxSize() != 1024*1024*1024 {
+		t.Fatalf("Expected MEDIA_PROXY_CACHE_MAX_SIZE to be 1024 by default, got %d", configParser.options.MediaProxyCacheMaxSize())
+	}
+
+	if err := configParser.parseLines([]string{"MEDIA_PROXY_CACHE_MAX_SIZE=256"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedValue := 256 * 1024 * 1024
+	currentValue := configParser.options.MediaProxyCacheMaxSize()
+	if currentValue != int64(expectedValue) {
+		t.Fatalf("Expected MEDIA_PROXY_CACHE_MAX_SIZE to be %d, got %d", expectedValue, currentValue)
+	}
+
+	if err := configParser.parseLines([]string{"MEDIA_PROXY_CACHE_MAX_SIZE=0"}); err == nil {
+		t.Fatal("Expected error for MEDIA_PROXY_CACHE_MAX_SIZE=0")
+	}
+}
+
+func TestMediaProxyCachePrefetchOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.MediaProxyCachePrefetch() {
+		t.Fatal("Expected MEDIA_PROXY_CACHE_PREFETCH to be disabled by default")
+	}
+
+	if err := configParser.parseLines([]string{"MEDIA_PROXY_CACHE_PREFETCH=1"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !configParser.options.MediaProxyCachePrefetch() {
+		t.Fatal("Expected MEDIA_PROXY_CACHE_PREFETCH to be enabled")
+	}
+}
+
 func TestMediaCustomProxyURLOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
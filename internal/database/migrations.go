@@ -1557,4 +1557,329 @@ var migrations = [...]func(tx *sql.Tx) error{
 		`)
 		return err
 	},
+	func(tx *sql.Tx) (err error) {
+		// Labels are personal annotations attached by the user, unlike entries.tags
+		// which only carries the categories published by the feed itself.
+		_, err = tx.Exec(`
+			CREATE TABLE labels (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				title text not null check (title <> ''),
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+
+			CREATE UNIQUE INDEX labels_user_id_lower_title_idx
+				ON labels (user_id, lower(title));
+
+			CREATE TABLE entry_labels (
+				entry_id bigint not null references entries(id) on delete cascade,
+				label_id bigint not null references labels(id) on delete cascade,
+				created_at timestamp with time zone not null default now(),
+				primary key (entry_id, label_id)
+			);
+
+			CREATE INDEX entry_labels_label_id_idx
+				ON entry_labels (label_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// Saved searches are virtual feeds: the entries are computed at read time
+		// from the query text and the optional constraints.
+		_, err = tx.Exec(`
+			CREATE TABLE saved_searches (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				title text not null check (title <> ''),
+				query text not null default '',
+				status text not null default '' check (status in ('', 'unread', 'read')),
+				starred bool not null default false,
+				category_id int references categories(id) on delete cascade,
+				feed_id bigint references feeds(id) on delete cascade,
+				max_age_days int not null default 0,
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+
+			CREATE UNIQUE INDEX saved_searches_user_id_lower_title_idx
+				ON saved_searches (user_id, lower(title));
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE users ADD COLUMN block_filter_expression text not null default '';
+			ALTER TABLE categories ADD COLUMN block_filter_expression text not null default '';
+			ALTER TABLE feeds ADD COLUMN block_filter_expression text not null default '';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// Action rules are evaluated against new entries while refreshing feeds.
+		_, err = tx.Exec(`
+			ALTER TABLE entries ADD COLUMN priority int not null default 0;
+
+			CREATE TABLE action_rules (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				expression text not null check (expression <> ''),
+				action text not null check (action in ('mark_as_read', 'star', 'add_label', 'send_entry', 'set_priority')),
+				label_id bigint references labels(id) on delete cascade,
+				priority int not null default 0,
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+
+			CREATE INDEX action_rules_user_id_idx ON action_rules (user_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE categories ADD COLUMN feed_defaults jsonb not null default '{}'`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// Removing a category moves its subcategories to the top level.
+		_, err = tx.Exec(`
+			ALTER TABLE categories ADD COLUMN parent_id int references categories(id) on delete set null;
+			ALTER TABLE categories ADD CONSTRAINT categories_parent_id_check check (parent_id <> id);
+			CREATE INDEX categories_parent_id_idx ON categories (parent_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE feed_refresh_history (
+				id bigserial not null,
+				feed_id bigint not null references feeds(id) on delete cascade,
+				checked_at timestamp with time zone not null default now(),
+				status_code int not null default 0,
+				duration_ms int not null default 0,
+				body_size bigint not null default 0,
+				not_modified bool not null default false,
+				new_entries int not null default 0,
+				updated_entries int not null default 0,
+				error_message text not null default '',
+				primary key (id)
+			);
+
+			CREATE INDEX feed_refresh_history_feed_id_checked_at_idx ON feed_refresh_history (feed_id, checked_at desc);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE feeds ADD COLUMN pending_feed_url text not null default '';
+			ALTER TABLE feeds ADD COLUMN pending_feed_url_count int not null default 0;
+
+			CREATE TABLE feed_url_changes (
+				id bigserial not null,
+				feed_id bigint not null references feeds(id) on delete cascade,
+				old_url text not null,
+				new_url text not null,
+				reason text not null check (reason in ('permanent_redirect', 'declared_url')),
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+
+			CREATE INDEX feed_url_changes_feed_id_idx ON feed_url_changes (feed_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE feed_refresh_history ADD COLUMN shared bool not null default false`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE feeds ADD COLUMN archive_entries bool not null default false;
+
+			CREATE TABLE entry_archives (
+				entry_id bigint not null references entries(id) on delete cascade,
+				user_id int not null references users(id) on delete cascade,
+				url text not null,
+				title text not null,
+				content text not null,
+				created_at timestamp with time zone not null default now(),
+				primary key (entry_id)
+			);
+
+			CREATE TABLE archive_media (
+				id bigserial not null,
+				hash text not null unique,
+				mime_type text not null,
+				content bytea not null,
+				primary key (id)
+			);
+
+			CREATE TABLE entry_archive_media (
+				entry_id bigint not null references entry_archives(entry_id) on delete cascade,
+				media_id bigint not null references archive_media(id) on delete cascade,
+				url text not null,
+				url_hash text not null,
+				primary key (entry_id, url_hash)
+			);
+
+			CREATE INDEX entry_archive_media_url_hash_idx ON entry_archive_media (url_hash);
+			CREATE INDEX entry_archive_media_media_id_idx ON entry_archive_media (media_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE published_feeds (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				token text not null unique,
+				kind text not null,
+				category_id int references categories(id) on delete cascade,
+				label_id bigint references labels(id) on delete cascade,
+				tag text not null default '',
+				title text not null default '',
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+
+			CREATE INDEX published_feeds_user_idx ON published_feeds (user_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE entries ADD COLUMN revision_count int not null default 0;
+
+			CREATE TABLE entry_revisions (
+				id bigserial not null,
+				entry_id bigint not null references entries(id) on delete cascade,
+				title text not null,
+				content text not null,
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+
+			CREATE INDEX entry_revisions_entry_id_idx ON entry_revisions (entry_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE websub_subscriptions (
+				feed_id bigint not null references feeds(id) on delete cascade,
+				hub_url text not null,
+				topic_url text not null,
+				token text not null unique,
+				secret text not null,
+				state text not null default 'pending',
+				lease_expires_at timestamp with time zone,
+				requested_at timestamp with time zone,
+				last_push_at timestamp with time zone,
+				created_at timestamp with time zone not null default now(),
+				primary key (feed_id)
+			);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE read_later_entries (
+				entry_id bigint not null references entries(id) on delete cascade,
+				user_id int not null references users(id) on delete cascade,
+				position int not null,
+				created_at timestamp with time zone not null default now(),
+				primary key (entry_id)
+			);
+
+			CREATE INDEX read_later_entries_user_position_idx ON read_later_entries (user_id, position);
+
+			ALTER TABLE users ADD COLUMN remove_read_later_on_read bool not null default 'f';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE highlights (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				entry_id bigint not null references entries(id) on delete cascade,
+				text text not null,
+				prefix text not null default '',
+				suffix text not null default '',
+				start_offset int not null,
+				end_offset int not null,
+				note text not null default '',
+				created_at timestamp with time zone not null default now(),
+				updated_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+
+			CREATE INDEX highlights_user_id_idx ON highlights (user_id, created_at);
+			CREATE INDEX highlights_entry_id_idx ON highlights (entry_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE api_keys ADD COLUMN scopes text[] not null default '{}';
+			ALTER TABLE api_keys ADD COLUMN expires_at timestamp with time zone;
+			ALTER TABLE api_keys ADD COLUMN last_used_ip text not null default '';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE user_totp (
+				user_id int not null references users(id) on delete cascade,
+				secret text not null,
+				enabled bool not null default 'f',
+				last_used_step bigint not null default 0,
+				created_at timestamp with time zone not null default now(),
+				primary key (user_id)
+			);
+
+			CREATE TABLE user_totp_recovery_codes (
+				user_id int not null references users(id) on delete cascade,
+				code_hash text not null,
+				primary key (user_id, code_hash)
+			);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE audit_log (
+				id bigserial not null,
+				user_id int references users(id) on delete set null,
+				username text not null default '',
+				action text not null,
+				target text not null default '',
+				ip text not null default '',
+				user_agent text not null default '',
+				created_at timestamp with time zone not null default now(),
+				primary key (id)
+			);
+
+			CREATE INDEX audit_log_created_at_idx ON audit_log (created_at);
+			CREATE INDEX audit_log_user_id_idx ON audit_log (user_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE login_attempts (
+				key text not null,
+				failed_attempts int not null default 0,
+				locked_until timestamp with time zone,
+				last_failed_at timestamp with time zone not null default now(),
+				primary key (key)
+			);
+
+			CREATE INDEX login_attempts_locked_until_idx ON login_attempts (locked_until);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE feeds ADD COLUMN overridden_settings text[] not null default '{}'`)
+		return err
+	},
 }
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff // import "miniflux.app/v2/internal/diff"
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// maxEdits limits the work done by the diff algorithm, the memory used grows with the square of the number of edits.
+// Beyond this limit, the changed part of the text is reported as entirely replaced.
+const maxEdits = 1000
+
+// Operation describes how a segment of text changed.
+type Operation int
+
+const (
+	Equal Operation = iota
+	Insert
+	Delete
+)
+
+// Segment is a part of the text, unchanged, inserted or deleted.
+type Segment struct {
+	Operation Operation
+	Text      string
+}
+
+// IsInsert returns true if the segment was inserted.
+func (s Segment) IsInsert() bool {
+	return s.Operation == Insert
+}
+
+// IsDelete returns true if the segment was deleted.
+func (s Segment) IsDelete() bool {
+	return s.Operation == Delete
+}
+
+// Segments is the list of segments describing the changes between two texts.
+type Segments []Segment
+
+// HasChanges returns true if some segments were inserted or deleted.
+func (s Segments) HasChanges() bool {
+	return slices.ContainsFunc(s, func(segment Segment) bool {
+		return segment.Operation != Equal
+	})
+}
+
+// Words compares two texts word by word.
+func Words(oldText, newText string) Segments {
+	oldTokens, newTokens := tokenize(oldText), tokenize(newText)
+
+	prefix := 0
+	for prefix < len(oldTokens) && prefix < len(newTokens) && oldTokens[prefix] == newTokens[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(oldTokens)-prefix && suffix < len(newTokens)-prefix && oldTokens[len(oldTokens)-1-suffix] == newTokens[len(newTokens)-1-suffix] {
+		suffix++
+	}
+
+	var segments Segments
+	segments = segments.append(Equal, oldTokens[:prefix]...)
+	for _, segment := range compare(oldTokens[prefix:len(oldTokens)-suffix], newTokens[prefix:len(newTokens)-suffix]) {
+		segments = segments.append(segment.Operation, segment.Text)
+	}
+	segments = segments.append(Equal, oldTokens[len(oldTokens)-suffix:]...)
+
+	return segments
+}
+
+// append adds the tokens to the last segment when it has the same operation.
+func (s Segments) append(operation Operation, tokens ...string) Segments {
+	if len(tokens) == 0 {
+		return s
+	}
+
+	text := strings.Join(tokens, "")
+	if len(s) > 0 && s[len(s)-1].Operation == operation {
+		s[len(s)-1].Text += text
+		return s
+	}
+
+	return append(s, Segment{Operation: operation, Text: text})
+}
+
+// compare returns one segment per token, using the Myers algorithm:
+// http://www.xmailserver.org/diff2.pdf
+func compare(a, b []string) Segments {
+	n, m := len(a), len(b)
+
+	// trace[d][k+d] is the furthest position reached in a on the diagonal k with d edits.
+	var trace [][]int
+	for d := 0; d <= n+m && d <= maxEdits; d++ {
+		v := make([]int, 2*d+1)
+		for k := -d; k <= d; k += 2 {
+			var x int
+			switch {
+			case d == 0:
+				x = 0
+			case k == -d || (k != d && trace[d-1][k-1+d-1] < trace[d-1][k+1+d-1]):
+				x = trace[d-1][k+1+d-1]
+			default:
+				x = trace[d-1][k-1+d-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[k+d] = x
+			if x >= n && y >= m {
+				return backtrack(append(trace, v), a, b)
+			}
+		}
+		trace = append(trace, v)
+	}
+
+	var segments Segments
+	for _, token := range a {
+		segments = append(segments, Segment{Operation: Delete, Text: token})
+	}
+	for _, token := range b {
+		segments = append(segments, Segment{Operation: Insert, Text: token})
+	}
+	return segments
+}
+
+func backtrack(trace [][]int, a, b []string) Segments {
+	x, y := len(a), len(b)
+
+	var reversed Segments
+	for d := len(trace) - 1; d > 0; d-- {
+		k := x - y
+
+		previousK := k - 1
+		if k == -d || (k != d && trace[d-1][k-1+d-1] < trace[d-1][k+1+d-1]) {
+			previousK = k + 1
+		}
+
+		previousX := trace[d-1][previousK+d-1]
+		previousY := previousX - previousK
+
+		for x > previousX && y > previousY {
+			reversed = append(reversed, Segment{Operation: Equal, Text: a[x-1]})
+			x--
+			y--
+		}
+
+		if previousK == k+1 {
+			reversed = append(reversed, Segment{Operation: Insert, Text: b[y-1]})
+			y--
+		} else {
+			reversed = append(reversed, Segment{Operation: Delete, Text: a[x-1]})
+			x--
+		}
+	}
+
+	for x > 0 && y > 0 {
+		reversed = append(reversed, Segment{Operation: Equal, Text: a[x-1]})
+		x--
+		y--
+	}
+
+	slices.Reverse(reversed)
+	return reversed
+}
+
+// tokenize splits the text into words, whitespace, and punctuation characters.
+func tokenize(text string) []string {
+	var tokens []string
+
+	start := -1
+	var startClass int
+	for i, r := range text {
+		class := runeClass(r)
+		if start >= 0 && (class != startClass || class == classOther) {
+			tokens = append(tokens, text[start:i])
+			start = -1
+		}
+
+		if start < 0 {
+			start, startClass = i, class
+		}
+	}
+
+	if start >= 0 {
+		tokens = append(tokens, text[start:])
+	}
+
+	return tokens
+}
+
+const (
+	classWord = iota
+	classSpace
+	classOther
+)
+
+func runeClass(r rune) int {
+	switch {
+	case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+		return classWord
+	case unicode.IsSpace(r):
+		return classSpace
+	default:
+		return classOther
+	}
+}
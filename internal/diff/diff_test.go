@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff // import "miniflux.app/v2/internal/diff"
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWords(t *testing.T) {
+	scenarios := []struct {
+		oldText  string
+		newText  string
+		expected Segments
+	}{
+		{"", "", nil},
+		{"same text", "same text", Segments{{Equal, "same text"}}},
+		{"", "new text", Segments{{Insert, "new text"}}},
+		{"old text", "", Segments{{Delete, "old text"}}},
+		{
+			"The price is 10 dollars.",
+			"The price is 12 dollars.",
+			Segments{{Equal, "The price is "}, {Delete, "10"}, {Insert, "12"}, {Equal, " dollars."}},
+		},
+		{
+			"We will ship in March.",
+			"We will probably ship in April.",
+			Segments{{Equal, "We will "}, {Insert, "probably "}, {Equal, "ship in "}, {Delete, "March"}, {Insert, "April"}, {Equal, "."}},
+		},
+		{
+			"a b c d",
+			"a c d e",
+			Segments{{Equal, "a "}, {Delete, "b "}, {Equal, "c d"}, {Insert, " e"}},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		segments := Words(scenario.oldText, scenario.newText)
+		if !reflect.DeepEqual(segments, scenario.expected) {
+			t.Errorf(`Unexpected diff between %q and %q: got %v instead of %v`, scenario.oldText, scenario.newText, segments, scenario.expected)
+		}
+	}
+}
+
+func TestWordsRebuildsBothTexts(t *testing.T) {
+	oldText := "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Sed do eiusmod tempor."
+	newText := "Lorem ipsum sit amet, adipiscing elit! Sed do eiusmod tempor incididunt."
+
+	var rebuiltOld, rebuiltNew strings.Builder
+	for _, segment := range Words(oldText, newText) {
+		if segment.Operation != Insert {
+			rebuiltOld.WriteString(segment.Text)
+		}
+		if segment.Operation != Delete {
+			rebuiltNew.WriteString(segment.Text)
+		}
+	}
+
+	if rebuiltOld.String() != oldText {
+		t.Errorf(`Unexpected old text: %q`, rebuiltOld.String())
+	}
+
+	if rebuiltNew.String() != newText {
+		t.Errorf(`Unexpected new text: %q`, rebuiltNew.String())
+	}
+}
+
+func TestWordsWithTooManyChanges(t *testing.T) {
+	var oldWords, newWords []string
+	for i := range maxEdits {
+		oldWords = append(oldWords, "a"+strings.Repeat("x", i%7))
+		newWords = append(newWords, "b"+strings.Repeat("y", i%5))
+	}
+
+	segments := Words("start "+strings.Join(oldWords, " ")+" end", "start "+strings.Join(newWords, " ")+" end")
+	expected := Segments{
+		{Equal, "start "},
+		{Delete, strings.Join(oldWords, " ")},
+		{Insert, strings.Join(newWords, " ")},
+		{Equal, " end"},
+	}
+	if !reflect.DeepEqual(segments, expected) {
+		t.Errorf(`Expected the changed part to be entirely replaced, got %d segments`, len(segments))
+	}
+}
+
+func TestHasChanges(t *testing.T) {
+	if Words("same", "same").HasChanges() {
+		t.Error(`Identical texts should not have changes`)
+	}
+
+	if !Words("old", "new").HasChanges() {
+		t.Error(`Different texts should have changes`)
+	}
+}
+
+func TestHTMLText(t *testing.T) {
+	input := `<h1>Title</h1><p>First   paragraph with <a href="#">a link</a>.</p><ul><li>One</li><li>Two &amp; three</li></ul><p>Line<br>break</p>`
+	expected := "Title\n\nFirst paragraph with a link.\n\nOne\n\nTwo & three\n\nLine\n\nbreak"
+
+	if text := HTMLText(input); text != expected {
+		t.Errorf(`Unexpected text: %q`, text)
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff // import "miniflux.app/v2/internal/diff"
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	spacesRegex   = regexp.MustCompile(`[^\S\n]+`)
+	newlinesRegex = regexp.MustCompile(`\s*\n\s*(\n\s*)+`)
+)
+
+var blockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true,
+	"dd": true, "div": true, "dl": true, "dt": true, "figcaption": true, "figure": true,
+	"footer": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "li": true, "ol": true, "p": true, "pre": true, "section": true,
+	"table": true, "tr": true, "ul": true,
+}
+
+// HTMLText returns the text of an HTML document, with one paragraph per block element,
+// to compare the content of entries without the noise of the markup.
+func HTMLText(document string) string {
+	var builder strings.Builder
+
+	tokenizer := html.NewTokenizer(strings.NewReader(document))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			text := spacesRegex.ReplaceAllString(builder.String(), " ")
+			text = newlinesRegex.ReplaceAllString(text, "\n\n")
+			return strings.TrimSpace(strings.ReplaceAll(text, " \n", "\n"))
+		case html.TextToken:
+			builder.Write(tokenizer.Text())
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			if blockElements[string(name)] {
+				builder.WriteString("\n\n")
+			}
+		}
+	}
+}
@@ -0,0 +1,411 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package epub writes EPUB 3 publications made of HTML chapters and embedded images.
+package epub // import "miniflux.app/v2/internal/epub"
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// MimeType is the media type of EPUB files.
+const MimeType = "application/epub+zip"
+
+// Book is an EPUB publication.
+type Book struct {
+	Identifier  string
+	Title       string
+	Language    string
+	Author      string
+	Publisher   string
+	Description string
+	Modified    time.Time
+
+	chapters []*chapter
+	images   []*image
+	imageMap map[string]*image
+}
+
+type chapter struct {
+	id       string
+	title    string
+	subtitle string
+	content  string
+}
+
+type image struct {
+	id        string
+	sourceURL string
+	href      string
+	mimeType  string
+	content   []byte
+}
+
+// NewBook returns an empty publication.
+func NewBook(identifier, title, language string) *Book {
+	if language == "" {
+		language = "en"
+	}
+
+	return &Book{
+		Identifier: identifier,
+		Title:      title,
+		Language:   language,
+		Modified:   time.Now(),
+		imageMap:   make(map[string]*image),
+	}
+}
+
+// AddChapter appends a chapter, the subtitle is displayed under the title and the content is an HTML fragment.
+// The images of the content are replaced by the images added to the book, the other images are removed.
+func (b *Book) AddChapter(title, subtitle, content string) {
+	b.chapters = append(b.chapters, &chapter{
+		id:       fmt.Sprintf("chapter-%d", len(b.chapters)+1),
+		title:    title,
+		subtitle: subtitle,
+		content:  content,
+	})
+}
+
+// AddImage embeds the image downloaded from the source URL.
+func (b *Book) AddImage(sourceURL, mimeType string, content []byte) {
+	if _, found := b.imageMap[sourceURL]; found {
+		return
+	}
+
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	mimeType = strings.TrimSpace(strings.ToLower(mimeType))
+
+	extension := ""
+	if extensions, err := mime.ExtensionsByType(mimeType); err == nil && len(extensions) > 0 {
+		extension = extensions[0]
+	}
+
+	img := &image{
+		id:        fmt.Sprintf("image-%d", len(b.images)+1),
+		sourceURL: sourceURL,
+		href:      "images/" + crypto.SHA256(sourceURL)[:16] + extension,
+		mimeType:  mimeType,
+		content:   content,
+	}
+	b.images = append(b.images, img)
+	b.imageMap[sourceURL] = img
+}
+
+// HasImage returns true if the image downloaded from the source URL is embedded.
+func (b *Book) HasImage(sourceURL string) bool {
+	_, found := b.imageMap[sourceURL]
+	return found
+}
+
+// Write generates the EPUB file.
+func (b *Book) Write(w io.Writer) error {
+	zipWriter := zip.NewWriter(w)
+
+	// The mimetype file must be the first entry of the archive, without compression.
+	mimetypeWriter, err := zipWriter.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return fmt.Errorf("epub: unable to write mimetype: %w", err)
+	}
+	if _, err := io.WriteString(mimetypeWriter, MimeType); err != nil {
+		return fmt.Errorf("epub: unable to write mimetype: %w", err)
+	}
+
+	files := []struct {
+		name    string
+		content func() ([]byte, error)
+	}{
+		{"META-INF/container.xml", func() ([]byte, error) { return []byte(containerXML), nil }},
+		{"OEBPS/content.opf", b.packageDocument},
+		{"OEBPS/nav.xhtml", b.navigationDocument},
+	}
+
+	for _, file := range files {
+		content, err := file.content()
+		if err != nil {
+			return err
+		}
+
+		if err := writeFile(zipWriter, file.name, content); err != nil {
+			return err
+		}
+	}
+
+	for _, chapter := range b.chapters {
+		content, err := b.chapterDocument(chapter)
+		if err != nil {
+			return err
+		}
+
+		if err := writeFile(zipWriter, "OEBPS/"+chapter.id+".xhtml", content); err != nil {
+			return err
+		}
+	}
+
+	for _, img := range b.images {
+		if err := writeFile(zipWriter, "OEBPS/"+img.href, img.content); err != nil {
+			return err
+		}
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("epub: unable to close archive: %w", err)
+	}
+
+	return nil
+}
+
+func writeFile(zipWriter *zip.Writer, name string, content []byte) error {
+	fileWriter, err := zipWriter.Create(name)
+	if err != nil {
+		return fmt.Errorf("epub: unable to create %s: %w", name, err)
+	}
+
+	if _, err := fileWriter.Write(content); err != nil {
+		return fmt.Errorf("epub: unable to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+const containerXML = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`
+
+type opfPackage struct {
+	XMLName          xml.Name     `xml:"http://www.idpf.org/2007/opf package"`
+	Version          string       `xml:"version,attr"`
+	UniqueIdentifier string       `xml:"unique-identifier,attr"`
+	Lang             string       `xml:"xml:lang,attr"`
+	Metadata         opfMetadata  `xml:"metadata"`
+	Items            []opfItem    `xml:"manifest>item"`
+	ItemRefs         []opfItemRef `xml:"spine>itemref"`
+}
+
+type opfMetadata struct {
+	DC          string     `xml:"xmlns:dc,attr"`
+	Identifier  opfElement `xml:"dc:identifier"`
+	Title       string     `xml:"dc:title"`
+	Language    string     `xml:"dc:language"`
+	Creator     string     `xml:"dc:creator,omitempty"`
+	Publisher   string     `xml:"dc:publisher,omitempty"`
+	Description string     `xml:"dc:description,omitempty"`
+	Meta        opfMeta    `xml:"meta"`
+}
+
+type opfElement struct {
+	ID    string `xml:"id,attr"`
+	Value string `xml:",chardata"`
+}
+
+type opfMeta struct {
+	Property string `xml:"property,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type opfItem struct {
+	ID         string `xml:"id,attr"`
+	Href       string `xml:"href,attr"`
+	MediaType  string `xml:"media-type,attr"`
+	Properties string `xml:"properties,attr,omitempty"`
+}
+
+type opfItemRef struct {
+	IDRef string `xml:"idref,attr"`
+}
+
+func (b *Book) packageDocument() ([]byte, error) {
+	pkg := opfPackage{
+		Version:          "3.0",
+		UniqueIdentifier: "book-id",
+		Lang:             b.Language,
+		Metadata: opfMetadata{
+			DC:          "http://purl.org/dc/elements/1.1/",
+			Identifier:  opfElement{ID: "book-id", Value: b.Identifier},
+			Title:       b.Title,
+			Language:    b.Language,
+			Creator:     b.Author,
+			Publisher:   b.Publisher,
+			Description: b.Description,
+			Meta:        opfMeta{Property: "dcterms:modified", Value: b.Modified.UTC().Format("2006-01-02T15:04:05Z")},
+		},
+		Items: []opfItem{{ID: "nav", Href: "nav.xhtml", MediaType: "application/xhtml+xml", Properties: "nav"}},
+	}
+
+	for _, chapter := range b.chapters {
+		pkg.Items = append(pkg.Items, opfItem{ID: chapter.id, Href: chapter.id + ".xhtml", MediaType: "application/xhtml+xml"})
+		pkg.ItemRefs = append(pkg.ItemRefs, opfItemRef{IDRef: chapter.id})
+	}
+
+	for _, img := range b.images {
+		pkg.Items = append(pkg.Items, opfItem{ID: img.id, Href: img.href, MediaType: img.mimeType})
+	}
+
+	content, err := xml.MarshalIndent(pkg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("epub: unable to generate package document: %w", err)
+	}
+
+	return append([]byte(xml.Header), content...), nil
+}
+
+func (b *Book) navigationDocument() ([]byte, error) {
+	var body strings.Builder
+	body.WriteString(`<nav epub:type="toc" id="toc"><h1>`)
+	xml.EscapeText(&body, []byte(b.Title))
+	body.WriteString(`</h1><ol>`)
+	for _, chapter := range b.chapters {
+		fmt.Fprintf(&body, `<li><a href="%s.xhtml">`, chapter.id)
+		xml.EscapeText(&body, []byte(chapter.title))
+		body.WriteString(`</a></li>`)
+	}
+	body.WriteString(`</ol></nav>`)
+
+	return b.xhtmlDocument(b.Title, body.String()), nil
+}
+
+func (b *Book) chapterDocument(chapter *chapter) ([]byte, error) {
+	content, err := b.toXHTML(chapter.content)
+	if err != nil {
+		return nil, fmt.Errorf("epub: unable to convert chapter %q: %w", chapter.title, err)
+	}
+
+	var body strings.Builder
+	body.WriteString(`<h1>`)
+	xml.EscapeText(&body, []byte(chapter.title))
+	body.WriteString(`</h1>`)
+	if chapter.subtitle != "" {
+		body.WriteString(`<p><em>`)
+		xml.EscapeText(&body, []byte(chapter.subtitle))
+		body.WriteString(`</em></p>`)
+	}
+	body.WriteString(content)
+
+	return b.xhtmlDocument(chapter.title, body.String()), nil
+}
+
+func (b *Book) xhtmlDocument(title, body string) []byte {
+	var buffer bytes.Buffer
+	buffer.WriteString(xml.Header)
+	buffer.WriteString("<!DOCTYPE html>\n")
+	fmt.Fprintf(&buffer, `<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" lang="%[1]s" xml:lang="%[1]s">`, html.EscapeString(b.Language))
+	buffer.WriteString(`<head><title>`)
+	xml.EscapeText(&buffer, []byte(title))
+	buffer.WriteString(`</title></head><body>`)
+	buffer.WriteString(body)
+	buffer.WriteString("</body></html>\n")
+	return buffer.Bytes()
+}
+
+// removedElements cannot be displayed by reading systems without network access.
+var removedElements = map[atom.Atom]bool{
+	atom.Audio:  true,
+	atom.Embed:  true,
+	atom.Iframe: true,
+	atom.Object: true,
+	atom.Script: true,
+	atom.Source: true,
+	atom.Style:  true,
+	atom.Video:  true,
+}
+
+// toXHTML serializes an HTML fragment as well-formed XHTML, and points the images to the embedded files.
+func (b *Book) toXHTML(content string) (string, error) {
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(content), context)
+	if err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	for _, node := range nodes {
+		if node.Type == html.CommentNode || (node.Type == html.ElementNode && (removedElements[node.DataAtom] || !b.keepImage(node))) {
+			continue
+		}
+
+		b.cleanNode(node)
+
+		if err := html.Render(&buffer, node); err != nil {
+			return "", err
+		}
+	}
+
+	return buffer.String(), nil
+}
+
+func (b *Book) cleanNode(node *html.Node) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		if child.Type == html.CommentNode || (child.Type == html.ElementNode && (removedElements[child.DataAtom] || !b.keepImage(child))) {
+			node.RemoveChild(child)
+		} else {
+			b.cleanNode(child)
+		}
+		child = next
+	}
+
+	if node.Type != html.ElementNode {
+		return
+	}
+
+	// Attributes are kept only when their name is valid in XHTML.
+	attributes := node.Attr[:0]
+	for _, attribute := range node.Attr {
+		if attribute.Namespace != "" || !isXMLName(attribute.Key) || attribute.Key == "srcset" || attribute.Key == "sizes" {
+			continue
+		}
+
+		if node.DataAtom == atom.Img && attribute.Key == "src" {
+			attribute.Val = b.imageMap[attribute.Val].href
+		}
+
+		attributes = append(attributes, attribute)
+	}
+	node.Attr = attributes
+}
+
+// keepImage returns false for the images that are not embedded in the book.
+func (b *Book) keepImage(node *html.Node) bool {
+	if node.DataAtom != atom.Img {
+		return true
+	}
+
+	for _, attribute := range node.Attr {
+		if attribute.Key == "src" {
+			_, found := b.imageMap[attribute.Val]
+			return found
+		}
+	}
+
+	return false
+}
+
+func isXMLName(name string) bool {
+	if name == "" || strings.ContainsAny(name[:1], "-.0123456789") {
+		return false
+	}
+
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package epub // import "miniflux.app/v2/internal/epub"
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+func readBook(t *testing.T, book *Book) map[string]string {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	if err := book.Write(&buffer); err != nil {
+		t.Fatalf(`Unable to write the book: %v`, err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatalf(`Unable to read the book: %v`, err)
+	}
+
+	if len(reader.File) == 0 || reader.File[0].Name != "mimetype" || reader.File[0].Method != zip.Store {
+		t.Fatal(`The mimetype file must be the first file without compression`)
+	}
+
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		fileReader, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(fileReader)
+		fileReader.Close()
+		files[file.Name] = string(content)
+
+		if strings.HasSuffix(file.Name, ".xhtml") || strings.HasSuffix(file.Name, ".opf") || strings.HasSuffix(file.Name, ".xml") {
+			decoder := xml.NewDecoder(bytes.NewReader(content))
+			decoder.Strict = true
+			for {
+				if _, err := decoder.Token(); err == io.EOF {
+					break
+				} else if err != nil {
+					t.Fatalf(`The file %s is not well-formed: %v`, file.Name, err)
+				}
+			}
+		}
+	}
+
+	return files
+}
+
+func TestWriteBook(t *testing.T) {
+	book := NewBook("urn:miniflux:test", "Title & more", "")
+	book.Author = "Author"
+	book.AddImage("https://example.org/image.png", "image/png; charset=binary", []byte("png"))
+	book.AddChapter("First chapter", "Feed", `<p>Text<br>with <img src="https://example.org/image.png" srcset="https://example.org/large.png 2x"> and <img src="https://example.org/missing.png"></p><iframe src="https://example.org/"></iframe><!-- comment -->`)
+	book.AddChapter("Second <chapter>", "", `<ul><li>One<li>Two</ul>`)
+
+	files := readBook(t, book)
+
+	if files["mimetype"] != MimeType {
+		t.Errorf(`Unexpected mimetype, got %q`, files["mimetype"])
+	}
+
+	if !strings.Contains(files["META-INF/container.xml"], `full-path="OEBPS/content.opf"`) {
+		t.Error(`The container must point to the package document`)
+	}
+
+	opf := files["OEBPS/content.opf"]
+	for _, expected := range []string{
+		`<dc:title>Title &amp; more</dc:title>`,
+		`<dc:language>en</dc:language>`,
+		`<dc:creator>Author</dc:creator>`,
+		`properties="nav"`,
+		`<itemref idref="chapter-1"></itemref>`,
+		`media-type="image/png"`,
+	} {
+		if !strings.Contains(opf, expected) {
+			t.Errorf(`The package document should contain %q, got %s`, expected, opf)
+		}
+	}
+
+	if nav := files["OEBPS/nav.xhtml"]; !strings.Contains(nav, `<a href="chapter-2.xhtml">Second &lt;chapter&gt;</a>`) {
+		t.Errorf(`Unexpected table of contents: %s`, nav)
+	}
+
+	chapter := files["OEBPS/chapter-1.xhtml"]
+	for _, unexpected := range []string{"missing.png", "srcset", "iframe", "comment"} {
+		if strings.Contains(chapter, unexpected) {
+			t.Errorf(`The chapter should not contain %q: %s`, unexpected, chapter)
+		}
+	}
+
+	if !strings.Contains(chapter, `<img src="images/`) || !strings.Contains(chapter, `<br/>`) || !strings.Contains(chapter, `<p><em>Feed</em></p>`) {
+		t.Errorf(`Unexpected chapter: %s`, chapter)
+	}
+
+	imageCount := 0
+	for name, content := range files {
+		if strings.HasPrefix(name, "OEBPS/images/") {
+			imageCount++
+			if !strings.HasSuffix(name, ".png") || content != "png" {
+				t.Errorf(`Unexpected image %s`, name)
+			}
+		}
+	}
+
+	if imageCount != 1 {
+		t.Errorf(`Expected one image, got %d`, imageCount)
+	}
+}
+
+func TestWriteBookWithTopLevelImage(t *testing.T) {
+	book := NewBook("urn:miniflux:test", "Title", "fr")
+	book.AddImage("https://example.org/image.png", "image/png", []byte("png"))
+	book.AddChapter("Chapter", "", `<img src="https://example.org/image.png"><img src="https://example.org/other.png">`)
+
+	chapter := readBook(t, book)["OEBPS/chapter-1.xhtml"]
+
+	if strings.Count(chapter, "<img") != 1 || !strings.Contains(chapter, `src="images/`) {
+		t.Errorf(`Unexpected chapter: %s`, chapter)
+	}
+
+	if !strings.Contains(chapter, `xml:lang="fr"`) {
+		t.Errorf(`The chapter should use the book language: %s`, chapter)
+	}
+}
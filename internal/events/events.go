@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package events notifies the connected clients of the changes made to the entries and the feeds of their user.
+package events // import "miniflux.app/v2/internal/events"
+
+import (
+	"sync"
+)
+
+// Types of events.
+const (
+	TypeNewEntries     = "new_entries"
+	TypeEntriesStatus  = "entries_status"
+	TypeEntriesStarred = "entries_starred"
+	TypeFeedError      = "feed_error"
+	TypeCounters       = "counters"
+)
+
+// subscriberBufferSize is the number of events kept for a slow subscriber, the next events are dropped.
+const subscriberBufferSize = 64
+
+// Event is a change sent to the clients of a user.
+type Event struct {
+	UserID int64
+	Type   string
+	Data   any
+}
+
+// NewEntriesData describes the entries stored during a refresh of a feed.
+type NewEntriesData struct {
+	FeedID   int64   `json:"feed_id"`
+	EntryIDs []int64 `json:"entry_ids"`
+}
+
+// EntriesStatusData describes the entries marked as read or unread.
+type EntriesStatusData struct {
+	EntryIDs []int64 `json:"entry_ids"`
+	Status   string  `json:"status"`
+}
+
+// EntriesStarredData describes the entries starred or unstarred.
+type EntriesStarredData struct {
+	EntryIDs []int64 `json:"entry_ids"`
+	Starred  bool    `json:"starred"`
+}
+
+// FeedErrorData describes a feed that failed to refresh.
+type FeedErrorData struct {
+	FeedID            int64  `json:"feed_id"`
+	ParsingErrorCount int    `json:"parsing_error_count"`
+	ParsingErrorMsg   string `json:"parsing_error_message"`
+}
+
+// CountersData contains the counters displayed in the navigation menu.
+type CountersData struct {
+	Unread     int `json:"unread"`
+	ErrorFeeds int `json:"error_feeds"`
+}
+
+// Broker dispatches the events to the subscribers of each user.
+type Broker struct {
+	mu          sync.RWMutex
+	subscribers map[int64]map[chan *Event]struct{}
+}
+
+// NewBroker returns a broker without subscribers.
+func NewBroker() *Broker {
+	return &Broker{subscribers: make(map[int64]map[chan *Event]struct{})}
+}
+
+// Subscribe returns the channel receiving the events of the user, and the function to call to unsubscribe.
+func (b *Broker) Subscribe(userID int64) (<-chan *Event, func()) {
+	ch := make(chan *Event, subscriberBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[userID] == nil {
+		b.subscribers[userID] = make(map[chan *Event]struct{})
+	}
+	b.subscribers[userID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subscribers[userID], ch)
+		if len(b.subscribers[userID]) == 0 {
+			delete(b.subscribers, userID)
+		}
+	}
+}
+
+// Publish sends the event to the subscribers of its user without blocking,
+// the event is dropped for the subscribers that do not keep up.
+func (b *Broker) Publish(event *Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subscribers[event.UserID] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// HasSubscribers returns true if a client of the user is connected.
+func (b *Broker) HasSubscribers(userID int64) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return len(b.subscribers[userID]) > 0
+}
+
+// BrokerInstance is the broker shared by the storage publishing the events and the HTTP handlers streaming them.
+var BrokerInstance = NewBroker()
+
+// Publish sends an event to the connected clients of the user.
+func Publish(userID int64, eventType string, data any) {
+	BrokerInstance.Publish(&Event{UserID: userID, Type: eventType, Data: data})
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package events // import "miniflux.app/v2/internal/events"
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBrokerPublishToUserSubscribers(t *testing.T) {
+	broker := NewBroker()
+
+	events, unsubscribe := broker.Subscribe(1)
+	otherEvents, unsubscribeOther := broker.Subscribe(2)
+	defer unsubscribeOther()
+
+	broker.Publish(&Event{UserID: 1, Type: TypeEntriesStatus, Data: &EntriesStatusData{EntryIDs: []int64{1}, Status: "read"}})
+
+	select {
+	case event := <-events:
+		if event.Type != TypeEntriesStatus {
+			t.Errorf(`Unexpected event type: %q`, event.Type)
+		}
+	default:
+		t.Fatal(`The subscriber did not receive the event`)
+	}
+
+	select {
+	case <-otherEvents:
+		t.Fatal(`The subscriber of another user received the event`)
+	default:
+	}
+
+	unsubscribe()
+	if broker.HasSubscribers(1) {
+		t.Error(`The user should not have subscribers anymore`)
+	}
+
+	if !broker.HasSubscribers(2) {
+		t.Error(`The other user should still have a subscriber`)
+	}
+}
+
+func TestBrokerDropsEventsOfSlowSubscribers(t *testing.T) {
+	broker := NewBroker()
+
+	events, unsubscribe := broker.Subscribe(1)
+	defer unsubscribe()
+
+	for range subscriberBufferSize + 10 {
+		broker.Publish(&Event{UserID: 1, Type: TypeNewEntries})
+	}
+
+	if len(events) != subscriberBufferSize {
+		t.Errorf(`Expected %d buffered events, got %d`, subscriberBufferSize, len(events))
+	}
+}
+
+func TestStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := httptest.NewRequest(http.MethodGet, "/events", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Stream(w, r, 42, func() (*CountersData, error) {
+			return &CountersData{Unread: 3, ErrorFeeds: 1}, nil
+		})
+	}()
+
+	for !BrokerInstance.HasSubscribers(42) {
+		time.Sleep(time.Millisecond)
+	}
+
+	Publish(42, TypeNewEntries, &NewEntriesData{FeedID: 7, EntryIDs: []int64{10, 11}})
+	Publish(43, TypeNewEntries, &NewEntriesData{FeedID: 8, EntryIDs: []int64{12}})
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-done
+
+	if contentType := w.Header().Get("Content-Type"); contentType != "text/event-stream" {
+		t.Errorf(`Unexpected content type: %q`, contentType)
+	}
+
+	body := w.Body.String()
+	expected := "event: counters\ndata: {\"unread\":3,\"error_feeds\":1}\n\n" +
+		"event: new_entries\ndata: {\"feed_id\":7,\"entry_ids\":[10,11]}\n\n"
+	if body != expected {
+		t.Errorf(`Unexpected stream: %q`, body)
+	}
+
+	if BrokerInstance.HasSubscribers(42) {
+		t.Error(`The stream should unsubscribe when the client disconnects`)
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package events // import "miniflux.app/v2/internal/events"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// heartbeatInterval keeps the connection open through the proxies closing the idle connections.
+const heartbeatInterval = 30 * time.Second
+
+// countersDelay groups the counters sent after a burst of events.
+const countersDelay = time.Second
+
+// CountersFunc returns the current counters of the user.
+type CountersFunc func() (*CountersData, error)
+
+// Stream sends the events of the user as Server-Sent Events until the client disconnects.
+// The counters are sent when the stream starts, and after the events changing them.
+func Stream(w http.ResponseWriter, r *http.Request, userID int64, counters CountersFunc) {
+	controller := http.NewResponseController(w)
+
+	// The stream is kept open longer than the write timeout of the server.
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		slog.Warn("Unable to disable the write deadline of the event stream", slog.Any("error", err))
+	}
+
+	events, unsubscribe := BrokerInstance.Subscribe(userID)
+	defer unsubscribe()
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	sendCounters := func() error {
+		data, err := counters()
+		if err != nil {
+			return err
+		}
+		return writeEvent(w, TypeCounters, data)
+	}
+
+	if err := sendCounters(); err != nil {
+		slog.Error("Unable to send the counters", slog.Int64("user_id", userID), slog.Any("error", err))
+		return
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	var countersTimer <-chan time.Time
+	for {
+		if err := controller.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case event := <-events:
+			if err := writeEvent(w, event.Type, event.Data); err != nil {
+				return
+			}
+
+			if countersTimer == nil {
+				countersTimer = time.After(countersDelay)
+			}
+		case <-countersTimer:
+			countersTimer = nil
+			if err := sendCounters(); err != nil {
+				slog.Error("Unable to send the counters", slog.Int64("user_id", userID), slog.Any("error", err))
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func writeEvent(w io.Writer, eventType string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventType, payload)
+	return err
+}
@@ -40,6 +40,8 @@ func (h *feverHandler) serve(w http.ResponseWriter, r *http.Request) {
 		h.handleUnreadItems(w, r)
 	case request.HasQueryParam(r, "saved_item_ids"):
 		h.handleSavedItems(w, r)
+	case request.HasQueryParam(r, "saved_searches"):
+		h.handleSavedSearches(w, r)
 	case request.HasQueryParam(r, "items"):
 		h.handleItems(w, r)
 	case r.FormValue("mark") == "item":
@@ -393,6 +395,57 @@ func (h *feverHandler) handleSavedItems(w http.ResponseWriter, r *http.Request)
 	response.JSON(w, r, result)
 }
 
+/*
+A request with the saved_searches argument will return one additional member.
+This is a Miniflux extension, saved searches are not part of the Fever API.
+
+	saved_searches contains an array of saved_search objects
+
+A saved_search object has the following members:
+
+	id (positive integer)
+	title (utf-8 string)
+	item_ids (string/comma-separated list of positive integers)
+*/
+func (h *feverHandler) handleSavedSearches(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	slog.Debug("[Fever] Fetching saved searches",
+		slog.Int64("user_id", userID),
+	)
+
+	savedSearches, err := h.store.SavedSearches(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	var result savedSearchesResponse
+	result.SavedSearches = make([]savedSearch, 0, len(savedSearches))
+	for _, s := range savedSearches {
+		entryIDs, err := h.store.NewEntryQueryBuilder(userID).
+			WithSavedSearch(s).
+			GetEntryIDs()
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		itemIDs := make([]string, 0, len(entryIDs))
+		for _, entryID := range entryIDs {
+			itemIDs = append(itemIDs, strconv.FormatInt(entryID, 10))
+		}
+
+		result.SavedSearches = append(result.SavedSearches, savedSearch{
+			ID:      s.ID,
+			Title:   s.Title,
+			ItemIDs: strings.Join(itemIDs, ","),
+		})
+	}
+
+	result.SetCommonValues()
+	response.JSON(w, r, result)
+}
+
 /*
 mark=item
 as=? where ? is replaced with read, saved or unsaved
@@ -8,8 +8,11 @@ import (
 	"log/slog"
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ratelimit"
 	"miniflux.app/v2/internal/storage"
 )
 
@@ -29,6 +32,17 @@ func Middleware(store *storage.Storage) func(http.Handler) http.Handler {
 				return
 			}
 
+			if lockedUntil := ratelimit.LockedUntil(store, ratelimit.SourceFever, ratelimit.IPKey(clientIP)); lockedUntil != nil {
+				slog.Warn("[Fever] Authentication blocked after too many failed attempts",
+					slog.Bool("authentication_failed", true),
+					slog.String("client_ip", clientIP),
+					slog.String("user_agent", r.UserAgent()),
+					slog.Time("locked_until", *lockedUntil),
+				)
+				response.JSON(w, r, newAuthFailureResponse())
+				return
+			}
+
 			user, err := store.UserByFeverToken(apiKey)
 			if err != nil {
 				slog.Error("[Fever] Unable to fetch user by API key",
@@ -47,6 +61,8 @@ func Middleware(store *storage.Storage) func(http.Handler) http.Handler {
 					slog.String("client_ip", clientIP),
 					slog.String("user_agent", r.UserAgent()),
 				)
+				audit.Record(store, r, nil, model.AuditLogActionLoginFailed, ratelimit.SourceFever)
+				ratelimit.RecordFailure(store, ratelimit.SourceFever, ratelimit.IPKey(clientIP))
 				response.JSON(w, r, newAuthFailureResponse())
 				return
 			}
@@ -59,6 +75,7 @@ func Middleware(store *storage.Storage) func(http.Handler) http.Handler {
 				slog.String("username", user.Username),
 			)
 
+			audit.Record(store, r, user, model.AuditLogActionLogin, ratelimit.SourceFever)
 			store.SetLastLogin(user.ID)
 
 			ctx := r.Context()
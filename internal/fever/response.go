@@ -80,11 +80,22 @@ type savedResponse struct {
 	ItemIDs string `json:"saved_item_ids"`
 }
 
+type savedSearchesResponse struct {
+	baseResponse
+	SavedSearches []savedSearch `json:"saved_searches"`
+}
+
 type group struct {
 	ID    int64  `json:"id"`
 	Title string `json:"title"`
 }
 
+type savedSearch struct {
+	ID      int64  `json:"id"`
+	Title   string `json:"title"`
+	ItemIDs string `json:"item_ids"`
+}
+
 type feedsGroups struct {
 	GroupID int64  `json:"group_id"`
 	FeedIDs string `json:"feed_ids"`
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"time"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
@@ -18,6 +19,7 @@ import (
 	"miniflux.app/v2/internal/mediaproxy"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/proxyrotator"
+	"miniflux.app/v2/internal/ratelimit"
 	"miniflux.app/v2/internal/reader/fetcher"
 	mff "miniflux.app/v2/internal/reader/handler"
 	mfs "miniflux.app/v2/internal/reader/subscription"
@@ -44,21 +46,24 @@ func NewHandler(store *storage.Storage) http.Handler {
 	withApiKeyAuth := func(fn http.HandlerFunc) http.Handler {
 		return authMiddleware.validateApiKey(fn)
 	}
+	withScope := func(scope string, fn http.HandlerFunc) http.Handler {
+		return authMiddleware.validateApiKey(requireScope(scope, fn))
+	}
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("POST /accounts/ClientLogin", h.clientLoginHandler)
 	mux.Handle("GET /reader/api/0/token", withApiKeyAuth(h.tokenHandler))
-	mux.Handle("POST /reader/api/0/edit-tag", withApiKeyAuth(h.editTagHandler))
-	mux.Handle("POST /reader/api/0/rename-tag", withApiKeyAuth(h.renameTagHandler))
-	mux.Handle("POST /reader/api/0/disable-tag", withApiKeyAuth(h.disableTagHandler))
+	mux.Handle("POST /reader/api/0/edit-tag", withScope(model.APIKeyScopeEntriesWrite, h.editTagHandler))
+	mux.Handle("POST /reader/api/0/rename-tag", withScope(model.APIKeyScopeFeedsAdmin, h.renameTagHandler))
+	mux.Handle("POST /reader/api/0/disable-tag", withScope(model.APIKeyScopeFeedsAdmin, h.disableTagHandler))
 	mux.Handle("GET /reader/api/0/tag/list", withApiKeyAuth(h.tagListHandler))
 	mux.Handle("GET /reader/api/0/user-info", withApiKeyAuth(h.userInfoHandler))
 	mux.Handle("GET /reader/api/0/subscription/list", withApiKeyAuth(h.subscriptionListHandler))
-	mux.Handle("POST /reader/api/0/subscription/edit", withApiKeyAuth(h.editSubscriptionHandler))
-	mux.Handle("POST /reader/api/0/subscription/quickadd", withApiKeyAuth(h.quickAddHandler))
+	mux.Handle("POST /reader/api/0/subscription/edit", withScope(model.APIKeyScopeFeedsAdmin, h.editSubscriptionHandler))
+	mux.Handle("POST /reader/api/0/subscription/quickadd", withScope(model.APIKeyScopeFeedsAdmin, h.quickAddHandler))
 	mux.Handle("GET /reader/api/0/stream/items/ids", withApiKeyAuth(h.streamItemIDsHandler))
 	mux.Handle("POST /reader/api/0/stream/items/contents", withApiKeyAuth(h.streamItemContentsHandler))
-	mux.Handle("POST /reader/api/0/mark-all-as-read", withApiKeyAuth(h.markAllAsReadHandler))
+	mux.Handle("POST /reader/api/0/mark-all-as-read", withScope(model.APIKeyScopeEntriesWrite, h.markAllAsReadHandler))
 	mux.Handle("GET /reader/api/0/", withApiKeyAuth(h.fallbackHandler))
 	mux.Handle("POST /reader/api/0/", withApiKeyAuth(h.fallbackHandler))
 
@@ -103,6 +108,49 @@ func (h *greaderHandler) clientLoginHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	rateLimitKeys := []string{ratelimit.IPKey(clientIP), ratelimit.GoogleReaderUserKey(username)}
+	if lockedUntil := ratelimit.LockedUntil(h.store, ratelimit.SourceGoogleReader, rateLimitKeys...); lockedUntil != nil {
+		slog.Warn("[GoogleReader] Login blocked after too many failed attempts",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.String("username", username),
+			slog.Time("locked_until", *lockedUntil),
+		)
+		response.JSONTooManyRequests(w, r, time.Until(*lockedUntil))
+		return
+	}
+
+	// An API key of the user can be used instead of the password, the API key becomes the token to keep its restrictions.
+	apiKey, err := h.store.APIKeyByToken(password)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	integration, err := h.store.GoogleReaderUserGetIntegration(username)
+	if apiKey != nil && err == nil && apiKey.UserID == integration.UserID && !apiKey.IsExpired() {
+		slog.Info("[GoogleReader] User authenticated successfully with an API key",
+			slog.Bool("authentication_successful", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.String("username", username),
+			slog.Int64("api_key_id", apiKey.ID),
+		)
+
+		user, err := h.store.UserByID(integration.UserID)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		audit.Record(h.store, r, user, model.AuditLogActionLogin, ratelimit.SourceGoogleReader)
+		ratelimit.RecordSuccess(h.store, ratelimit.SourceGoogleReader, ratelimit.GoogleReaderUserKey(username))
+		h.store.SetLastLogin(integration.UserID)
+		sendLoginResponse(w, r, apiKey.Token, output)
+		return
+	}
+
 	if err := h.store.GoogleReaderUserCheckPassword(username, password); err != nil {
 		slog.Warn("[GoogleReader] Invalid username or password",
 			slog.Bool("authentication_failed", true),
@@ -111,10 +159,14 @@ func (h *greaderHandler) clientLoginHandler(w http.ResponseWriter, r *http.Reque
 			slog.String("username", username),
 			slog.Any("error", err),
 		)
+		audit.Record(h.store, r, nil, model.AuditLogActionLoginFailed, audit.LoginFailureTarget(ratelimit.SourceGoogleReader, username))
+		ratelimit.RecordFailure(h.store, ratelimit.SourceGoogleReader, rateLimitKeys...)
 		response.JSONUnauthorized(w, r)
 		return
 	}
 
+	ratelimit.RecordSuccess(h.store, ratelimit.SourceGoogleReader, ratelimit.GoogleReaderUserKey(username))
+
 	slog.Info("[GoogleReader] User authenticated successfully",
 		slog.Bool("authentication_successful", true),
 		slog.String("client_ip", clientIP),
@@ -122,12 +174,18 @@ func (h *greaderHandler) clientLoginHandler(w http.ResponseWriter, r *http.Reque
 		slog.String("username", username),
 	)
 
-	integration, err := h.store.GoogleReaderUserGetIntegration(username)
 	if err != nil {
 		response.JSONServerError(w, r, err)
 		return
 	}
 
+	user, err := h.store.UserByID(integration.UserID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	audit.Record(h.store, r, user, model.AuditLogActionLogin, ratelimit.SourceGoogleReader)
 	h.store.SetLastLogin(integration.UserID)
 
 	token := getAuthToken(integration.GoogleReaderUsername, integration.GoogleReaderPassword)
@@ -137,13 +195,7 @@ func (h *greaderHandler) clientLoginHandler(w http.ResponseWriter, r *http.Reque
 		slog.String("username", username),
 	)
 
-	result := loginResponse{SID: token, LSID: token, Auth: token}
-	if output == "json" {
-		response.JSON(w, r, result)
-		return
-	}
-
-	response.Text(w, r, result.String())
+	sendLoginResponse(w, r, token, output)
 }
 
 func (h *greaderHandler) tokenHandler(w http.ResponseWriter, r *http.Request) {
@@ -215,6 +267,8 @@ func (h *greaderHandler) editTagHandler(w http.ResponseWriter, r *http.Request)
 		response.JSONServerError(w, r, err)
 		return
 	}
+	addLabels, addTags := splitLabelStreams(addTags)
+	removeLabels, removeTags := splitLabelStreams(removeTags)
 	tags, err := checkAndSimplifyTags(addTags, removeTags)
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -234,6 +288,8 @@ func (h *greaderHandler) editTagHandler(w http.ResponseWriter, r *http.Request)
 		slog.Int64("user_id", userID),
 		slog.Any("item_ids", itemIDs),
 		slog.Any("tags", tags),
+		slog.Any("add_labels", addLabels),
+		slog.Any("remove_labels", removeLabels),
 	)
 
 	entries, err := h.store.NewEntryQueryBuilder(userID).
@@ -245,11 +301,15 @@ func (h *greaderHandler) editTagHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	n := 0
+	var entryIDs []int64
 	var readEntryIDs []int64
 	var unreadEntryIDs []int64
 	var starredEntryIDs []int64
 	var unstarredEntryIDs []int64
+	var readLaterEntryIDs []int64
+	var notReadLaterEntryIDs []int64
 	for _, entry := range entries {
+		entryIDs = append(entryIDs, entry.ID)
 		if read, exists := tags[ReadStream]; exists {
 			if read && entry.Status == model.EntryStatusUnread {
 				readEntryIDs = append(readEntryIDs, entry.ID)
@@ -267,6 +327,13 @@ func (h *greaderHandler) editTagHandler(w http.ResponseWriter, r *http.Request)
 				unstarredEntryIDs = append(unstarredEntryIDs, entry.ID)
 			}
 		}
+		if readLater, exists := tags[ReadLaterStream]; exists {
+			if readLater && !entry.ReadLater {
+				readLaterEntryIDs = append(readLaterEntryIDs, entry.ID)
+			} else if !readLater && entry.ReadLater {
+				notReadLaterEntryIDs = append(notReadLaterEntryIDs, entry.ID)
+			}
+		}
 	}
 	entries = entries[:n]
 	if len(readEntryIDs) > 0 {
@@ -301,6 +368,29 @@ func (h *greaderHandler) editTagHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	if len(notReadLaterEntryIDs) > 0 {
+		err = h.store.RemoveFromReadLater(userID, notReadLaterEntryIDs)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+	}
+
+	if len(readLaterEntryIDs) > 0 {
+		err = h.store.AddToReadLater(userID, readLaterEntryIDs)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+	}
+
+	if len(entryIDs) > 0 {
+		if err := h.updateEntryLabels(userID, entryIDs, addLabels, removeLabels); err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+	}
+
 	if len(entries) > 0 {
 		settings, err := h.store.Integration(userID)
 		if err != nil {
@@ -634,6 +724,7 @@ func (h *greaderHandler) streamItemContentsHandler(w http.ResponseWriter, r *htt
 	userReadingList := streamPrefix + readingListStreamSuffix
 	userRead := streamPrefix + readStreamSuffix
 	userStarred := streamPrefix + starredStreamSuffix
+	userReadLater := streamPrefix + readLaterStreamSuffix
 
 	itemIDs, err := parseItemIDsFromRequest(r)
 	if err != nil {
@@ -682,6 +773,9 @@ func (h *greaderHandler) streamItemContentsHandler(w http.ResponseWriter, r *htt
 		if entry.Feed.Category.Title != "" {
 			categories = append(categories, labelPrefix+entry.Feed.Category.Title)
 		}
+		for _, label := range entry.Labels {
+			categories = append(categories, labelPrefix+label.Title)
+		}
 		if entry.Status == model.EntryStatusRead {
 			categories = append(categories, userRead)
 		}
@@ -690,6 +784,10 @@ func (h *greaderHandler) streamItemContentsHandler(w http.ResponseWriter, r *htt
 			categories = append(categories, userStarred)
 		}
 
+		if entry.ReadLater {
+			categories = append(categories, userReadLater)
+		}
+
 		entry.Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(entry.Content)
 		entry.Enclosures.ProxifyEnclosureURL(config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
 
@@ -756,19 +854,36 @@ func (h *greaderHandler) disableTagHandler(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	titles := make([]string, len(streams))
-	for i, stream := range streams {
+	titles := make([]string, 0, len(streams))
+	for _, stream := range streams {
 		if stream.Type != LabelStream {
 			response.JSONBadRequest(w, r, errors.New("googlereader: only labels are supported"))
 			return
 		}
-		titles[i] = stream.ID
+
+		// User labels take precedence over categories sharing the same name.
+		label, err := h.store.LabelByTitle(userID, stream.ID)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		if label != nil {
+			if err := h.store.RemoveLabel(userID, label.ID); err != nil {
+				response.JSONServerError(w, r, err)
+				return
+			}
+			continue
+		}
+
+		titles = append(titles, stream.ID)
 	}
 
-	err = h.store.RemoveAndReplaceCategoriesByName(userID, titles)
-	if err != nil {
-		response.JSONServerError(w, r, err)
-		return
+	if len(titles) > 0 {
+		if err := h.store.RemoveAndReplaceCategoriesByName(userID, titles); err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
 	}
 
 	response.Text(w, r, "OK")
@@ -812,6 +927,32 @@ func (h *greaderHandler) renameTagHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	label, err := h.store.LabelByTitle(userID, source.ID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	if label != nil {
+		labelModificationRequest := model.LabelModificationRequest{
+			Title: new(destination.ID),
+		}
+
+		if validationError := validator.ValidateLabelModification(h.store, userID, label.ID, &labelModificationRequest); validationError != nil {
+			response.JSONBadRequest(w, r, validationError.Error())
+			return
+		}
+
+		labelModificationRequest.Patch(label)
+
+		if err := h.store.UpdateLabel(label); err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		response.Text(w, r, "OK")
+		return
+	}
+
 	category, err := h.store.CategoryByTitle(userID, source.ID)
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -862,9 +1003,21 @@ func (h *greaderHandler) tagListHandler(w http.ResponseWriter, r *http.Request)
 		response.JSONServerError(w, r, err)
 		return
 	}
-	result.Tags = make([]subscriptionCategoryResponse, 0, 1+len(categories))
+	labels, err := h.store.Labels(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	savedSearches, err := h.store.SavedSearches(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	result.Tags = make([]subscriptionCategoryResponse, 0, 2+len(categories)+len(labels)+len(savedSearches))
 	result.Tags = append(result.Tags, subscriptionCategoryResponse{
 		ID: fmt.Sprintf(userStreamPrefix, userID) + starredStreamSuffix,
+	}, subscriptionCategoryResponse{
+		ID: fmt.Sprintf(userStreamPrefix, userID) + readLaterStreamSuffix,
 	})
 	labelPrefix := fmt.Sprintf(userLabelPrefix, userID)
 	for _, category := range categories {
@@ -874,6 +1027,20 @@ func (h *greaderHandler) tagListHandler(w http.ResponseWriter, r *http.Request)
 			Type:  "folder",
 		})
 	}
+	for _, label := range labels {
+		result.Tags = append(result.Tags, subscriptionCategoryResponse{
+			ID:    labelPrefix + label.Title,
+			Label: label.Title,
+			Type:  "tag",
+		})
+	}
+	for _, savedSearch := range savedSearches {
+		result.Tags = append(result.Tags, subscriptionCategoryResponse{
+			ID:    labelPrefix + savedSearch.Title,
+			Label: savedSearch.Title,
+			Type:  "tag",
+		})
+	}
 	response.JSON(w, r, result)
 }
 
@@ -982,15 +1149,25 @@ func (h *greaderHandler) streamItemIDsHandler(w http.ResponseWriter, r *http.Req
 		response.JSONServerError(w, r, errors.New("googlereader: only one stream type expected"))
 		return
 	}
+
+	if validationErr := validator.ValidateSearchQuery(rm.SearchQuery); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
 	switch rm.Streams[0].Type {
 	case ReadingListStream:
 		h.handleReadingListStreamHandler(w, r, rm)
 	case StarredStream:
 		h.handleStarredStreamHandler(w, r, rm)
+	case ReadLaterStream:
+		h.handleReadLaterStreamHandler(w, r, rm)
 	case ReadStream:
 		h.handleReadStreamHandler(w, r, rm)
 	case FeedStream:
 		h.handleFeedStreamHandler(w, r, rm)
+	case LabelStream:
+		h.handleLabelStreamHandler(w, r, rm)
 	default:
 		slog.Warn("[GoogleReader] Unknown Stream",
 			slog.String("handler", "streamItemIDsHandler"),
@@ -1038,6 +1215,10 @@ func (h *greaderHandler) handleReadingListStreamHandler(w http.ResponseWriter, r
 		builder = builder.BeforePublishedDate(time.Unix(rm.StopTime, 0))
 	}
 
+	if rm.SearchQuery != "" {
+		builder = builder.WithSearchQuery(rm.SearchQuery)
+	}
+
 	itemRefs, continuation, err := getItemRefsAndContinuation(*builder, rm)
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -1061,6 +1242,38 @@ func (h *greaderHandler) handleStarredStreamHandler(w http.ResponseWriter, r *ht
 		builder = builder.BeforePublishedDate(time.Unix(rm.StopTime, 0))
 	}
 
+	if rm.SearchQuery != "" {
+		builder = builder.WithSearchQuery(rm.SearchQuery)
+	}
+
+	itemRefs, continuation, err := getItemRefsAndContinuation(*builder, rm)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, streamIDResponse{itemRefs, continuation})
+}
+
+func (h *greaderHandler) handleReadLaterStreamHandler(w http.ResponseWriter, r *http.Request, rm requestModifiers) {
+	builder := h.store.NewEntryQueryBuilder(rm.UserID).
+		WithReadLater().
+		WithLimit(rm.Count).
+		WithOffset(rm.Offset).
+		WithReadLaterSorting()
+
+	if rm.StartTime > 0 {
+		builder = builder.AfterPublishedDate(time.Unix(rm.StartTime, 0))
+	}
+
+	if rm.StopTime > 0 {
+		builder = builder.BeforePublishedDate(time.Unix(rm.StopTime, 0))
+	}
+
+	if rm.SearchQuery != "" {
+		builder = builder.WithSearchQuery(rm.SearchQuery)
+	}
+
 	itemRefs, continuation, err := getItemRefsAndContinuation(*builder, rm)
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -1085,6 +1298,10 @@ func (h *greaderHandler) handleReadStreamHandler(w http.ResponseWriter, r *http.
 		builder = builder.BeforePublishedDate(time.Unix(rm.StopTime, 0))
 	}
 
+	if rm.SearchQuery != "" {
+		builder = builder.WithSearchQuery(rm.SearchQuery)
+	}
+
 	itemRefs, continuation, err := getItemRefsAndContinuation(*builder, rm)
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -1137,6 +1354,76 @@ func (h *greaderHandler) handleFeedStreamHandler(w http.ResponseWriter, r *http.
 		builder = builder.BeforePublishedDate(time.Unix(rm.StopTime, 0))
 	}
 
+	if rm.SearchQuery != "" {
+		builder = builder.WithSearchQuery(rm.SearchQuery)
+	}
+
+	for _, s := range rm.ExcludeTargets {
+		if s.Type == ReadStream {
+			builder = builder.WithoutStatus(model.EntryStatusRead)
+		}
+	}
+
+	itemRefs, continuation, err := getItemRefsAndContinuation(*builder, rm)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, streamIDResponse{itemRefs, continuation})
+}
+
+func (h *greaderHandler) handleLabelStreamHandler(w http.ResponseWriter, r *http.Request, rm requestModifiers) {
+	builder := h.store.NewEntryQueryBuilder(rm.UserID).
+		WithLimit(rm.Count).
+		WithOffset(rm.Offset).
+		WithSorting(model.DefaultSortingOrder, rm.SortDirection)
+
+	// User labels take precedence over saved searches and categories sharing the same name.
+	label, err := h.store.LabelByTitle(rm.UserID, rm.Streams[0].ID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	savedSearch, err := h.store.SavedSearchByTitle(rm.UserID, rm.Streams[0].ID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	switch {
+	case label != nil:
+		builder = builder.WithLabelID(label.ID)
+	case savedSearch != nil:
+		builder = builder.WithSavedSearch(savedSearch)
+	default:
+		category, err := h.store.CategoryByTitle(rm.UserID, rm.Streams[0].ID)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		if category == nil {
+			response.JSON(w, r, streamIDResponse{make([]itemRef, 0), 0})
+			return
+		}
+
+		builder = builder.WithCategoryID(category.ID)
+	}
+
+	if rm.StartTime > 0 {
+		builder = builder.AfterPublishedDate(time.Unix(rm.StartTime, 0))
+	}
+
+	if rm.StopTime > 0 {
+		builder = builder.BeforePublishedDate(time.Unix(rm.StopTime, 0))
+	}
+
+	if rm.SearchQuery != "" {
+		builder = builder.WithSearchQuery(rm.SearchQuery)
+	}
+
 	for _, s := range rm.ExcludeTargets {
 		if s.Type == ReadStream {
 			builder = builder.WithoutStatus(model.EntryStatusRead)
@@ -1231,6 +1518,62 @@ func (h *greaderHandler) markAllAsReadHandler(w http.ResponseWriter, r *http.Req
 	response.Text(w, r, "OK")
 }
 
+// updateEntryLabels attaches and detaches user labels. Unknown labels are created on the fly.
+func (h *greaderHandler) updateEntryLabels(userID int64, entryIDs []int64, addLabels, removeLabels []string) error {
+	for _, title := range addLabels {
+		label, err := h.store.LabelByTitle(userID, title)
+		if err != nil {
+			return err
+		}
+
+		if label == nil {
+			labelCreationRequest := model.LabelCreationRequest{Title: title}
+			if validationErr := validator.ValidateLabelCreation(h.store, userID, &labelCreationRequest); validationErr != nil {
+				return validationErr.Error()
+			}
+
+			if label, err = h.store.CreateLabel(userID, &labelCreationRequest); err != nil {
+				return err
+			}
+		}
+
+		if err := h.store.AddLabelToEntries(userID, label.ID, entryIDs); err != nil {
+			return err
+		}
+	}
+
+	for _, title := range removeLabels {
+		label, err := h.store.LabelByTitle(userID, title)
+		if err != nil {
+			return err
+		}
+
+		if label == nil {
+			continue
+		}
+
+		if err := h.store.RemoveLabelFromEntries(userID, label.ID, entryIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitLabelStreams separates user label streams from state streams.
+func splitLabelStreams(streams []Stream) ([]string, []Stream) {
+	var labels []string
+	others := make([]Stream, 0, len(streams))
+	for _, s := range streams {
+		if s.Type == LabelStream {
+			labels = append(labels, s.ID)
+		} else {
+			others = append(others, s)
+		}
+	}
+	return labels, others
+}
+
 func checkAndSimplifyTags(addTags []Stream, removeTags []Stream) (map[StreamType]bool, error) {
 	tags := make(map[StreamType]bool)
 	for _, s := range addTags {
@@ -1247,6 +1590,8 @@ func checkAndSimplifyTags(addTags []Stream, removeTags []Stream) (map[StreamType
 			tags[ReadStream] = false
 		case StarredStream:
 			tags[StarredStream] = true
+		case ReadLaterStream:
+			tags[ReadLaterStream] = true
 		case BroadcastStream, LikeStream:
 			slog.Debug("Broadcast & Like tags are not implemented!")
 		default:
@@ -1270,6 +1615,11 @@ func checkAndSimplifyTags(addTags []Stream, removeTags []Stream) (map[StreamType
 				return nil, fmt.Errorf("googlereader: %s should not be supplied for add and remove simultaneously", starredStreamSuffix)
 			}
 			tags[StarredStream] = false
+		case ReadLaterStream:
+			if _, ok := tags[ReadLaterStream]; ok {
+				return nil, fmt.Errorf("googlereader: %s should not be supplied for add and remove simultaneously", readLaterStreamSuffix)
+			}
+			tags[ReadLaterStream] = false
 		case BroadcastStream, LikeStream:
 			slog.Debug("Broadcast & Like tags are not implemented!")
 		default:
@@ -14,6 +14,7 @@ import (
 
 	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
 )
@@ -111,6 +112,12 @@ func (m *authMiddleware) serveValidated(w http.ResponseWriter, r *http.Request,
 		token = auths[1]
 	}
 
+	// The tokens without username are the API keys of the users.
+	if !strings.Contains(token, "/") {
+		m.serveWithAPIKey(w, r, next, token)
+		return
+	}
+
 	parts := strings.Split(token, "/")
 	if len(parts) != 2 {
 		slog.Warn("[GoogleReader] Auth token does not have the expected structure username/hash",
@@ -179,6 +186,104 @@ func (m *authMiddleware) serveValidated(w http.ResponseWriter, r *http.Request,
 	next.ServeHTTP(w, r.WithContext(ctx))
 }
 
+func (m *authMiddleware) serveWithAPIKey(w http.ResponseWriter, r *http.Request, next http.Handler, token string) {
+	clientIP := request.ClientIP(r)
+
+	apiKey, err := m.store.APIKeyByToken(token)
+	if err != nil {
+		slog.Error("[GoogleReader] Unable to fetch API key from database",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Any("error", err),
+		)
+		sendUnauthorizedResponse(w, r)
+		return
+	}
+
+	if apiKey == nil {
+		slog.Warn("[GoogleReader] No API key found with the given token",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+		)
+		sendUnauthorizedResponse(w, r)
+		return
+	}
+
+	if apiKey.IsExpired() {
+		slog.Warn("[GoogleReader] The provided API key has expired",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Int64("api_key_id", apiKey.ID),
+		)
+		sendUnauthorizedResponse(w, r)
+		return
+	}
+
+	integration, err := m.store.Integration(apiKey.UserID)
+	if err != nil || !integration.GoogleReaderEnabled {
+		slog.Warn("[GoogleReader] The Google Reader API is not enabled for the owner of the API key",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Int64("api_key_id", apiKey.ID),
+			slog.Any("error", err),
+		)
+		sendUnauthorizedResponse(w, r)
+		return
+	}
+
+	user, err := m.store.UserByID(apiKey.UserID)
+	if err != nil || user == nil {
+		slog.Error("[GoogleReader] Unable to fetch user from database",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Any("error", err),
+		)
+		sendUnauthorizedResponse(w, r)
+		return
+	}
+
+	m.store.SetLastLogin(user.ID)
+	m.store.SetAPIKeyUsedTimestamp(user.ID, token, clientIP)
+
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, request.UserIDContextKey, user.ID)
+	ctx = context.WithValue(ctx, request.UserNameContextKey, user.Username)
+	ctx = context.WithValue(ctx, request.UserTimezoneContextKey, user.Timezone)
+	ctx = context.WithValue(ctx, request.IsAdminUserContextKey, user.IsAdmin)
+	ctx = context.WithValue(ctx, request.IsAuthenticatedContextKey, true)
+	ctx = context.WithValue(ctx, request.GoogleReaderTokenKey, token)
+	ctx = context.WithValue(ctx, request.APIKeyContextKey, apiKey)
+
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// requireScope rejects the requests authenticated with an API key without the given scope.
+func requireScope(scope string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if apiKey := request.APIKey(r); apiKey != nil && !apiKey.HasScope(scope) {
+			slog.Warn("[GoogleReader] The API key does not grant access to this endpoint",
+				slog.String("client_ip", request.ClientIP(r)),
+				slog.String("user_agent", r.UserAgent()),
+				slog.String("request_uri", r.RequestURI),
+				slog.Int64("api_key_id", apiKey.ID),
+			)
+			response.NewBuilder(w, r).
+				WithStatus(http.StatusForbidden).
+				WithHeader("Content-Type", "text/plain; charset=utf-8").
+				WithBodyAsString("Forbidden").
+				Write()
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func getAuthToken(username, password string) string {
 	token := hex.EncodeToString(hmac.New(sha256.New, []byte(username+password)).Sum(nil))
 	token = username + "/" + token
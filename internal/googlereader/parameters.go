@@ -36,4 +36,6 @@ const (
 	paramContinuation = "c"
 	// paramTimestamp - name of the parameter for unix timestamp
 	paramTimestamp = "ts"
+	// paramSearchQuery - name of the parameter containing a search query to filter the stream with
+	paramSearchQuery = "q"
 )
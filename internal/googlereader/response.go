@@ -119,6 +119,16 @@ type contentItemOrigin struct {
 	HTMLUrl  string `json:"htmlUrl"`
 }
 
+func sendLoginResponse(w http.ResponseWriter, r *http.Request, token, output string) {
+	result := loginResponse{SID: token, LSID: token, Auth: token}
+	if output == "json" {
+		response.JSON(w, r, result)
+		return
+	}
+
+	response.Text(w, r, result.String())
+}
+
 func sendUnauthorizedResponse(w http.ResponseWriter, r *http.Request) {
 	response.NewBuilder(w, r).
 		WithStatus(http.StatusUnauthorized).
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package highlight // import "miniflux.app/v2/internal/highlight"
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// contextLength is the number of characters kept before and after the highlighted text.
+const contextLength = 32
+
+// ErrTextNotFound is returned when the highlighted text is not in the entry content.
+var ErrTextNotFound = errors.New("highlight: the text is not in the entry content")
+
+// TextContent returns the text of an HTML document, like the textContent property of the DOM.
+func TextContent(document string) string {
+	var builder strings.Builder
+
+	tokenizer := html.NewTokenizer(strings.NewReader(document))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return builder.String()
+		case html.TextToken:
+			builder.Write(tokenizer.Text())
+		}
+	}
+}
+
+// Anchor finds the highlighted text in the entry content, then sets the offsets, the prefix and the suffix of the highlight.
+func Anchor(content string, highlight *model.Highlight) error {
+	text := []rune(TextContent(content))
+
+	start, found := locate(text, highlight)
+	if !found {
+		return ErrTextNotFound
+	}
+
+	end := start + utf8.RuneCountInString(highlight.Text)
+	highlight.StartOffset = start
+	highlight.EndOffset = end
+	highlight.Prefix = string(text[max(start-contextLength, 0):start])
+	highlight.Suffix = string(text[end:min(end+contextLength, len(text))])
+
+	return nil
+}
+
+// locate returns the position of the highlighted text, in characters.
+// The stored offset is used when the text is still there, otherwise the occurrence
+// with the best matching prefix and suffix is chosen, then the closest one to the stored offset.
+func locate(text []rune, highlight *model.Highlight) (int, bool) {
+	quote := []rune(highlight.Text)
+	if len(quote) == 0 || len(quote) > len(text) {
+		return 0, false
+	}
+
+	start := highlight.StartOffset
+	if start >= 0 && start+len(quote) <= len(text) && string(text[start:start+len(quote)]) == highlight.Text {
+		return start, true
+	}
+
+	prefix, suffix := []rune(highlight.Prefix), []rune(highlight.Suffix)
+	best, bestScore, bestDistance := -1, -1, 0
+	for i := 0; i+len(quote) <= len(text); i++ {
+		if text[i] != quote[0] || string(text[i:i+len(quote)]) != highlight.Text {
+			continue
+		}
+
+		score := commonSuffixLength(text[:i], prefix) + commonPrefixLength(text[i+len(quote):], suffix)
+		distance := max(i-start, start-i)
+		if score > bestScore || (score == bestScore && distance < bestDistance) {
+			best, bestScore, bestDistance = i, score, distance
+		}
+	}
+
+	return best, best >= 0
+}
+
+func commonPrefixLength(a, b []rune) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+func commonSuffixLength(a, b []rune) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[len(a)-1-n] == b[len(b)-1-n] {
+		n++
+	}
+	return n
+}
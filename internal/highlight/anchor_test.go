@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package highlight // import "miniflux.app/v2/internal/highlight"
+
+import (
+	"errors"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestTextContent(t *testing.T) {
+	input := `<p>Caf&eacute; <b>and</b> tea</p><!-- comment --><p>Second</p>`
+	if text := TextContent(input); text != "Café and teaSecond" {
+		t.Errorf(`Unexpected text: %q`, text)
+	}
+}
+
+func TestAnchorWithMatchingOffset(t *testing.T) {
+	highlight := &model.Highlight{Text: "and", StartOffset: 5}
+	if err := Anchor(`<p>Café <b>and</b> tea</p>`, highlight); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if highlight.StartOffset != 5 || highlight.EndOffset != 8 {
+		t.Errorf(`Unexpected offsets: %d-%d`, highlight.StartOffset, highlight.EndOffset)
+	}
+
+	if highlight.Prefix != "Café " || highlight.Suffix != " tea" {
+		t.Errorf(`Unexpected context: %q and %q`, highlight.Prefix, highlight.Suffix)
+	}
+}
+
+func TestAnchorWithMovedText(t *testing.T) {
+	highlight := &model.Highlight{Text: "fox", Prefix: "brown ", Suffix: " jumps", StartOffset: 2}
+	if err := Anchor(`<p>A new sentence. The grey fox sleeps. The quick brown fox jumps.</p>`, highlight); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if highlight.StartOffset != 53 || highlight.EndOffset != 56 {
+		t.Errorf(`The occurrence with the matching context should be chosen, got %d-%d`, highlight.StartOffset, highlight.EndOffset)
+	}
+}
+
+func TestAnchorWithClosestOccurrence(t *testing.T) {
+	highlight := &model.Highlight{Text: "ab", StartOffset: 7}
+	if err := Anchor(`ab ab ab ab`, highlight); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if highlight.StartOffset != 6 {
+		t.Errorf(`The closest occurrence should be chosen, got %d`, highlight.StartOffset)
+	}
+}
+
+func TestAnchorWithMissingText(t *testing.T) {
+	if err := Anchor(`<p>Some text</p>`, &model.Highlight{Text: "other"}); !errors.Is(err, ErrTextNotFound) {
+		t.Errorf(`Expected ErrTextNotFound, got %v`, err)
+	}
+
+	if err := Anchor(`<p>Some text</p>`, &model.Highlight{}); !errors.Is(err, ErrTextNotFound) {
+		t.Errorf(`An empty text should not be found, got %v`, err)
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package highlight // import "miniflux.app/v2/internal/highlight"
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+
+	"miniflux.app/v2/internal/model"
+)
+
+type textRange struct {
+	start, end  int
+	highlightID int64
+}
+
+// Render wraps the highlighted passages of the entry content with mark elements.
+// The highlights that cannot be found anymore are ignored, the overlapping parts are only marked once.
+func Render(content string, highlights model.Highlights) string {
+	if len(highlights) == 0 {
+		return content
+	}
+
+	text := []rune(TextContent(content))
+	ranges := make([]textRange, 0, len(highlights))
+	for _, highlight := range highlights {
+		if start, found := locate(text, highlight); found {
+			ranges = append(ranges, textRange{start, start + utf8.RuneCountInString(highlight.Text), highlight.ID})
+		}
+	}
+
+	if len(ranges) == 0 {
+		return content
+	}
+
+	slices.SortFunc(ranges, func(a, b textRange) int {
+		return a.start - b.start
+	})
+
+	for i := 1; i < len(ranges); i++ {
+		ranges[i].start = max(ranges[i].start, ranges[i-1].end)
+		ranges[i].end = max(ranges[i].end, ranges[i].start)
+	}
+
+	var builder strings.Builder
+	marked := make(map[int64]bool, len(ranges))
+	offset := 0
+
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return builder.String()
+		}
+
+		if tokenType != html.TextToken {
+			builder.Write(tokenizer.Raw())
+			continue
+		}
+
+		// The text without highlight is copied as is, to keep its original escaping.
+		raw := string(tokenizer.Raw())
+		chunk := []rune(string(tokenizer.Text()))
+		chunkStart, chunkEnd := offset, offset+len(chunk)
+		offset = chunkEnd
+
+		if !slices.ContainsFunc(ranges, func(r textRange) bool { return r.start < chunkEnd && r.end > chunkStart && r.start < r.end }) {
+			builder.WriteString(raw)
+			continue
+		}
+
+		position := chunkStart
+		for _, r := range ranges {
+			start, end := max(r.start, position), min(r.end, chunkEnd)
+			if start >= end {
+				continue
+			}
+
+			builder.WriteString(html.EscapeString(string(chunk[position-chunkStart : start-chunkStart])))
+
+			segment := string(chunk[start-chunkStart : end-chunkStart])
+			if strings.TrimFunc(segment, unicode.IsSpace) == "" {
+				// Whitespace is not wrapped, it may be between table cells where mark elements are not allowed.
+				builder.WriteString(html.EscapeString(segment))
+			} else {
+				builder.WriteString(`<mark class="entry-highlight" data-highlight-id="` + strconv.FormatInt(r.highlightID, 10) + `"`)
+				if !marked[r.highlightID] {
+					builder.WriteString(` id="highlight-` + strconv.FormatInt(r.highlightID, 10) + `"`)
+					marked[r.highlightID] = true
+				}
+				builder.WriteString(`>` + html.EscapeString(segment) + `</mark>`)
+			}
+
+			position = end
+		}
+
+		builder.WriteString(html.EscapeString(string(chunk[position-chunkStart:])))
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package highlight // import "miniflux.app/v2/internal/highlight"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestRenderWithoutHighlight(t *testing.T) {
+	input := `<p>Fish &amp; chips</p>`
+	if output := Render(input, nil); output != input {
+		t.Errorf(`Unexpected output: %q`, output)
+	}
+}
+
+func TestRender(t *testing.T) {
+	input := `<p>Fish &amp; chips are <em>tasty</em> food.</p>`
+	highlights := model.Highlights{{ID: 1, Text: "chips are tasty", StartOffset: 7}}
+
+	expected := `<p>Fish &amp; <mark class="entry-highlight" data-highlight-id="1" id="highlight-1">chips are </mark><em><mark class="entry-highlight" data-highlight-id="1">tasty</mark></em> food.</p>`
+	if output := Render(input, highlights); output != expected {
+		t.Errorf(`Unexpected output: %q`, output)
+	}
+}
+
+func TestRenderWithOverlappingHighlights(t *testing.T) {
+	input := `<p>one two three</p>`
+	highlights := model.Highlights{
+		{ID: 2, Text: "two three", StartOffset: 4},
+		{ID: 1, Text: "one two", StartOffset: 0},
+	}
+
+	expected := `<p><mark class="entry-highlight" data-highlight-id="1" id="highlight-1">one two</mark><mark class="entry-highlight" data-highlight-id="2" id="highlight-2"> three</mark></p>`
+	if output := Render(input, highlights); output != expected {
+		t.Errorf(`Unexpected output: %q`, output)
+	}
+}
+
+func TestRenderIgnoresWhitespaceBetweenCells(t *testing.T) {
+	input := "<table><tr><td>a</td>\n<td>b</td></tr></table>"
+	highlights := model.Highlights{{ID: 1, Text: "a\nb"}}
+
+	expected := "<table><tr><td><mark class=\"entry-highlight\" data-highlight-id=\"1\" id=\"highlight-1\">a</mark></td>\n<td><mark class=\"entry-highlight\" data-highlight-id=\"1\">b</mark></td></tr></table>"
+	if output := Render(input, highlights); output != expected {
+		t.Errorf(`Unexpected output: %q`, output)
+	}
+}
+
+func TestRenderIgnoresMissingHighlights(t *testing.T) {
+	input := `<p>Some text</p>`
+	if output := Render(input, model.Highlights{{ID: 1, Text: "missing"}}); output != input {
+		t.Errorf(`Unexpected output: %q`, output)
+	}
+}
@@ -22,6 +22,7 @@ const (
 	WebSessionContextKey
 	ClientIPContextKey
 	GoogleReaderTokenKey
+	APIKeyContextKey
 )
 
 // WebSession returns the current web session from the request context, if present.
@@ -34,6 +35,16 @@ func WebSession(r *http.Request) *model.WebSession {
 	return nil
 }
 
+// APIKey returns the API key used to authenticate the request, if present.
+func APIKey(r *http.Request) *model.APIKey {
+	if v := r.Context().Value(APIKeyContextKey); v != nil {
+		if value, valid := v.(*model.APIKey); valid {
+			return value
+		}
+	}
+	return nil
+}
+
 // GoogleReaderToken returns the Google Reader token from the request context, if present.
 func GoogleReaderToken(r *http.Request) string {
 	return getContextStringValue(r, GoogleReaderTokenKey)
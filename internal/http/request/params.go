@@ -4,6 +4,7 @@
 package request // import "miniflux.app/v2/internal/http/request"
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -103,6 +104,29 @@ func QueryInt64Param(r *http.Request, param string, defaultValue int64) int64 {
 	return val
 }
 
+// QueryInt64ParamList returns the positive integers of the named query parameter.
+// The values can be repeated or separated by commas.
+func QueryInt64ParamList(r *http.Request, param string) ([]int64, error) {
+	var results []int64
+	for _, value := range QueryStringParamList(r, param) {
+		for item := range strings.SplitSeq(value, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+
+			val, err := strconv.ParseInt(item, 10, 64)
+			if err != nil || val <= 0 {
+				return nil, fmt.Errorf("invalid value %q for parameter %q", item, param)
+			}
+
+			results = append(results, val)
+		}
+	}
+
+	return results, nil
+}
+
 // QueryBoolParam returns the named query parameter parsed as bool, or defaultValue when missing or invalid.
 func QueryBoolParam(r *http.Request, param string, defaultValue bool) bool {
 	value := r.URL.Query().Get(param)
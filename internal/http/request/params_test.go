@@ -245,6 +245,33 @@ func TestQueryStringParamList(t *testing.T) {
 	}
 }
 
+func TestQueryInt64ParamList(t *testing.T) {
+	u, _ := url.Parse("http://example.org/?ids=1,2&ids=3&ids=%204%20,,&invalid=1,a&negative=-1")
+	r := &http.Request{URL: u}
+
+	result, err := QueryInt64ParamList(r, "ids")
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	expected := []int64{1, 2, 3, 4}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf(`Unexpected result, got %v instead of %v`, result, expected)
+	}
+
+	if result, err := QueryInt64ParamList(r, "missing"); err != nil || result != nil {
+		t.Errorf(`Unexpected result for a missing parameter, got %v, %v`, result, err)
+	}
+
+	if _, err := QueryInt64ParamList(r, "invalid"); err == nil {
+		t.Error(`Invalid values should return an error`)
+	}
+
+	if _, err := QueryInt64ParamList(r, "negative"); err == nil {
+		t.Error(`Negative values should return an error`)
+	}
+}
+
 func TestHasQueryParam(t *testing.T) {
 	u, _ := url.Parse("http://example.org/?key=42")
 	r := &http.Request{URL: u}
@@ -53,6 +53,7 @@ type Builder struct {
 	headers           http.Header
 	enableCompression bool
 	body              any
+	modTime           time.Time
 }
 
 // NewBuilder creates a new response builder.
@@ -90,6 +91,14 @@ func (b *Builder) WithBodyAsReader(body io.Reader) *Builder {
 	return b
 }
 
+// WithBodyAsReadSeeker uses the given seekable reader as response body.
+// Range and conditional requests are handled by [http.ServeContent].
+func (b *Builder) WithBodyAsReadSeeker(body io.ReadSeeker, modTime time.Time) *Builder {
+	b.body = body
+	b.modTime = modTime
+	return b
+}
+
 // WithAttachment forces the document to be downloaded by the web browser.
 func (b *Builder) WithAttachment(filename string) *Builder {
 	b.headers.Set("Content-Disposition", formatContentDisposition("attachment", filename))
@@ -138,6 +147,10 @@ func (b *Builder) Write() {
 		b.compress(v)
 	case string:
 		b.compress([]byte(v))
+	case io.ReadSeeker:
+		// The status code is chosen by http.ServeContent, compression is not implemented in this case.
+		b.setHeaders()
+		http.ServeContent(b.w, b.r, "", b.modTime, v)
 	case io.Reader:
 		// Compression not implemented in this case
 		b.writeHeaders()
@@ -149,13 +162,16 @@ func (b *Builder) Write() {
 }
 
 func (b *Builder) writeHeaders() {
+	b.setHeaders()
+	b.w.WriteHeader(b.statusCode)
+}
+
+func (b *Builder) setHeaders() {
 	b.headers.Set("X-Content-Type-Options", "nosniff")
 	b.headers.Set("X-Frame-Options", "DENY")
 	b.headers.Set("Referrer-Policy", "no-referrer")
 
 	maps.Copy(b.w.Header(), b.headers)
-
-	b.w.WriteHeader(b.statusCode)
 }
 
 // values should be in sync with [Builder.compress] switch/case.
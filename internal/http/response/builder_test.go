@@ -540,3 +540,58 @@ func TestBuildResponseWithReaderBody(t *testing.T) {
 		t.Fatalf(`Unexpected body, got %s instead of %s`, actualBody, "body")
 	}
 }
+
+func TestBuildResponseWithReadSeekerBody(t *testing.T) {
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NewBuilder(w, r).WithHeader("Content-Type", "text/plain").WithBodyAsReadSeeker(strings.NewReader("body"), time.Time{}).Write()
+	})
+
+	handler.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf(`Unexpected status code, got %d instead of %d`, w.Code, http.StatusOK)
+	}
+
+	if actualBody := w.Body.String(); actualBody != "body" {
+		t.Fatalf(`Unexpected body, got %s instead of %s`, actualBody, "body")
+	}
+
+	if actualHeader := w.Header().Get("X-Content-Type-Options"); actualHeader != "nosniff" {
+		t.Fatalf(`Unexpected header value, got %q instead of %q`, actualHeader, "nosniff")
+	}
+}
+
+func TestBuildResponseWithReadSeekerBodyAndRange(t *testing.T) {
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Range", "bytes=1-2")
+
+	w := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NewBuilder(w, r).WithHeader("Content-Type", "text/plain").WithBodyAsReadSeeker(strings.NewReader("body"), time.Time{}).Write()
+	})
+
+	handler.ServeHTTP(w, r)
+
+	if w.Code != http.StatusPartialContent {
+		t.Fatalf(`Unexpected status code, got %d instead of %d`, w.Code, http.StatusPartialContent)
+	}
+
+	if actualBody := w.Body.String(); actualBody != "od" {
+		t.Fatalf(`Unexpected body, got %s instead of %s`, actualBody, "od")
+	}
+
+	if actualHeader := w.Header().Get("Content-Range"); actualHeader != "bytes 1-2/4" {
+		t.Fatalf(`Unexpected Content-Range header, got %q instead of %q`, actualHeader, "bytes 1-2/4")
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"miniflux.app/v2/internal/http/request"
 )
@@ -116,6 +118,28 @@ func JSONUnauthorized(w http.ResponseWriter, r *http.Request) {
 		Write()
 }
 
+// JSONTooManyRequests sends a too many requests error to the client, with the delay before retrying.
+func JSONTooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	slog.Warn(http.StatusText(http.StatusTooManyRequests),
+		slog.String("client_ip", request.ClientIP(r)),
+		slog.Group("request",
+			slog.String("method", r.Method),
+			slog.String("uri", r.RequestURI),
+			slog.String("user_agent", r.UserAgent()),
+		),
+		slog.Group("response",
+			slog.Int("status_code", http.StatusTooManyRequests),
+		),
+	)
+
+	NewBuilder(w, r).
+		WithStatus(http.StatusTooManyRequests).
+		WithHeader("Content-Type", jsonContentTypeHeader).
+		WithHeader("Retry-After", strconv.Itoa(max(int(retryAfter.Round(time.Second).Seconds()), 1))).
+		WithBodyAsBytes(generateJSONError(errors.New("too many requests"))).
+		Write()
+}
+
 // JSONForbidden sends a forbidden error to the client.
 func JSONForbidden(w http.ResponseWriter, r *http.Request) {
 	slog.Warn(http.StatusText(http.StatusForbidden),
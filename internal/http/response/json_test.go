@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestJSONResponse(t *testing.T) {
@@ -182,6 +183,34 @@ func TestJSONUnauthorizedResponse(t *testing.T) {
 	}
 }
 
+func TestJSONTooManyRequestsResponse(t *testing.T) {
+	r, err := http.NewRequest("POST", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		JSONTooManyRequests(w, r, 90*time.Second)
+	})
+
+	handler.ServeHTTP(w, r)
+	resp := w.Result()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf(`Unexpected status code, got %d instead of %d`, resp.StatusCode, http.StatusTooManyRequests)
+	}
+
+	if actualBody := w.Body.String(); actualBody != `{"error_message":"too many requests"}` {
+		t.Fatalf(`Unexpected body, got %s instead of %s`, actualBody, `{"error_message":"too many requests"}`)
+	}
+
+	if actualRetryAfter := resp.Header.Get("Retry-After"); actualRetryAfter != "90" {
+		t.Fatalf(`Unexpected Retry-After header, got %q instead of %q`, actualRetryAfter, "90")
+	}
+}
+
 func TestJSONForbiddenResponse(t *testing.T) {
 	r, err := http.NewRequest("GET", "/", nil)
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 	"miniflux.app/v2/internal/googlereader"
 	"miniflux.app/v2/internal/storage"
 	"miniflux.app/v2/internal/ui"
+	"miniflux.app/v2/internal/websub"
 	"miniflux.app/v2/internal/worker"
 )
 
@@ -37,6 +38,11 @@ func newRouter(store *storage.Storage, pool *worker.Pool) http.Handler {
 		appMux.Handle("/v1/", api.NewHandler(store, pool))
 	}
 
+	// WebSub callbacks.
+	if config.Opts.WebSub() {
+		appMux.Handle("/websub/", websub.NewHandler(store))
+	}
+
 	// Metrics endpoint.
 	if config.Opts.HasMetricsCollector() {
 		appMux.Handle("GET /metrics", metricsHandler())
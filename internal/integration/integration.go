@@ -507,6 +507,115 @@ func SendEntry(entry *model.Entry, userIntegrations *model.Integration) {
 	}
 }
 
+// SendHighlight sends a highlight and its note to the activated third-party providers that support highlights.
+// Readeck annotates the bookmark of the entry, which must have been saved to Readeck before.
+func SendHighlight(entry *model.Entry, highlight *model.Highlight, userIntegrations *model.Integration) {
+	if userIntegrations.ReadeckEnabled {
+		slog.Debug("Sending highlight to Readeck",
+			slog.Int64("user_id", userIntegrations.UserID),
+			slog.Int64("entry_id", entry.ID),
+			slog.Int64("highlight_id", highlight.ID),
+		)
+
+		client := readeck.NewClient(
+			userIntegrations.ReadeckURL,
+			userIntegrations.ReadeckAPIKey,
+			userIntegrations.ReadeckLabels,
+			userIntegrations.ReadeckOnlyURL,
+		)
+		if err := client.CreateAnnotation(entry.URL, highlight.Text, highlight.Note); err != nil {
+			slog.Error("Unable to send highlight to Readeck",
+				slog.Int64("user_id", userIntegrations.UserID),
+				slog.Int64("entry_id", entry.ID),
+				slog.Int64("highlight_id", highlight.ID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if userIntegrations.ReadwiseEnabled {
+		slog.Debug("Sending highlight to Readwise",
+			slog.Int64("user_id", userIntegrations.UserID),
+			slog.Int64("entry_id", entry.ID),
+			slog.Int64("highlight_id", highlight.ID),
+		)
+
+		client := readwise.NewClient(userIntegrations.ReadwiseAPIKey)
+		if err := client.CreateHighlight(highlight.Text, highlight.Note, entry.Title, entry.Author, entry.URL, highlight.CreatedAt); err != nil {
+			slog.Error("Unable to send highlight to Readwise",
+				slog.Int64("user_id", userIntegrations.UserID),
+				slog.Int64("entry_id", entry.ID),
+				slog.Int64("highlight_id", highlight.ID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if userIntegrations.WebhookEnabled {
+		var webhookURL string
+		if entry.Feed != nil && entry.Feed.WebhookURL != "" {
+			webhookURL = entry.Feed.WebhookURL
+		} else {
+			webhookURL = userIntegrations.WebhookURL
+		}
+
+		slog.Debug("Sending highlight to Webhook",
+			slog.Int64("user_id", userIntegrations.UserID),
+			slog.Int64("entry_id", entry.ID),
+			slog.Int64("highlight_id", highlight.ID),
+			slog.String("webhook_url", webhookURL),
+		)
+
+		webhookClient := webhook.NewClient(webhookURL, userIntegrations.WebhookSecret)
+		if err := webhookClient.SendSaveHighlightWebhookEvent(entry, highlight); err != nil {
+			slog.Warn("Unable to send highlight to Webhook",
+				slog.Int64("user_id", userIntegrations.UserID),
+				slog.Int64("entry_id", entry.ID),
+				slog.Int64("highlight_id", highlight.ID),
+				slog.String("webhook_url", webhookURL),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// SendFlaggedEntries sends the entries flagged by action rules to third-party providers, like the "Save" button does.
+func SendFlaggedEntries(entries model.Entries, userIntegrations *model.Integration) {
+	for _, entry := range entries {
+		if entry.SendToIntegrations {
+			SendEntry(entry, userIntegrations)
+		}
+	}
+}
+
+// PushFeedURLChange notifies activated third-party providers that the URL of a feed has been updated automatically.
+func PushFeedURLChange(feed *model.Feed, change *model.FeedURLChange, userIntegrations *model.Integration) {
+	if userIntegrations.WebhookEnabled {
+		var webhookURL string
+		if feed.WebhookURL != "" {
+			webhookURL = feed.WebhookURL
+		} else {
+			webhookURL = userIntegrations.WebhookURL
+		}
+
+		slog.Debug("Sending feed URL change to Webhook",
+			slog.Int64("user_id", userIntegrations.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("webhook_url", webhookURL),
+		)
+
+		webhookClient := webhook.NewClient(webhookURL, userIntegrations.WebhookSecret)
+		if err := webhookClient.SendFeedURLChangedWebhookEvent(feed, change); err != nil {
+			slog.Warn("Unable to send feed URL change to Webhook",
+				slog.Int64("user_id", userIntegrations.UserID),
+				slog.Int64("feed_id", feed.ID),
+				slog.String("webhook_url", webhookURL),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
 // PushEntries pushes a list of entries to activated third-party providers during feed refreshes.
 func PushEntries(feed *model.Feed, entries model.Entries, userIntegrations *model.Integration) {
 	if userIntegrations.MatrixBotEnabled {
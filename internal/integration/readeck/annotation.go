@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package readeck // import "miniflux.app/v2/internal/integration/readeck"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/client"
+	"miniflux.app/v2/internal/urllib"
+	"miniflux.app/v2/internal/version"
+
+	"golang.org/x/net/html"
+)
+
+// maxArticleSize limits the size of the article downloaded from Readeck to anchor an annotation.
+const maxArticleSize = 10 << 20
+
+// ErrBookmarkNotFound is returned when the entry has not been saved to Readeck.
+var ErrBookmarkNotFound = errors.New("readeck: bookmark not found")
+
+// ErrHighlightNotFound is returned when the highlighted text is not found in the article extracted by Readeck.
+var ErrHighlightNotFound = errors.New("readeck: highlighted text not found in the article")
+
+// CreateAnnotation highlights the text in the bookmark of the entry.
+//
+// Readeck anchors the annotations in its own extraction of the page, so the text is searched
+// in the article of the bookmark, and must be contained in a single element.
+func (c *Client) CreateAnnotation(entryURL, text, note string) error {
+	if c.baseURL == "" || c.apiKey == "" {
+		return errors.New("readeck: missing base URL or API key")
+	}
+
+	bookmarkID, err := c.findBookmark(entryURL)
+	if err != nil {
+		return err
+	}
+
+	article, err := c.bookmarkArticle(bookmarkID)
+	if err != nil {
+		return err
+	}
+
+	selector, startOffset, endOffset, found := findTextRange(article, text)
+	if !found {
+		return ErrHighlightNotFound
+	}
+
+	requestBody, err := json.Marshal(&readeckAnnotation{
+		StartSelector: selector,
+		StartOffset:   startOffset,
+		EndSelector:   selector,
+		EndOffset:     endOffset,
+		Color:         "yellow",
+		Note:          note,
+	})
+	if err != nil {
+		return fmt.Errorf("readeck: unable to encode request body: %v", err)
+	}
+
+	response, err := c.sendRequest(http.MethodPost, "/api/bookmarks/"+url.PathEscape(bookmarkID)+"/annotations", nil, requestBody)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("readeck: unable to create annotation: bookmark=%s status=%d", bookmarkID, response.StatusCode)
+	}
+
+	return nil
+}
+
+// findBookmark returns the ID of the most recent bookmark of the entry URL, among the bookmarks of its site.
+func (c *Client) findBookmark(entryURL string) (string, error) {
+	query := url.Values{}
+	if parsedURL, err := url.Parse(entryURL); err == nil && parsedURL.Hostname() != "" {
+		query.Set("site", parsedURL.Hostname())
+	}
+
+	response, err := c.sendRequest(http.MethodGet, "/api/bookmarks", query, nil)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 400 {
+		return "", fmt.Errorf("readeck: unable to search bookmarks: status=%d", response.StatusCode)
+	}
+
+	var bookmarks []readeckBookmarkInfo
+	if err := json.NewDecoder(response.Body).Decode(&bookmarks); err != nil {
+		return "", fmt.Errorf("readeck: unable to decode bookmarks: %v", err)
+	}
+
+	for _, bookmark := range bookmarks {
+		if bookmark.URL == entryURL {
+			return bookmark.ID, nil
+		}
+	}
+
+	return "", ErrBookmarkNotFound
+}
+
+// bookmarkArticle returns the article extracted by Readeck for the bookmark.
+func (c *Client) bookmarkArticle(bookmarkID string) (string, error) {
+	response, err := c.sendRequest(http.MethodGet, "/api/bookmarks/"+url.PathEscape(bookmarkID)+"/article", nil, nil)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 400 {
+		return "", fmt.Errorf("readeck: unable to fetch the article of bookmark %s: status=%d", bookmarkID, response.StatusCode)
+	}
+
+	article, err := io.ReadAll(io.LimitReader(response.Body, maxArticleSize))
+	if err != nil {
+		return "", fmt.Errorf("readeck: unable to read the article of bookmark %s: %v", bookmarkID, err)
+	}
+
+	return string(article), nil
+}
+
+func (c *Client) sendRequest(method, path string, query url.Values, body []byte) (*http.Response, error) {
+	apiEndpoint, err := urllib.JoinBaseURLAndPath(c.baseURL, path)
+	if err != nil {
+		return nil, fmt.Errorf(`readeck: invalid API endpoint: %v`, err)
+	}
+
+	if len(query) > 0 {
+		apiEndpoint += "?" + query.Encode()
+	}
+
+	var requestBody io.Reader
+	if body != nil {
+		requestBody = bytes.NewReader(body)
+	}
+
+	request, err := http.NewRequest(method, apiEndpoint, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("readeck: unable to create request: %v", err)
+	}
+
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", "Miniflux/"+version.Version)
+	request.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	httpClient := client.NewClientWithOptions(client.Options{Timeout: defaultClientTimeout, BlockPrivateNetworks: !config.Opts.IntegrationAllowPrivateNetworks()})
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("readeck: unable to send request: %v", err)
+	}
+
+	return response, nil
+}
+
+// findTextRange returns the selector of the deepest element of the article containing the text,
+// relative to the root of the article, and the offsets in characters of the text in this element.
+func findTextRange(article, text string) (string, int, int, bool) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", 0, 0, false
+	}
+
+	doc, err := html.Parse(strings.NewReader(article))
+	if err != nil {
+		return "", 0, 0, false
+	}
+
+	root := findElement(doc, "body")
+	if root == nil {
+		return "", 0, 0, false
+	}
+
+	var path []string
+	node := root
+	for {
+		var next *html.Node
+		var nextSegment string
+		indexes := make(map[string]int)
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode {
+				continue
+			}
+			indexes[child.Data]++
+			if next == nil && strings.Contains(textContent(child), text) {
+				next = child
+				nextSegment = child.Data + "[" + strconv.Itoa(indexes[child.Data]) + "]"
+			}
+		}
+
+		if next == nil {
+			break
+		}
+		node = next
+		path = append(path, nextSegment)
+	}
+
+	if len(path) == 0 {
+		return "", 0, 0, false
+	}
+
+	content := textContent(node)
+	index := strings.Index(content, text)
+	startOffset := utf8.RuneCountInString(content[:index])
+	return strings.Join(path, "/"), startOffset, startOffset + utf8.RuneCountInString(text), true
+}
+
+func findElement(node *html.Node, tag string) *html.Node {
+	if node.Type == html.ElementNode && node.Data == tag {
+		return node
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if element := findElement(child, tag); element != nil {
+			return element
+		}
+	}
+
+	return nil
+}
+
+func textContent(node *html.Node) string {
+	var builder strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			builder.WriteString(n.Data)
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(node)
+	return builder.String()
+}
+
+type readeckBookmarkInfo struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+type readeckAnnotation struct {
+	StartSelector string `json:"start_selector"`
+	StartOffset   int    `json:"start_offset"`
+	EndSelector   string `json:"end_selector"`
+	EndOffset     int    `json:"end_offset"`
+	Color         string `json:"color"`
+	Note          string `json:"note,omitempty"`
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package readeck
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFindTextRange(t *testing.T) {
+	article := `<section><p>First paragraph.</p><p>Second <em>paragraph</em> with the highlighted text, and more.</p></section><section><p>Élan vital here.</p></section>`
+
+	tests := []struct {
+		name        string
+		text        string
+		selector    string
+		startOffset int
+		endOffset   int
+		found       bool
+	}{
+		{"text across inline elements", "paragraph with the highlighted", "section[1]/p[2]", 7, 37, true},
+		{"first matching element", "paragraph", "section[1]/p[1]", 6, 15, true},
+		{"offsets in characters", "vital", "section[2]/p[1]", 5, 10, true},
+		{"text across blocks", "paragraph.Second", "section[1]", 6, 22, true},
+		{"unknown text", "missing", "", 0, 0, false},
+		{"empty text", " ", "", 0, 0, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, startOffset, endOffset, found := findTextRange(article, tc.text)
+			if found != tc.found || selector != tc.selector || startOffset != tc.startOffset || endOffset != tc.endOffset {
+				t.Errorf(`Unexpected range: %q %d-%d %v, expected %q %d-%d %v`, selector, startOffset, endOffset, found, tc.selector, tc.startOffset, tc.endOffset, tc.found)
+			}
+		})
+	}
+}
+
+func TestCreateAnnotation(t *testing.T) {
+	configureIntegrationAllowPrivateNetworksOption(t)
+
+	var annotation readeckAnnotation
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/bookmarks", func(w http.ResponseWriter, r *http.Request) {
+		if site := r.URL.Query().Get("site"); site != "example.com" {
+			t.Errorf(`Unexpected site filter: %q`, site)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":"other","url":"https://example.com/other"},{"id":"abc","url":"https://example.com/article"}]`))
+	})
+	mux.HandleFunc("GET /api/bookmarks/abc/article", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<section><p>Some highlighted text.</p></section>`))
+	})
+	mux.HandleFunc("POST /api/bookmarks/abc/annotations", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&annotation); err != nil {
+			t.Fatalf(`Unable to decode annotation: %v`, err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewClient(server.URL, "key", "", false)
+	if err := client.CreateAnnotation("https://example.com/article", "highlighted", "A note"); err != nil {
+		t.Fatalf(`Unable to create annotation: %v`, err)
+	}
+
+	expected := readeckAnnotation{StartSelector: "section[1]/p[1]", StartOffset: 5, EndSelector: "section[1]/p[1]", EndOffset: 16, Color: "yellow", Note: "A note"}
+	if annotation != expected {
+		t.Errorf(`Unexpected annotation: %+v`, annotation)
+	}
+
+	if err := client.CreateAnnotation("https://example.com/unknown", "highlighted", ""); !errors.Is(err, ErrBookmarkNotFound) {
+		t.Errorf(`Expected a bookmark not found error, got %v`, err)
+	}
+
+	if err := client.CreateAnnotation("https://example.com/article", "missing", ""); !errors.Is(err, ErrHighlightNotFound) {
+		t.Errorf(`Expected a highlight not found error, got %v`, err)
+	}
+}
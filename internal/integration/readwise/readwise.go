@@ -2,6 +2,7 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // Readwise Reader API documentation: https://readwise.io/reader_api
+// Readwise highlights API documentation: https://readwise.io/api_deets
 
 package readwise // import "miniflux.app/v2/internal/integration/readwise"
 
@@ -9,11 +10,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"miniflux.app/v2/internal/http/client"
 )
 
-const readwiseApiEndpoint = "https://readwise.io/api/v3/save/"
+const (
+	readwiseApiEndpoint           = "https://readwise.io/api/v3/save/"
+	readwiseHighlightsApiEndpoint = "https://readwise.io/api/v2/highlights/"
+)
 
 type Client struct {
 	apiKey string
@@ -47,6 +52,55 @@ func (c *Client) CreateDocument(entryURL string) error {
 	return nil
 }
 
+// CreateHighlight sends a highlight and its note, Readwise updates the existing highlight with the same text and source.
+func (c *Client) CreateHighlight(text, note, entryTitle, entryAuthor, entryURL string, highlightedAt time.Time) error {
+	if c.apiKey == "" {
+		return errors.New("readwise: missing API key")
+	}
+
+	response, err := client.NewRequestBuilder(readwiseHighlightsApiEndpoint).
+		WithMethod(http.MethodPost).
+		WithJSON(&readwiseHighlights{
+			Highlights: []readwiseHighlight{{
+				Text:          text,
+				Note:          note,
+				Title:         entryTitle,
+				Author:        entryAuthor,
+				SourceURL:     entryURL,
+				SourceType:    "miniflux",
+				Category:      "articles",
+				HighlightedAt: highlightedAt.Format(time.RFC3339),
+			}},
+		}).
+		WithHeader("Authorization", "Token "+c.apiKey).
+		Do()
+	if err != nil {
+		return fmt.Errorf("readwise: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("readwise: unable to create highlight: url=%s status=%d", readwiseHighlightsApiEndpoint, response.StatusCode)
+	}
+
+	return nil
+}
+
+type readwiseHighlights struct {
+	Highlights []readwiseHighlight `json:"highlights"`
+}
+
+type readwiseHighlight struct {
+	Text          string `json:"text"`
+	Note          string `json:"note,omitempty"`
+	Title         string `json:"title,omitempty"`
+	Author        string `json:"author,omitempty"`
+	SourceURL     string `json:"source_url,omitempty"`
+	SourceType    string `json:"source_type"`
+	Category      string `json:"category"`
+	HighlightedAt string `json:"highlighted_at"`
+}
+
 type readwiseDocument struct {
 	URL string `json:"url"`
 }
@@ -16,8 +16,10 @@ import (
 )
 
 const (
-	NewEntriesEventType = "new_entries"
-	SaveEntryEventType  = "save_entry"
+	NewEntriesEventType     = "new_entries"
+	SaveEntryEventType      = "save_entry"
+	FeedURLChangedEventType = "feed_url_changed"
+	SaveHighlightEventType  = "save_highlight"
 )
 
 type Client struct {
@@ -109,6 +111,61 @@ func (c *Client) SendNewEntriesWebhookEvent(feed *model.Feed, entries model.Entr
 	})
 }
 
+func (c *Client) SendFeedURLChangedWebhookEvent(feed *model.Feed, change *model.FeedURLChange) error {
+	return c.makeRequest(FeedURLChangedEventType, &WebhookFeedURLChangedEvent{
+		EventType: FeedURLChangedEventType,
+		Feed: &WebhookFeed{
+			ID:         feed.ID,
+			UserID:     feed.UserID,
+			CategoryID: feed.Category.ID,
+			Category:   &WebhookCategory{ID: feed.Category.ID, Title: feed.Category.Title},
+			FeedURL:    feed.FeedURL,
+			SiteURL:    feed.SiteURL,
+			Title:      feed.Title,
+			CheckedAt:  feed.CheckedAt,
+		},
+		OldURL: change.OldURL,
+		NewURL: change.NewURL,
+		Reason: change.Reason,
+	})
+}
+
+func (c *Client) SendSaveHighlightWebhookEvent(entry *model.Entry, highlight *model.Highlight) error {
+	return c.makeRequest(SaveHighlightEventType, &WebhookSaveHighlightEvent{
+		EventType: SaveHighlightEventType,
+		Highlight: &WebhookHighlight{
+			ID:          highlight.ID,
+			EntryID:     highlight.EntryID,
+			Text:        highlight.Text,
+			Prefix:      highlight.Prefix,
+			Suffix:      highlight.Suffix,
+			StartOffset: highlight.StartOffset,
+			EndOffset:   highlight.EndOffset,
+			Note:        highlight.Note,
+			CreatedAt:   highlight.CreatedAt,
+			UpdatedAt:   highlight.UpdatedAt,
+		},
+		Entry: &WebhookEntry{
+			ID:          entry.ID,
+			UserID:      entry.UserID,
+			FeedID:      entry.FeedID,
+			Status:      entry.Status,
+			Hash:        entry.Hash,
+			Title:       entry.Title,
+			URL:         entry.URL,
+			CommentsURL: entry.CommentsURL,
+			Date:        entry.Date,
+			CreatedAt:   entry.CreatedAt,
+			ChangedAt:   entry.ChangedAt,
+			Author:      entry.Author,
+			ShareCode:   entry.ShareCode,
+			Starred:     entry.Starred,
+			ReadingTime: entry.ReadingTime,
+			Tags:        entry.Tags,
+		},
+	})
+}
+
 func (c *Client) makeRequest(eventType string, payload any) error {
 	if c.webhookURL == "" {
 		return errors.New(`webhook: missing webhook URL`)
@@ -185,3 +242,30 @@ type WebhookSaveEntryEvent struct {
 	EventType string        `json:"event_type"`
 	Entry     *WebhookEntry `json:"entry"`
 }
+
+type WebhookFeedURLChangedEvent struct {
+	EventType string       `json:"event_type"`
+	Feed      *WebhookFeed `json:"feed"`
+	OldURL    string       `json:"old_url"`
+	NewURL    string       `json:"new_url"`
+	Reason    string       `json:"reason"`
+}
+
+type WebhookHighlight struct {
+	ID          int64     `json:"id"`
+	EntryID     int64     `json:"entry_id"`
+	Text        string    `json:"text"`
+	Prefix      string    `json:"prefix"`
+	Suffix      string    `json:"suffix"`
+	StartOffset int       `json:"start_offset"`
+	EndOffset   int       `json:"end_offset"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type WebhookSaveHighlightEvent struct {
+	EventType string            `json:"event_type"`
+	Highlight *WebhookHighlight `json:"highlight"`
+	Entry     *WebhookEntry     `json:"entry"`
+}
@@ -0,0 +1,600 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package mediaproxy // import "miniflux.app/v2/internal/mediaproxy"
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/reader/fetcher"
+)
+
+// CacheInstance is the disk cache of the media proxy, nil when the cache is disabled.
+var CacheInstance *Cache
+
+// ErrMediaTooLarge is returned when a media does not fit in the cache.
+var ErrMediaTooLarge = errors.New("mediaproxy: media too large for the cache")
+
+const (
+	// defaultCacheLifetime is used when the origin does not send any caching header.
+	defaultCacheLifetime = 24 * time.Hour
+
+	prefetchQueueSize = 1000
+)
+
+// CachedMedia describes a media stored in the cache.
+type CachedMedia struct {
+	URL          string    `json:"url"`
+	Digest       string    `json:"digest"`
+	Size         int64     `json:"size"`
+	ContentType  string    `json:"content_type"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	StoredAt     time.Time `json:"stored_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// Expired returns true if the media must be revalidated with the origin before being served.
+func (m *CachedMedia) Expired() bool {
+	return !time.Now().Before(m.ExpiresAt)
+}
+
+type cacheItem struct {
+	key   string
+	media CachedMedia
+}
+
+type prefetchRequest struct {
+	mediaURL string
+	referer  string
+}
+
+// Cache stores the media downloaded by the proxy on disk.
+//
+// The content is addressed by its SHA-256 digest, a file referenced by several URLs is stored once.
+// The least recently used media are removed when the total size exceeds the limit.
+type Cache struct {
+	directory string
+	maxSize   int64
+
+	mu    sync.Mutex
+	items map[string]*list.Element
+	lru   *list.List
+	blobs map[string]int
+	size  int64
+
+	prefetchQueue chan prefetchRequest
+}
+
+// NewCache opens the cache stored in the given directory, and starts the prefetch worker.
+func NewCache(directory string, maxSize int64) (*Cache, error) {
+	c := &Cache{
+		directory:     directory,
+		maxSize:       maxSize,
+		items:         make(map[string]*list.Element),
+		lru:           list.New(),
+		blobs:         make(map[string]int),
+		prefetchQueue: make(chan prefetchRequest, prefetchQueueSize),
+	}
+
+	// Unfinished downloads are lost when the process stops.
+	if err := os.RemoveAll(c.temporaryDirectory()); err != nil {
+		return nil, fmt.Errorf("mediaproxy: unable to clean temporary directory: %w", err)
+	}
+
+	for _, dir := range []string{c.mediaDirectory(), c.blobDirectory(), c.temporaryDirectory()} {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("mediaproxy: unable to create cache directory: %w", err)
+		}
+	}
+
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+
+	go c.prefetchWorker()
+
+	return c, nil
+}
+
+// Lookup returns the media cached for the given URL, or nil if the URL is not cached.
+func (c *Cache) Lookup(mediaURL string) *CachedMedia {
+	if c == nil {
+		return nil
+	}
+
+	key := crypto.SHA256(mediaURL)
+
+	c.mu.Lock()
+	element, found := c.items[key]
+	if !found {
+		c.mu.Unlock()
+		return nil
+	}
+
+	c.lru.MoveToFront(element)
+	media := element.Value.(*cacheItem).media
+	c.mu.Unlock()
+
+	// The modification time of the metadata keeps the access order across restarts,
+	// the file system is not touched while holding the lock.
+	now := time.Now()
+	os.Chtimes(c.mediaPath(key), now, now)
+
+	return &media
+}
+
+// Open returns the content of a cached media.
+func (c *Cache) Open(media *CachedMedia) (*os.File, error) {
+	return os.Open(c.blobPath(media.Digest))
+}
+
+// NewWriter returns a writer storing a response body until it is committed to the cache.
+func (c *Cache) NewWriter() (*CacheWriter, error) {
+	file, err := os.CreateTemp(c.temporaryDirectory(), "media-*")
+	if err != nil {
+		return nil, fmt.Errorf("mediaproxy: unable to create temporary file: %w", err)
+	}
+
+	return &CacheWriter{file: file, hash: sha256.New(), limit: c.maxSize}, nil
+}
+
+// Discard removes the content of an uncommitted writer.
+func (c *Cache) Discard(w *CacheWriter) {
+	w.file.Close()
+	os.Remove(w.file.Name())
+}
+
+// CommitResponse reads the rest of the response body, and stores the media if the response is complete.
+func (c *Cache) CommitResponse(w *CacheWriter, mediaURL string, resp *http.Response, lifetime time.Duration) (*CachedMedia, error) {
+	// The client may have stopped reading the body before the end.
+	if w.err == nil {
+		if _, err := io.Copy(w, io.LimitReader(resp.Body, w.limit-w.size+1)); err != nil {
+			c.Discard(w)
+			return nil, fmt.Errorf("mediaproxy: unable to read response body: %w", err)
+		}
+	}
+
+	if w.err == nil && resp.ContentLength >= 0 && w.size != resp.ContentLength {
+		c.Discard(w)
+		return nil, fmt.Errorf("mediaproxy: incomplete response body, got %d bytes instead of %d", w.size, resp.ContentLength)
+	}
+
+	return c.commit(w, &CachedMedia{
+		URL:          mediaURL,
+		ContentType:  resp.Header.Get("Content-Type"),
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		StoredAt:     time.Now(),
+		ExpiresAt:    time.Now().Add(lifetime),
+	})
+}
+
+// Store saves the response body in the cache.
+func (c *Cache) Store(mediaURL string, resp *http.Response, lifetime time.Duration) (*CachedMedia, error) {
+	if resp.ContentLength > c.maxSize {
+		return nil, ErrMediaTooLarge
+	}
+
+	w, err := c.NewWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CommitResponse(w, mediaURL, resp, lifetime)
+}
+
+// Refresh updates the validity of a cached media after a "not modified" response from the origin.
+func (c *Cache) Refresh(mediaURL string, header http.Header) {
+	lifetime, _ := CacheLifetime(header)
+	key := crypto.SHA256(mediaURL)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, found := c.items[key]
+	if !found {
+		return
+	}
+
+	item := element.Value.(*cacheItem)
+	item.media.ExpiresAt = time.Now().Add(lifetime)
+	if etag := header.Get("ETag"); etag != "" {
+		item.media.ETag = etag
+	}
+	if lastModified := header.Get("Last-Modified"); lastModified != "" {
+		item.media.LastModified = lastModified
+	}
+
+	if err := c.writeMetadata(key, &item.media); err != nil {
+		slog.Warn("MediaProxy: Unable to update cached media",
+			slog.String("media_url", mediaURL),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// Prefetch queues the download of a media to the cache, the request is dropped when the queue is full.
+func (c *Cache) Prefetch(mediaURL, referer string) {
+	if c == nil {
+		return
+	}
+
+	select {
+	case c.prefetchQueue <- prefetchRequest{mediaURL: mediaURL, referer: referer}:
+	default:
+		slog.Debug("MediaProxy: Prefetch queue is full, the media is skipped",
+			slog.String("media_url", mediaURL),
+		)
+	}
+}
+
+func (c *Cache) prefetchWorker() {
+	for request := range c.prefetchQueue {
+		if err := c.prefetch(request); err != nil {
+			slog.Debug("MediaProxy: Unable to prefetch media",
+				slog.String("media_url", request.mediaURL),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+func (c *Cache) prefetch(request prefetchRequest) error {
+	if media := c.peek(request.mediaURL); media != nil && !media.Expired() {
+		return nil
+	}
+
+	requestBuilder := fetcher.NewRequestBuilder().
+		WithTimeout(config.Opts.MediaProxyHTTPClientTimeout()).
+		WithoutCompression()
+
+	if request.referer != "" {
+		requestBuilder = requestBuilder.WithHeader("Referer", request.referer)
+	}
+
+	resp, err := requestBuilder.ExecuteRequest(request.mediaURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("mediaproxy: unexpected status code %d", resp.StatusCode)
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); !strings.HasPrefix(strings.ToLower(contentType), "image/") {
+		return fmt.Errorf("mediaproxy: unexpected content type %q", contentType)
+	}
+
+	lifetime, storable := CacheLifetime(resp.Header)
+	if !storable {
+		return nil
+	}
+
+	_, err = c.Store(request.mediaURL, resp, lifetime)
+	return err
+}
+
+// peek returns the media cached for the given URL without changing the access order.
+func (c *Cache) peek(mediaURL string) *CachedMedia {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, found := c.items[crypto.SHA256(mediaURL)]
+	if !found {
+		return nil
+	}
+
+	media := element.Value.(*cacheItem).media
+	return &media
+}
+
+func (c *Cache) commit(w *CacheWriter, media *CachedMedia) (*CachedMedia, error) {
+	if err := w.file.Close(); err != nil && w.err == nil {
+		w.err = err
+	}
+
+	if w.err != nil {
+		os.Remove(w.file.Name())
+		return nil, w.err
+	}
+
+	media.Digest = hex.EncodeToString(w.hash.Sum(nil))
+	media.Size = w.size
+	key := crypto.SHA256(media.URL)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.blobs[media.Digest] > 0 {
+		os.Remove(w.file.Name())
+	} else {
+		blobPath := c.blobPath(media.Digest)
+		if err := os.MkdirAll(filepath.Dir(blobPath), 0o700); err != nil {
+			os.Remove(w.file.Name())
+			return nil, fmt.Errorf("mediaproxy: unable to create cache directory: %w", err)
+		}
+
+		if err := os.Rename(w.file.Name(), blobPath); err != nil {
+			os.Remove(w.file.Name())
+			return nil, fmt.Errorf("mediaproxy: unable to store media: %w", err)
+		}
+	}
+
+	if err := c.writeMetadata(key, media); err != nil {
+		if c.blobs[media.Digest] == 0 {
+			os.Remove(c.blobPath(media.Digest))
+		}
+		return nil, err
+	}
+
+	// The new item is added first, the content is kept when it did not change.
+	previous, hasPrevious := c.items[key]
+	c.addItem(&cacheItem{key: key, media: *media})
+	if hasPrevious {
+		c.removeElement(previous, false)
+	}
+	c.evict()
+
+	return media, nil
+}
+
+func (c *Cache) addItem(item *cacheItem) {
+	c.items[item.key] = c.lru.PushFront(item)
+
+	if c.blobs[item.media.Digest] == 0 {
+		c.size += item.media.Size
+	}
+	c.blobs[item.media.Digest]++
+}
+
+// removeElement drops an item from the cache, and its content when no other item references it.
+func (c *Cache) removeElement(element *list.Element, removeMetadata bool) {
+	item := element.Value.(*cacheItem)
+	c.lru.Remove(element)
+	if c.items[item.key] == element {
+		delete(c.items, item.key)
+	}
+
+	if removeMetadata {
+		os.Remove(c.mediaPath(item.key))
+	}
+
+	c.blobs[item.media.Digest]--
+	if c.blobs[item.media.Digest] <= 0 {
+		delete(c.blobs, item.media.Digest)
+		c.size -= item.media.Size
+		os.Remove(c.blobPath(item.media.Digest))
+	}
+}
+
+// evict removes the least recently used media until the cache fits in the size limit.
+func (c *Cache) evict() {
+	for c.size > c.maxSize {
+		element := c.lru.Back()
+		if element == nil {
+			return
+		}
+
+		slog.Debug("MediaProxy: Removing least recently used media from the cache",
+			slog.String("media_url", element.Value.(*cacheItem).media.URL),
+		)
+		c.removeElement(element, true)
+	}
+}
+
+// load reads the metadata of the cached media, and removes the incomplete entries and the orphan files.
+func (c *Cache) load() error {
+	type loadedItem struct {
+		item       *cacheItem
+		accessedAt time.Time
+	}
+
+	var loadedItems []loadedItem
+
+	err := filepath.WalkDir(c.mediaDirectory(), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		var media CachedMedia
+		data, err := os.ReadFile(path)
+		if err == nil {
+			err = json.Unmarshal(data, &media)
+		}
+
+		key := strings.TrimSuffix(entry.Name(), ".json")
+		if err != nil || media.Digest == "" || key != crypto.SHA256(media.URL) {
+			os.Remove(path)
+			return nil
+		}
+
+		if blobInfo, err := os.Stat(c.blobPath(media.Digest)); err != nil || blobInfo.Size() != media.Size {
+			os.Remove(path)
+			return nil
+		}
+
+		loadedItems = append(loadedItems, loadedItem{item: &cacheItem{key: key, media: media}, accessedAt: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("mediaproxy: unable to load cache: %w", err)
+	}
+
+	slices.SortFunc(loadedItems, func(a, b loadedItem) int {
+		return a.accessedAt.Compare(b.accessedAt)
+	})
+
+	for _, loadedItem := range loadedItems {
+		c.addItem(loadedItem.item)
+	}
+
+	err = filepath.WalkDir(c.blobDirectory(), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		if c.blobs[entry.Name()] == 0 {
+			os.Remove(path)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("mediaproxy: unable to load cache: %w", err)
+	}
+
+	c.evict()
+
+	slog.Debug("MediaProxy: Cache loaded",
+		slog.String("directory", c.directory),
+		slog.Int("media_count", len(c.items)),
+		slog.Int64("size", c.size),
+	)
+
+	return nil
+}
+
+func (c *Cache) writeMetadata(key string, media *CachedMedia) error {
+	data, err := json.Marshal(media)
+	if err != nil {
+		return fmt.Errorf("mediaproxy: unable to encode media metadata: %w", err)
+	}
+
+	mediaPath := c.mediaPath(key)
+	if err := os.MkdirAll(filepath.Dir(mediaPath), 0o700); err != nil {
+		return fmt.Errorf("mediaproxy: unable to create cache directory: %w", err)
+	}
+
+	if err := os.WriteFile(mediaPath, data, 0o600); err != nil {
+		return fmt.Errorf("mediaproxy: unable to write media metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Cache) mediaDirectory() string {
+	return filepath.Join(c.directory, "media")
+}
+
+func (c *Cache) blobDirectory() string {
+	return filepath.Join(c.directory, "blobs")
+}
+
+func (c *Cache) temporaryDirectory() string {
+	return filepath.Join(c.directory, "tmp")
+}
+
+func (c *Cache) mediaPath(key string) string {
+	return filepath.Join(c.mediaDirectory(), key[:2], key+".json")
+}
+
+func (c *Cache) blobPath(digest string) string {
+	return filepath.Join(c.blobDirectory(), digest[:2], digest)
+}
+
+// CacheWriter stores a response body in a temporary file.
+//
+// Write never fails, so the response can be sent to the client while it is stored.
+// The error is reported when the writer is committed.
+type CacheWriter struct {
+	file  *os.File
+	hash  hash.Hash
+	size  int64
+	limit int64
+	err   error
+}
+
+func (w *CacheWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return len(p), nil
+	}
+
+	if w.size+int64(len(p)) > w.limit {
+		w.err = ErrMediaTooLarge
+		return len(p), nil
+	}
+
+	if _, err := w.file.Write(p); err != nil {
+		w.err = fmt.Errorf("mediaproxy: unable to write media: %w", err)
+		return len(p), nil
+	}
+
+	w.hash.Write(p)
+	w.size += int64(len(p))
+
+	return len(p), nil
+}
+
+// CacheLifetime returns how long a response can be served from the cache according to its caching headers.
+// The second return value is false when the response must not be stored.
+func CacheLifetime(header http.Header) (time.Duration, bool) {
+	maxAge, sharedMaxAge := -1, -1
+
+	for directive := range strings.SplitSeq(strings.ToLower(header.Get("Cache-Control")), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		value = strings.Trim(value, `"`)
+
+		switch name {
+		case "no-store", "private":
+			return 0, false
+		case "no-cache":
+			return 0, true
+		case "max-age":
+			if seconds, err := strconv.Atoi(value); err == nil {
+				maxAge = seconds
+			}
+		case "s-maxage":
+			if seconds, err := strconv.Atoi(value); err == nil {
+				sharedMaxAge = seconds
+			}
+		}
+	}
+
+	switch {
+	case sharedMaxAge >= 0:
+		return time.Duration(sharedMaxAge) * time.Second, true
+	case maxAge >= 0:
+		return time.Duration(maxAge) * time.Second, true
+	}
+
+	if expiresHeaderValue := header.Get("Expires"); expiresHeaderValue != "" {
+		expires, err := http.ParseTime(expiresHeaderValue)
+		if err != nil {
+			return 0, true
+		}
+
+		date := time.Now()
+		if parsedDate, err := http.ParseTime(header.Get("Date")); err == nil {
+			date = parsedDate
+		}
+
+		return max(expires.Sub(date), 0), true
+	}
+
+	return defaultCacheLifetime, true
+}
@@ -0,0 +1,317 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package mediaproxy // import "miniflux.app/v2/internal/mediaproxy"
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+)
+
+func newTestResponse(body string, header http.Header) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		StatusCode:    http.StatusOK,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}
+}
+
+func readCachedMedia(t *testing.T, cache *Cache, media *CachedMedia) string {
+	t.Helper()
+
+	file, err := cache.Open(media)
+	if err != nil {
+		t.Fatalf(`Unable to open cached media: %v`, err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf(`Unable to read cached media: %v`, err)
+	}
+
+	return string(data)
+}
+
+func TestCacheStoreAndLookup(t *testing.T) {
+	cache, err := NewCache(t.TempDir(), 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := http.Header{"Content-Type": {"image/png"}, "Etag": {`"v1"`}}
+	if _, err := cache.Store("https://example.org/image.png", newTestResponse("image data", header), time.Hour); err != nil {
+		t.Fatalf(`Unable to store media: %v`, err)
+	}
+
+	media := cache.Lookup("https://example.org/image.png")
+	if media == nil {
+		t.Fatal(`The media should be cached`)
+	}
+
+	if media.ContentType != "image/png" || media.ETag != `"v1"` || media.Size != 10 || media.Expired() {
+		t.Errorf(`Unexpected cached media: %+v`, media)
+	}
+
+	if content := readCachedMedia(t, cache, media); content != "image data" {
+		t.Errorf(`Unexpected content, got %q`, content)
+	}
+
+	if cache.Lookup("https://example.org/other.png") != nil {
+		t.Error(`Unknown URLs should not be cached`)
+	}
+}
+
+func TestCacheLookupWhenDisabled(t *testing.T) {
+	var cache *Cache
+
+	if cache.Lookup("https://example.org/image.png") != nil {
+		t.Error(`A disabled cache should not return any media`)
+	}
+
+	cache.Prefetch("https://example.org/image.png", "")
+}
+
+func TestCacheStoresIdenticalContentOnce(t *testing.T) {
+	cache, err := NewCache(t.TempDir(), 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, _ := cache.Store("https://example.org/a.png", newTestResponse("same content", nil), time.Hour)
+	second, _ := cache.Store("https://example.org/b.png", newTestResponse("same content", nil), time.Hour)
+
+	if first.Digest != second.Digest {
+		t.Fatal(`Identical content should have the same digest`)
+	}
+
+	if cache.size != 12 {
+		t.Errorf(`Identical content should be counted once, got a size of %d`, cache.size)
+	}
+
+	// Replacing a media with the same content must keep the stored file.
+	if _, err := cache.Store("https://example.org/a.png", newTestResponse("same content", nil), time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	if content := readCachedMedia(t, cache, cache.Lookup("https://example.org/a.png")); content != "same content" {
+		t.Errorf(`Unexpected content, got %q`, content)
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsedMedia(t *testing.T) {
+	cache, err := NewCache(t.TempDir(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Store("https://example.org/a.png", newTestResponse("aaaa", nil), time.Hour)
+	cache.Store("https://example.org/b.png", newTestResponse("bbbb", nil), time.Hour)
+	cache.Lookup("https://example.org/a.png")
+	cache.Store("https://example.org/c.png", newTestResponse("cccc", nil), time.Hour)
+
+	if cache.Lookup("https://example.org/b.png") != nil {
+		t.Error(`The least recently used media should be removed`)
+	}
+
+	if cache.Lookup("https://example.org/a.png") == nil || cache.Lookup("https://example.org/c.png") == nil {
+		t.Error(`The recently used media should be kept`)
+	}
+
+	if cache.size != 8 {
+		t.Errorf(`Unexpected cache size, got %d`, cache.size)
+	}
+}
+
+func TestCacheRejectsLargeMedia(t *testing.T) {
+	cache, err := NewCache(t.TempDir(), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cache.Store("https://example.org/a.png", newTestResponse("too large", nil), time.Hour); !errors.Is(err, ErrMediaTooLarge) {
+		t.Errorf(`Expected ErrMediaTooLarge, got %v`, err)
+	}
+
+	resp := newTestResponse("too large", nil)
+	resp.ContentLength = -1
+	if _, err := cache.Store("https://example.org/a.png", resp, time.Hour); !errors.Is(err, ErrMediaTooLarge) {
+		t.Errorf(`Expected ErrMediaTooLarge without Content-Length, got %v`, err)
+	}
+
+	if cache.Lookup("https://example.org/a.png") != nil {
+		t.Error(`A media larger than the cache should not be stored`)
+	}
+}
+
+func TestCacheRejectsIncompleteResponse(t *testing.T) {
+	cache, err := NewCache(t.TempDir(), 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := newTestResponse("partial", nil)
+	resp.ContentLength = 100
+
+	if _, err := cache.Store("https://example.org/a.png", resp, time.Hour); err == nil {
+		t.Error(`An incomplete response should not be stored`)
+	}
+}
+
+func TestCacheCommitResponseAfterPartialRead(t *testing.T) {
+	cache, err := NewCache(t.TempDir(), 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writer, err := cache.NewWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The client stops reading after the first bytes.
+	resp := newTestResponse("image data", nil)
+	io.CopyN(io.Discard, io.TeeReader(resp.Body, writer), 3)
+
+	media, err := cache.CommitResponse(writer, "https://example.org/a.png", resp, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if content := readCachedMedia(t, cache, media); content != "image data" {
+		t.Errorf(`Unexpected content, got %q`, content)
+	}
+}
+
+func TestCacheRefresh(t *testing.T) {
+	cache, err := NewCache(t.TempDir(), 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Store("https://example.org/a.png", newTestResponse("image", http.Header{"Etag": {`"v1"`}}), 0)
+	if media := cache.Lookup("https://example.org/a.png"); !media.Expired() {
+		t.Fatal(`The media should be expired`)
+	}
+
+	cache.Refresh("https://example.org/a.png", http.Header{"Cache-Control": {"max-age=3600"}, "Etag": {`"v2"`}})
+
+	media := cache.Lookup("https://example.org/a.png")
+	if media.Expired() || media.ETag != `"v2"` {
+		t.Errorf(`Unexpected cached media after refresh: %+v`, media)
+	}
+}
+
+func TestCacheReload(t *testing.T) {
+	directory := t.TempDir()
+
+	cache, err := NewCache(directory, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Store("https://example.org/a.png", newTestResponse("aaaa", nil), time.Hour)
+	cache.Store("https://example.org/b.png", newTestResponse("bbbb", nil), time.Hour)
+
+	orphanPath := filepath.Join(directory, "blobs", "ab", "abcdef")
+	os.MkdirAll(filepath.Dir(orphanPath), 0o700)
+	os.WriteFile(orphanPath, []byte("orphan"), 0o600)
+
+	reloadedCache, err := NewCache(directory, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if content := readCachedMedia(t, reloadedCache, reloadedCache.Lookup("https://example.org/a.png")); content != "aaaa" {
+		t.Errorf(`Unexpected content after reload, got %q`, content)
+	}
+
+	if reloadedCache.size != 8 {
+		t.Errorf(`Unexpected cache size after reload, got %d`, reloadedCache.size)
+	}
+
+	if _, err := os.Stat(orphanPath); !os.IsNotExist(err) {
+		t.Error(`Orphan files should be removed`)
+	}
+}
+
+func TestCacheLifetime(t *testing.T) {
+	now := time.Now().UTC()
+
+	scenarios := []struct {
+		name             string
+		header           http.Header
+		expectedLifetime time.Duration
+		expectedStorable bool
+	}{
+		{"no header", http.Header{}, defaultCacheLifetime, true},
+		{"max-age", http.Header{"Cache-Control": {"public, max-age=600"}}, 600 * time.Second, true},
+		{"s-maxage", http.Header{"Cache-Control": {"max-age=600, s-maxage=60"}}, 60 * time.Second, true},
+		{"no-cache", http.Header{"Cache-Control": {"no-cache"}}, 0, true},
+		{"no-store", http.Header{"Cache-Control": {"no-store"}}, 0, false},
+		{"private", http.Header{"Cache-Control": {"private, max-age=600"}}, 0, false},
+		{"expires", http.Header{"Date": {now.Format(http.TimeFormat)}, "Expires": {now.Add(time.Hour).Format(http.TimeFormat)}}, time.Hour, true},
+		{"expired", http.Header{"Date": {now.Format(http.TimeFormat)}, "Expires": {now.Add(-time.Hour).Format(http.TimeFormat)}}, 0, true},
+		{"invalid expires", http.Header{"Expires": {"0"}}, 0, true},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			lifetime, storable := CacheLifetime(scenario.header)
+			if lifetime != scenario.expectedLifetime || storable != scenario.expectedStorable {
+				t.Errorf(`Got (%v, %v) instead of (%v, %v)`, lifetime, storable, scenario.expectedLifetime, scenario.expectedStorable)
+			}
+		})
+	}
+}
+
+func TestProxifiedImageURLs(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MEDIA_PROXY_MODE", "http-only")
+	os.Setenv("MEDIA_PROXY_RESOURCE_TYPES", "image")
+
+	var err error
+	parser := config.NewConfigParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Config parsing failure: %v`, err)
+	}
+
+	input := `<p><img src="http://website/a.png"/><img src="https://website/b.png"/><img src="http://website/a.png" srcset="http://website/c.png 2x"/></p>`
+	output := ProxifiedImageURLs(input)
+	expected := []string{"http://website/a.png", "http://website/c.png"}
+
+	if strings.Join(output, ",") != strings.Join(expected, ",") {
+		t.Errorf(`Unexpected image URLs, got %v instead of %v`, output, expected)
+	}
+}
+
+func TestProxifiedImageURLsWithoutImageResourceType(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MEDIA_PROXY_MODE", "all")
+	os.Setenv("MEDIA_PROXY_RESOURCE_TYPES", "audio")
+
+	var err error
+	parser := config.NewConfigParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Config parsing failure: %v`, err)
+	}
+
+	if output := ProxifiedImageURLs(`<img src="https://website/a.png"/>`); len(output) != 0 {
+		t.Errorf(`No image should be returned, got %v`, output)
+	}
+}
@@ -139,3 +139,38 @@ func ShouldProxifyURLWithMimeType(mediaURL, mediaMimeType, mediaProxyOption stri
 
 	return false
 }
+
+// ProxifiedImageURLs returns the URLs of the images of the document that are served by the media proxy.
+// The list is empty when the media proxy is disabled, or when an external proxy is used.
+func ProxifiedImageURLs(htmlDocument string) []string {
+	proxyOption := config.Opts.MediaProxyMode()
+	if proxyOption == "none" || config.Opts.MediaCustomProxyURL() != nil || !slices.Contains(config.Opts.MediaProxyResourceTypes(), "image") {
+		return nil
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlDocument))
+	if err != nil {
+		return nil
+	}
+
+	var imageURLs []string
+	addImageURL := func(imageURL string) {
+		if shouldProxifyURL(imageURL, proxyOption) && !slices.Contains(imageURLs, imageURL) {
+			imageURLs = append(imageURLs, imageURL)
+		}
+	}
+
+	doc.Find("img, picture source").Each(func(i int, img *goquery.Selection) {
+		if srcAttrValue, ok := img.Attr("src"); ok {
+			addImageURL(srcAttrValue)
+		}
+
+		if srcsetAttrValue, ok := img.Attr("srcset"); ok {
+			for _, imageCandidate := range sanitizer.ParseSrcSetAttribute(srcsetAttrValue) {
+				addImageURL(imageCandidate.ImageURL)
+			}
+		}
+	})
+
+	return imageURLs
+}
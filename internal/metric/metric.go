@@ -19,6 +19,13 @@ const (
 	StatusError   = "error"
 )
 
+// Result label values for login metrics.
+const (
+	LoginResultSuccess = "success"
+	LoginResultFailure = "failure"
+	LoginResultBlocked = "blocked"
+)
+
 // Prometheus Metrics.
 var (
 	BackgroundFeedRefreshDuration = prometheus.NewHistogramVec(
@@ -51,6 +58,32 @@ var (
 		[]string{"status"},
 	)
 
+	LoginAttempts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "miniflux",
+			Name:      "login_attempts_total",
+			Help:      "Number of login attempts by source and result",
+		},
+		[]string{"source", "result"},
+	)
+
+	LoginLockouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "miniflux",
+			Name:      "login_lockouts_total",
+			Help:      "Number of lockouts after too many failed logins by source",
+		},
+		[]string{"source"},
+	)
+
+	lockedLoginsGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "locked_logins",
+			Help:      "Number of client IP addresses and usernames currently locked out",
+		},
+	)
+
 	usersGauge = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: "miniflux",
@@ -153,6 +186,9 @@ func NewCollector(store *storage.Storage, refreshInterval time.Duration) *collec
 	prometheus.MustRegister(BackgroundFeedRefreshDuration)
 	prometheus.MustRegister(ScraperRequestDuration)
 	prometheus.MustRegister(ArchiveEntriesDuration)
+	prometheus.MustRegister(LoginAttempts)
+	prometheus.MustRegister(LoginLockouts)
+	prometheus.MustRegister(lockedLoginsGauge)
 	prometheus.MustRegister(usersGauge)
 	prometheus.MustRegister(feedsGauge)
 	prometheus.MustRegister(brokenFeedsGauge)
@@ -210,6 +246,12 @@ func (c *collector) GatherStorageMetrics(ctx context.Context) {
 			}
 		}
 
+		if lockedLoginsCount, err := c.store.CountLockedLogins(); err != nil {
+			slog.Warn("Unable to collect locked logins metric", slog.Any("error", err))
+		} else {
+			lockedLoginsGauge.Set(float64(lockedLoginsCount))
+		}
+
 		dbStats := c.store.DBStats()
 		dbOpenConnectionsGauge.Set(float64(dbStats.OpenConnections))
 		dbConnectionsInUseGauge.Set(float64(dbStats.InUse))
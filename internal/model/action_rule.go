@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "fmt"
+
+// Actions applied to new entries matching an action rule.
+const (
+	ActionRuleMarkAsRead  = "mark_as_read"
+	ActionRuleStar        = "star"
+	ActionRuleAddLabel    = "add_label"
+	ActionRuleSendEntry   = "send_entry"
+	ActionRuleSetPriority = "set_priority"
+)
+
+// ActionRule represents a filter expression associated with an action.
+// The action is applied to new entries matching the expression during feed refresh.
+type ActionRule struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	Expression string `json:"expression"`
+	Action     string `json:"action"`
+	LabelID    int64  `json:"label_id"`
+	Priority   int    `json:"priority"`
+}
+
+func (a *ActionRule) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Action=%s, Expression=%s", a.ID, a.UserID, a.Action, a.Expression)
+}
+
+// ActionRules represents a list of action rules.
+type ActionRules []*ActionRule
+
+// ActionRuleCreationRequest represents the request to create an action rule.
+type ActionRuleCreationRequest struct {
+	Expression string `json:"expression"`
+	Action     string `json:"action"`
+	LabelID    int64  `json:"label_id"`
+	Priority   int    `json:"priority"`
+}
+
+// ActionRuleModificationRequest represents the request to update an action rule.
+type ActionRuleModificationRequest struct {
+	Expression *string `json:"expression"`
+	Action     *string `json:"action"`
+	LabelID    *int64  `json:"label_id"`
+	Priority   *int    `json:"priority"`
+}
+
+func (a *ActionRuleModificationRequest) Patch(rule *ActionRule) {
+	if a.Expression != nil {
+		rule.Expression = *a.Expression
+	}
+
+	if a.Action != nil {
+		rule.Action = *a.Action
+	}
+
+	if a.LabelID != nil {
+		rule.LabelID = *a.LabelID
+	}
+
+	if a.Priority != nil {
+		rule.Priority = *a.Priority
+	}
+}
@@ -4,25 +4,63 @@
 package model // import "miniflux.app/v2/internal/model"
 
 import (
+	"slices"
 	"time"
 )
 
+// API key scopes, every API key can read the data of the user.
+const (
+	APIKeyScopeReadOnly     = "read-only"
+	APIKeyScopeEntriesWrite = "entries-write"
+	APIKeyScopeFeedsAdmin   = "feeds-admin"
+	APIKeyScopeIntegrations = "integrations"
+)
+
+// APIKeyScopes is the list of scopes that can be granted to an API key.
+var APIKeyScopes = []string{
+	APIKeyScopeReadOnly,
+	APIKeyScopeEntriesWrite,
+	APIKeyScopeFeedsAdmin,
+	APIKeyScopeIntegrations,
+}
+
 // APIKey represents an application API key.
 // We need to use a pointer for LastUsedAt,
 // as the value obtained from the database might sometimes be nil.
+// An API key without scopes has full access to the account, like the keys created before scopes existed.
 type APIKey struct {
 	ID          int64      `json:"id"`
 	UserID      int64      `json:"user_id"`
 	Token       string     `json:"token"`
 	Description string     `json:"description"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"`
 	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  string     `json:"last_used_ip"`
 	CreatedAt   time.Time  `json:"created_at"`
 }
 
+// HasFullAccess returns true if the API key is not limited to some scopes.
+func (a *APIKey) HasFullAccess() bool {
+	return len(a.Scopes) == 0
+}
+
+// HasScope returns true if the API key grants the given scope.
+func (a *APIKey) HasScope(scope string) bool {
+	return a.HasFullAccess() || scope == APIKeyScopeReadOnly || slices.Contains(a.Scopes, scope)
+}
+
+// IsExpired returns true if the API key cannot be used anymore.
+func (a *APIKey) IsExpired() bool {
+	return a.ExpiresAt != nil && !a.ExpiresAt.After(time.Now())
+}
+
 // APIKeys represents a collection of API Key.
 type APIKeys []APIKey
 
 // APIKeyCreationRequest represents the request to create a new API Key.
 type APIKeyCreationRequest struct {
-	Description string `json:"description"`
+	Description string     `json:"description"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"`
 }
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAPIKeyWithoutScopesHasFullAccess(t *testing.T) {
+	apiKey := &APIKey{}
+
+	if !apiKey.HasFullAccess() {
+		t.Error("An API key without scopes should have full access")
+	}
+
+	for _, scope := range APIKeyScopes {
+		if !apiKey.HasScope(scope) {
+			t.Errorf("An API key without scopes should grant the %q scope", scope)
+		}
+	}
+}
+
+func TestAPIKeyScopes(t *testing.T) {
+	apiKey := &APIKey{Scopes: []string{APIKeyScopeEntriesWrite}}
+
+	if apiKey.HasFullAccess() {
+		t.Error("A scoped API key should not have full access")
+	}
+
+	if !apiKey.HasScope(APIKeyScopeReadOnly) {
+		t.Error("Every API key should be able to read")
+	}
+
+	if !apiKey.HasScope(APIKeyScopeEntriesWrite) {
+		t.Error("The API key should grant the entries-write scope")
+	}
+
+	if apiKey.HasScope(APIKeyScopeFeedsAdmin) || apiKey.HasScope(APIKeyScopeIntegrations) {
+		t.Error("The API key should not grant the scopes it was not given")
+	}
+}
+
+func TestAPIKeyExpiration(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	scenarios := []struct {
+		expiresAt *time.Time
+		expected  bool
+	}{
+		{nil, false},
+		{&past, true},
+		{&future, false},
+	}
+
+	for _, scenario := range scenarios {
+		apiKey := &APIKey{ExpiresAt: scenario.expiresAt}
+		if apiKey.IsExpired() != scenario.expected {
+			t.Errorf("Unexpected expiration for %v: got %v", scenario.expiresAt, apiKey.IsExpired())
+		}
+	}
+}
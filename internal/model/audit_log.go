@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// Audit log actions.
+const (
+	AuditLogActionLogin                = "login"
+	AuditLogActionLoginFailed          = "login_failed"
+	AuditLogActionPasswordReset        = "password_reset"
+	AuditLogActionAPIKeyCreated        = "api_key_created"
+	AuditLogActionAPIKeyDeleted        = "api_key_deleted"
+	AuditLogActionSessionRemoved       = "session_removed"
+	AuditLogActionOAuth2Linked         = "oauth2_linked"
+	AuditLogActionOAuth2Unlinked       = "oauth2_unlinked"
+	AuditLogActionUserCreated          = "user_created"
+	AuditLogActionUserDeleted          = "user_deleted"
+	AuditLogActionTwoFactorEnabled     = "two_factor_enabled"
+	AuditLogActionTwoFactorDisabled    = "two_factor_disabled"
+	AuditLogActionRecoveryCodesCreated = "recovery_codes_created"
+	AuditLogActionLoginUnlocked        = "login_unlocked"
+)
+
+// AuditLogActions returns the list of audit log actions.
+func AuditLogActions() []string {
+	return []string{
+		AuditLogActionLogin,
+		AuditLogActionLoginFailed,
+		AuditLogActionPasswordReset,
+		AuditLogActionAPIKeyCreated,
+		AuditLogActionAPIKeyDeleted,
+		AuditLogActionSessionRemoved,
+		AuditLogActionOAuth2Linked,
+		AuditLogActionOAuth2Unlinked,
+		AuditLogActionUserCreated,
+		AuditLogActionUserDeleted,
+		AuditLogActionTwoFactorEnabled,
+		AuditLogActionTwoFactorDisabled,
+		AuditLogActionRecoveryCodesCreated,
+		AuditLogActionLoginUnlocked,
+	}
+}
+
+// AuditLogEntry represents a security related action of a user or an administrator.
+// The user is the actor of the action, it is not set when the actor is unknown, like a failed login
+// with a wrong username or a command run on the server. The username is kept when the user is removed.
+// The target describes the object of the action, like "user:john" or "api_key:42".
+type AuditLogEntry struct {
+	ID        int64     `json:"id"`
+	UserID    *int64    `json:"user_id"`
+	Username  string    `json:"username"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuditLogEntries represents a list of audit log entries.
+type AuditLogEntries []*AuditLogEntry
@@ -11,9 +11,17 @@ type Category struct {
 	Title        string `json:"title"`
 	UserID       int64  `json:"user_id"`
 	HideGlobally bool   `json:"hide_globally"`
+	// ParentID is zero for top-level categories.
+	ParentID int64 `json:"parent_id"`
+	// BlockFilterExpression is applied to the entries of every feed in the category.
+	BlockFilterExpression string `json:"block_filter_expression"`
+	// FeedDefaults are inherited by the feeds of the category.
+	FeedDefaults FeedDefaults `json:"feed_defaults"`
 	// Pointers are needed to avoid breaking /v1/categories?counts=true
 	FeedCount   *int `json:"feed_count,omitempty"`
 	TotalUnread *int `json:"total_unread,omitempty"`
+	// Depth is the nesting level of the category once sorted as a tree.
+	Depth int `json:"-"`
 }
 
 func (c *Category) String() string {
@@ -21,13 +29,19 @@ func (c *Category) String() string {
 }
 
 type CategoryCreationRequest struct {
-	Title        string `json:"title"`
-	HideGlobally bool   `json:"hide_globally"`
+	Title                 string       `json:"title"`
+	HideGlobally          bool         `json:"hide_globally"`
+	ParentID              int64        `json:"parent_id"`
+	BlockFilterExpression string       `json:"block_filter_expression"`
+	FeedDefaults          FeedDefaults `json:"feed_defaults"`
 }
 
 type CategoryModificationRequest struct {
-	Title        *string `json:"title"`
-	HideGlobally *bool   `json:"hide_globally"`
+	Title                 *string       `json:"title"`
+	HideGlobally          *bool         `json:"hide_globally"`
+	ParentID              *int64        `json:"parent_id"`
+	BlockFilterExpression *string       `json:"block_filter_expression"`
+	FeedDefaults          *FeedDefaults `json:"feed_defaults"`
 }
 
 func (c *CategoryModificationRequest) Patch(category *Category) {
@@ -38,7 +52,94 @@ func (c *CategoryModificationRequest) Patch(category *Category) {
 	if c.HideGlobally != nil {
 		category.HideGlobally = *c.HideGlobally
 	}
+
+	if c.ParentID != nil {
+		category.ParentID = *c.ParentID
+	}
+
+	if c.BlockFilterExpression != nil {
+		category.BlockFilterExpression = *c.BlockFilterExpression
+	}
+
+	if c.FeedDefaults != nil {
+		category.FeedDefaults = *c.FeedDefaults
+	}
 }
 
 // Categories represents a list of categories.
 type Categories []Category
+
+// SortAsTree returns the categories ordered depth-first, each parent followed by its subcategories.
+// Siblings keep their relative order, and categories whose parent is not in the list are considered top-level.
+func (c Categories) SortAsTree() Categories {
+	known := make(map[int64]bool, len(c))
+	for _, category := range c {
+		known[category.ID] = true
+	}
+
+	children := make(map[int64][]Category)
+	for _, category := range c {
+		parentID := category.ParentID
+		if !known[parentID] {
+			parentID = 0
+		}
+		children[parentID] = append(children[parentID], category)
+	}
+
+	sorted := make(Categories, 0, len(c))
+	visited := make(map[int64]bool, len(c))
+
+	var walk func(parentID int64, depth int)
+	walk = func(parentID int64, depth int) {
+		for _, category := range children[parentID] {
+			if visited[category.ID] {
+				continue
+			}
+			visited[category.ID] = true
+			category.Depth = depth
+			sorted = append(sorted, category)
+			walk(category.ID, depth+1)
+		}
+	}
+	walk(0, 0)
+
+	// Categories in a cycle are not reachable from the top level, they are listed at the end.
+	for _, category := range c {
+		if !visited[category.ID] {
+			visited[category.ID] = true
+			sorted = append(sorted, category)
+			walk(category.ID, 1)
+		}
+	}
+
+	return sorted
+}
+
+// AggregateUnreadCounters adds the unread entries of the subcategories to the counter of their ancestors.
+func (c Categories) AggregateUnreadCounters() {
+	indexes := make(map[int64]int, len(c))
+	directUnread := make([]int, len(c))
+	for i, category := range c {
+		indexes[category.ID] = i
+		if category.TotalUnread != nil {
+			directUnread[i] = *category.TotalUnread
+		}
+	}
+
+	for i, category := range c {
+		if directUnread[i] == 0 {
+			continue
+		}
+
+		visited := map[int64]bool{category.ID: true}
+		for parentID := category.ParentID; parentID != 0 && !visited[parentID]; {
+			visited[parentID] = true
+			parentIndex, found := indexes[parentID]
+			if !found || c[parentIndex].TotalUnread == nil {
+				break
+			}
+			*c[parentIndex].TotalUnread += directUnread[i]
+			parentID = c[parentIndex].ParentID
+		}
+	}
+}
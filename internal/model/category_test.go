@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestCategoriesSortAsTree(t *testing.T) {
+	categories := Categories{
+		{ID: 1, Title: "A"},
+		{ID: 2, Title: "B", ParentID: 3},
+		{ID: 3, Title: "C", ParentID: 1},
+		{ID: 4, Title: "D"},
+		{ID: 5, Title: "E", ParentID: 42},
+	}
+
+	sorted := categories.SortAsTree()
+
+	expected := []struct {
+		id    int64
+		depth int
+	}{
+		{1, 0},
+		{3, 1},
+		{2, 2},
+		{4, 0},
+		{5, 0},
+	}
+
+	if len(sorted) != len(expected) {
+		t.Fatalf(`Unexpected number of categories: got %d instead of %d`, len(sorted), len(expected))
+	}
+
+	for i, category := range sorted {
+		if category.ID != expected[i].id || category.Depth != expected[i].depth {
+			t.Errorf(`Unexpected category at position %d: got ID=%d depth=%d, want ID=%d depth=%d`, i, category.ID, category.Depth, expected[i].id, expected[i].depth)
+		}
+	}
+}
+
+func TestCategoriesSortAsTreeWithCycle(t *testing.T) {
+	categories := Categories{
+		{ID: 1, Title: "A", ParentID: 2},
+		{ID: 2, Title: "B", ParentID: 1},
+	}
+
+	sorted := categories.SortAsTree()
+	if len(sorted) != 2 {
+		t.Fatalf(`Categories in a cycle should be kept, got %d categories`, len(sorted))
+	}
+
+	if sorted[0].ID != 1 || sorted[0].Depth != 0 || sorted[1].ID != 2 || sorted[1].Depth != 1 {
+		t.Errorf(`Unexpected order: %+v`, sorted)
+	}
+}
+
+func TestCategoriesAggregateUnreadCounters(t *testing.T) {
+	categories := Categories{
+		{ID: 1, TotalUnread: new(1)},
+		{ID: 2, ParentID: 1, TotalUnread: new(2)},
+		{ID: 3, ParentID: 2, TotalUnread: new(4)},
+		{ID: 4, TotalUnread: new(8)},
+	}
+
+	categories.AggregateUnreadCounters()
+
+	for i, expected := range []int{7, 6, 4, 8} {
+		if *categories[i].TotalUnread != expected {
+			t.Errorf(`Unexpected unread counter for category #%d: got %d instead of %d`, categories[i].ID, *categories[i].TotalUnread, expected)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package model // import "miniflux.app/v2/internal/model"
 
 import (
+	"slices"
 	"time"
 )
 
@@ -25,32 +26,40 @@ const MaxEntryIDsLimit = 10000
 
 // Entry represents a feed item in the system.
 type Entry struct {
-	ID          int64         `json:"id"`
-	UserID      int64         `json:"user_id"`
-	FeedID      int64         `json:"feed_id"`
-	Status      string        `json:"status"`
-	Hash        string        `json:"hash"`
-	Title       string        `json:"title"`
-	URL         string        `json:"url"`
-	CommentsURL string        `json:"comments_url"`
-	Language    string        `json:"language"`
-	Date        time.Time     `json:"published_at"`
-	CreatedAt   time.Time     `json:"created_at"`
-	ChangedAt   time.Time     `json:"changed_at"`
-	Content     string        `json:"content"`
-	Author      string        `json:"author"`
-	ShareCode   string        `json:"share_code"`
-	Starred     bool          `json:"starred"`
-	ReadingTime int           `json:"reading_time"`
-	Enclosures  EnclosureList `json:"enclosures"`
-	Feed        *Feed         `json:"feed,omitempty"`
-	Tags        []string      `json:"tags"`
+	ID            int64         `json:"id"`
+	UserID        int64         `json:"user_id"`
+	FeedID        int64         `json:"feed_id"`
+	Status        string        `json:"status"`
+	Hash          string        `json:"hash"`
+	Title         string        `json:"title"`
+	URL           string        `json:"url"`
+	CommentsURL   string        `json:"comments_url"`
+	Language      string        `json:"language"`
+	Date          time.Time     `json:"published_at"`
+	CreatedAt     time.Time     `json:"created_at"`
+	ChangedAt     time.Time     `json:"changed_at"`
+	Content       string        `json:"content"`
+	Author        string        `json:"author"`
+	ShareCode     string        `json:"share_code"`
+	Starred       bool          `json:"starred"`
+	ReadLater     bool          `json:"read_later"`
+	ReadingTime   int           `json:"reading_time"`
+	Priority      int           `json:"priority"`
+	RevisionCount int           `json:"revision_count"`
+	Enclosures    EnclosureList `json:"enclosures"`
+	Feed          *Feed         `json:"feed,omitempty"`
+	Tags          []string      `json:"tags"`
+	Labels        Labels        `json:"labels"`
+
+	// SendToIntegrations is set by action rules while processing new entries.
+	SendToIntegrations bool `json:"-"`
 }
 
 func NewEntry() *Entry {
 	return &Entry{
 		Enclosures: make(EnclosureList, 0),
 		Tags:       make([]string, 0),
+		Labels:     make(Labels, 0),
 		Feed: &Feed{
 			Category: &Category{},
 			Icon:     &FeedIcon{},
@@ -74,9 +83,52 @@ func (e *Entry) ShouldMarkAsReadOnView(user *User) bool {
 	return user.MarkReadOnView
 }
 
+// Clone returns a copy of the entry that can be modified without altering the original entry.
+func (e *Entry) Clone() *Entry {
+	clone := *e
+	clone.Tags = slices.Clone(e.Tags)
+	clone.Labels = slices.Clone(e.Labels)
+
+	if e.Enclosures != nil {
+		clone.Enclosures = make(EnclosureList, 0, len(e.Enclosures))
+		for _, enclosure := range e.Enclosures {
+			enclosureCopy := *enclosure
+			clone.Enclosures = append(clone.Enclosures, &enclosureCopy)
+		}
+	}
+
+	if e.Feed != nil {
+		feedCopy := *e.Feed
+		if e.Feed.Category != nil {
+			categoryCopy := *e.Feed.Category
+			feedCopy.Category = &categoryCopy
+		}
+		if e.Feed.Icon != nil {
+			iconCopy := *e.Feed.Icon
+			feedCopy.Icon = &iconCopy
+		}
+		clone.Feed = &feedCopy
+	}
+
+	return &clone
+}
+
 // Entries represents a list of entries.
 type Entries []*Entry
 
+// Clone returns a copy of the entries that can be modified without altering the original entries.
+func (e Entries) Clone() Entries {
+	if e == nil {
+		return nil
+	}
+
+	clones := make(Entries, 0, len(e))
+	for _, entry := range e {
+		clones = append(clones, entry.Clone())
+	}
+	return clones
+}
+
 // EntriesStatusUpdateRequest represents a request to change entries status.
 type EntriesStatusUpdateRequest struct {
 	EntryIDs []int64 `json:"entry_ids"`
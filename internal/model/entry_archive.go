@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"encoding/base64"
+	"time"
+)
+
+// EntryArchive represents the offline copy of an entry web page.
+type EntryArchive struct {
+	EntryID    int64     `json:"entry_id"`
+	UserID     int64     `json:"user_id"`
+	URL        string    `json:"url"`
+	Title      string    `json:"title"`
+	Content    string    `json:"content"`
+	MediaCount int       `json:"media_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ArchivedMedia represents an image stored with an entry archive.
+// The content is shared between the archives referencing the same file.
+type ArchivedMedia struct {
+	ID       int64  `json:"id"`
+	URL      string `json:"url"`
+	Hash     string `json:"hash"`
+	MimeType string `json:"mime_type"`
+	Content  []byte `json:"-"`
+}
+
+// DataURL returns the data URL of the media.
+func (m *ArchivedMedia) DataURL() string {
+	return "data:" + m.MimeType + ";base64," + base64.StdEncoding.EncodeToString(m.Content)
+}
+
+// ArchivedMediaList represents a list of archived media.
+type ArchivedMediaList []*ArchivedMedia
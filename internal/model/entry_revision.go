@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// EntryRevision represents the title and the content of an entry before an update of the feed changed them.
+type EntryRevision struct {
+	ID      int64  `json:"id"`
+	EntryID int64  `json:"entry_id"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	// CreatedAt is the date of the update that replaced this revision.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EntryRevisions represents a list of entry revisions.
+type EntryRevisions []*EntryRevision
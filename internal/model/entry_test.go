@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestEntriesClone(t *testing.T) {
+	entry := NewEntry()
+	entry.Title = "Title"
+	entry.Tags = []string{"tag"}
+	entry.Enclosures = EnclosureList{{URL: "https://example.org/audio.mp3"}}
+	entries := Entries{entry}
+
+	clones := entries.Clone()
+	clones[0].Title = "Rewritten"
+	clones[0].Tags[0] = "other"
+	clones[0].Enclosures[0].URL = "https://example.org/proxified.mp3"
+	clones[0].Feed.Category.Title = "Category"
+
+	if entry.Title != "Title" {
+		t.Errorf(`The original title should be kept, got %q`, entry.Title)
+	}
+
+	if entry.Tags[0] != "tag" {
+		t.Errorf(`The original tags should be kept, got %v`, entry.Tags)
+	}
+
+	if entry.Enclosures[0].URL != "https://example.org/audio.mp3" {
+		t.Errorf(`The original enclosures should be kept, got %q`, entry.Enclosures[0].URL)
+	}
+
+	if entry.Feed.Category.Title != "" {
+		t.Errorf(`The original feed category should be kept, got %q`, entry.Feed.Category.Title)
+	}
+}
+
+func TestEntriesCloneNil(t *testing.T) {
+	var entries Entries
+	if entries.Clone() != nil {
+		t.Error(`Cloning nil entries should return nil`)
+	}
+}
@@ -6,6 +6,7 @@ package model // import "miniflux.app/v2/internal/model"
 import (
 	"fmt"
 	"io"
+	"slices"
 	"time"
 
 	"miniflux.app/v2/internal/config"
@@ -41,6 +42,7 @@ type Feed struct {
 	KeeplistRules               string    `json:"keeplist_rules"`
 	BlockFilterEntryRules       string    `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        string    `json:"keep_filter_entry_rules"`
+	BlockFilterExpression       string    `json:"block_filter_expression"`
 	UrlRewriteRules             string    `json:"urlrewrite_rules"`
 	UserAgent                   string    `json:"user_agent"`
 	Cookie                      string    `json:"cookie"`
@@ -57,21 +59,29 @@ type Feed struct {
 	NtfyEnabled                 bool      `json:"ntfy_enabled"`
 	Crawler                     bool      `json:"crawler"`
 	IgnoreEntryUpdates          bool      `json:"ignore_entry_updates"`
+	ArchiveEntries              bool      `json:"archive_entries"`
 	AppriseServiceURLs          string    `json:"apprise_service_urls"`
 	WebhookURL                  string    `json:"webhook_url"`
 	NtfyPriority                int       `json:"ntfy_priority"`
 	NtfyTopic                   string    `json:"ntfy_topic"`
 	PushoverPriority            int       `json:"pushover_priority"`
 	ProxyURL                    string    `json:"proxy_url"`
+	PendingFeedURL              string    `json:"pending_feed_url"`
+	PendingFeedURLCount         int       `json:"pending_feed_url_count"`
+	// OverriddenSettings lists the settings never taken from the category defaults.
+	OverriddenSettings []string `json:"overridden_settings"`
 
 	// Non-persisted attributes
 	Category *Category `json:"category,omitempty"`
 	Icon     *FeedIcon `json:"icon"`
 	Entries  Entries   `json:"entries,omitempty"`
+	// InheritedSettings lists the settings taken from the category defaults.
+	InheritedSettings []string `json:"inherited_settings,omitempty"`
 
 	// Internal attributes (not exposed in the API and not persisted in the database)
 	TTL                    time.Duration `json:"-"`
 	IconURL                string        `json:"-"`
+	HubURL                 string        `json:"-"`
 	UnreadCount            int           `json:"-"`
 	ReadCount              int           `json:"-"`
 	NumberOfVisibleEntries int           `json:"-"`
@@ -98,6 +108,28 @@ func (f *Feed) WithCategoryID(categoryID int64) {
 	f.Category = &Category{ID: categoryID}
 }
 
+// WithCategoryDefaults returns a copy of the feed where the settings that are not
+// overridden by the feed are taken from the category defaults.
+// The original feed is left untouched so it can be persisted as is.
+func (f *Feed) WithCategoryDefaults() *Feed {
+	effectiveFeed := *f
+	effectiveFeed.InheritedSettings = nil
+	if f.Category != nil {
+		effectiveFeed.InheritedSettings = f.Category.FeedDefaults.applyTo(&effectiveFeed)
+	}
+	return &effectiveFeed
+}
+
+// InheritsSetting returns true if the given setting is taken from the category defaults.
+func (f *Feed) InheritsSetting(name string) bool {
+	return slices.Contains(f.InheritedSettings, name)
+}
+
+// OverridesSetting returns true if the given setting of the feed is used even when the category defines a default.
+func (f *Feed) OverridesSetting(name string) bool {
+	return slices.Contains(f.OverriddenSettings, name)
+}
+
 // WithTranslatedErrorMessage adds a new error message and increment the error counter.
 func (f *Feed) WithTranslatedErrorMessage(message string) {
 	f.ParsingErrorCount++
@@ -110,6 +142,31 @@ func (f *Feed) ResetErrorCounter() {
 	f.ParsingErrorMsg = ""
 }
 
+// TrackPendingFeedURL counts the consecutive refreshes reporting the same new location for the feed.
+// An empty URL, or the current feed URL, resets the counter.
+// It returns true once the new location has been reported threshold times in a row.
+func (f *Feed) TrackPendingFeedURL(newFeedURL string, threshold int) bool {
+	if threshold <= 0 || newFeedURL == "" || newFeedURL == f.FeedURL {
+		f.ResetPendingFeedURL()
+		return false
+	}
+
+	if newFeedURL == f.PendingFeedURL {
+		f.PendingFeedURLCount++
+	} else {
+		f.PendingFeedURL = newFeedURL
+		f.PendingFeedURLCount = 1
+	}
+
+	return f.PendingFeedURLCount >= threshold
+}
+
+// ResetPendingFeedURL forgets the new location reported for the feed.
+func (f *Feed) ResetPendingFeedURL() {
+	f.PendingFeedURL = ""
+	f.PendingFeedURLCount = 0
+}
+
 // CheckedNow set attribute values when the feed is refreshed.
 func (f *Feed) CheckedNow() {
 	f.CheckedAt = time.Now()
@@ -172,6 +229,7 @@ type FeedCreationRequest struct {
 	KeeplistRules               string `json:"keeplist_rules"`
 	BlockFilterEntryRules       string `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        string `json:"keep_filter_entry_rules"`
+	BlockFilterExpression       string `json:"block_filter_expression"`
 	UrlRewriteRules             string `json:"urlrewrite_rules"`
 	ProxyURL                    string `json:"proxy_url"`
 }
@@ -186,38 +244,42 @@ type FeedCreationRequestFromSubscriptionDiscovery struct {
 
 // FeedModificationRequest represents the request to update a feed.
 type FeedModificationRequest struct {
-	FeedURL                     *string `json:"feed_url"`
-	SiteURL                     *string `json:"site_url"`
-	Title                       *string `json:"title"`
-	Description                 *string `json:"description"`
-	ScraperRules                *string `json:"scraper_rules"`
-	RewriteRules                *string `json:"rewrite_rules"`
-	BlocklistRules              *string `json:"blocklist_rules"`
-	UrlRewriteRules             *string `json:"urlrewrite_rules"`
-	KeeplistRules               *string `json:"keeplist_rules"`
-	BlockFilterEntryRules       *string `json:"block_filter_entry_rules"`
-	KeepFilterEntryRules        *string `json:"keep_filter_entry_rules"`
-	Crawler                     *bool   `json:"crawler"`
-	IgnoreEntryUpdates          *bool   `json:"ignore_entry_updates"`
-	UserAgent                   *string `json:"user_agent"`
-	Cookie                      *string `json:"cookie"`
-	Username                    *string `json:"username"`
-	Password                    *string `json:"password"`
-	CategoryID                  *int64  `json:"category_id"`
-	Disabled                    *bool   `json:"disabled"`
-	NoMediaPlayer               *bool   `json:"no_media_player"`
-	IgnoreHTTPCache             *bool   `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates *bool   `json:"allow_self_signed_certificates"`
-	FetchViaProxy               *bool   `json:"fetch_via_proxy"`
-	HideGlobally                *bool   `json:"hide_globally"`
-	DisableHTTP2                *bool   `json:"disable_http2"`
-	ProxyURL                    *string `json:"proxy_url"`
+	FeedURL                     *string   `json:"feed_url"`
+	SiteURL                     *string   `json:"site_url"`
+	Title                       *string   `json:"title"`
+	Description                 *string   `json:"description"`
+	ScraperRules                *string   `json:"scraper_rules"`
+	RewriteRules                *string   `json:"rewrite_rules"`
+	BlocklistRules              *string   `json:"blocklist_rules"`
+	UrlRewriteRules             *string   `json:"urlrewrite_rules"`
+	KeeplistRules               *string   `json:"keeplist_rules"`
+	BlockFilterEntryRules       *string   `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules        *string   `json:"keep_filter_entry_rules"`
+	BlockFilterExpression       *string   `json:"block_filter_expression"`
+	Crawler                     *bool     `json:"crawler"`
+	IgnoreEntryUpdates          *bool     `json:"ignore_entry_updates"`
+	UserAgent                   *string   `json:"user_agent"`
+	Cookie                      *string   `json:"cookie"`
+	Username                    *string   `json:"username"`
+	Password                    *string   `json:"password"`
+	CategoryID                  *int64    `json:"category_id"`
+	Disabled                    *bool     `json:"disabled"`
+	NoMediaPlayer               *bool     `json:"no_media_player"`
+	IgnoreHTTPCache             *bool     `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates *bool     `json:"allow_self_signed_certificates"`
+	FetchViaProxy               *bool     `json:"fetch_via_proxy"`
+	HideGlobally                *bool     `json:"hide_globally"`
+	DisableHTTP2                *bool     `json:"disable_http2"`
+	ArchiveEntries              *bool     `json:"archive_entries"`
+	ProxyURL                    *string   `json:"proxy_url"`
+	OverriddenSettings          *[]string `json:"overridden_settings"`
 }
 
 // Patch updates a feed with modified values.
 func (f *FeedModificationRequest) Patch(feed *Feed) {
-	if f.FeedURL != nil && *f.FeedURL != "" {
+	if f.FeedURL != nil && *f.FeedURL != "" && *f.FeedURL != feed.FeedURL {
 		feed.FeedURL = *f.FeedURL
+		feed.ResetPendingFeedURL()
 	}
 
 	if f.SiteURL != nil && *f.SiteURL != "" {
@@ -260,6 +322,10 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 		feed.KeepFilterEntryRules = *f.KeepFilterEntryRules
 	}
 
+	if f.BlockFilterExpression != nil {
+		feed.BlockFilterExpression = *f.BlockFilterExpression
+	}
+
 	if f.Crawler != nil {
 		feed.Crawler = *f.Crawler
 	}
@@ -316,9 +382,17 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 		feed.DisableHTTP2 = *f.DisableHTTP2
 	}
 
+	if f.ArchiveEntries != nil {
+		feed.ArchiveEntries = *f.ArchiveEntries
+	}
+
 	if f.ProxyURL != nil {
 		feed.ProxyURL = *f.ProxyURL
 	}
+
+	if f.OverriddenSettings != nil {
+		feed.OverriddenSettings = *f.OverriddenSettings
+	}
 }
 
 // Feeds is a list of feed
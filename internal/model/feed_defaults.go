@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// FeedDefaultSettings lists the feed settings that can be defined at the category level.
+var FeedDefaultSettings = []string{
+	"scraper_rules",
+	"rewrite_rules",
+	"urlrewrite_rules",
+	"blocklist_rules",
+	"keeplist_rules",
+	"block_filter_entry_rules",
+	"keep_filter_entry_rules",
+	"user_agent",
+	"cookie",
+	"proxy_url",
+	"apprise_service_urls",
+	"webhook_url",
+	"ntfy_topic",
+	"crawler",
+	"ignore_entry_updates",
+	"ignore_http_cache",
+	"allow_self_signed_certificates",
+	"fetch_via_proxy",
+	"disable_http2",
+	"no_media_player",
+	"ntfy_enabled",
+	"pushover_enabled",
+}
+
+// FeedDefaults holds the feed settings defined at the category level.
+//
+// A feed inherits a text setting when it is left empty on the feed,
+// and a boolean setting when it is enabled on the category,
+// unless the feed overrides the setting explicitly.
+type FeedDefaults struct {
+	ScraperRules                string `json:"scraper_rules"`
+	RewriteRules                string `json:"rewrite_rules"`
+	UrlRewriteRules             string `json:"urlrewrite_rules"`
+	BlocklistRules              string `json:"blocklist_rules"`
+	KeeplistRules               string `json:"keeplist_rules"`
+	BlockFilterEntryRules       string `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules        string `json:"keep_filter_entry_rules"`
+	UserAgent                   string `json:"user_agent"`
+	Cookie                      string `json:"cookie"`
+	ProxyURL                    string `json:"proxy_url"`
+	AppriseServiceURLs          string `json:"apprise_service_urls"`
+	WebhookURL                  string `json:"webhook_url"`
+	NtfyTopic                   string `json:"ntfy_topic"`
+	Crawler                     bool   `json:"crawler"`
+	IgnoreEntryUpdates          bool   `json:"ignore_entry_updates"`
+	IgnoreHTTPCache             bool   `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates bool   `json:"allow_self_signed_certificates"`
+	FetchViaProxy               bool   `json:"fetch_via_proxy"`
+	DisableHTTP2                bool   `json:"disable_http2"`
+	NoMediaPlayer               bool   `json:"no_media_player"`
+	NtfyEnabled                 bool   `json:"ntfy_enabled"`
+	PushoverEnabled             bool   `json:"pushover_enabled"`
+}
+
+// Value implements the driver.Valuer interface, the defaults are stored as a JSON object.
+func (d FeedDefaults) Value() (driver.Value, error) {
+	return json.Marshal(d)
+}
+
+// Scan implements the sql.Scanner interface.
+func (d *FeedDefaults) Scan(src any) error {
+	*d = FeedDefaults{}
+
+	switch data := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(data, d)
+	case string:
+		return json.Unmarshal([]byte(data), d)
+	default:
+		return fmt.Errorf("unsupported type for feed defaults: %T", src)
+	}
+}
+
+// Defines returns true if the category defines a default value for the given setting.
+func (d *FeedDefaults) Defines(name string) bool {
+	return slices.Contains(d.applyTo(&Feed{}), name)
+}
+
+// applyTo copies the defaults to the feed settings that are not overridden,
+// and returns the name of the inherited settings.
+func (d *FeedDefaults) applyTo(feed *Feed) []string {
+	var inherited []string
+
+	inheritString := func(name string, value *string, defaultValue string) {
+		if *value == "" && defaultValue != "" && !feed.OverridesSetting(name) {
+			*value = defaultValue
+			inherited = append(inherited, name)
+		}
+	}
+
+	inheritBool := func(name string, value *bool, defaultValue bool) {
+		if !*value && defaultValue && !feed.OverridesSetting(name) {
+			*value = true
+			inherited = append(inherited, name)
+		}
+	}
+
+	inheritString("scraper_rules", &feed.ScraperRules, d.ScraperRules)
+	inheritString("rewrite_rules", &feed.RewriteRules, d.RewriteRules)
+	inheritString("urlrewrite_rules", &feed.UrlRewriteRules, d.UrlRewriteRules)
+	inheritString("blocklist_rules", &feed.BlocklistRules, d.BlocklistRules)
+	inheritString("keeplist_rules", &feed.KeeplistRules, d.KeeplistRules)
+	inheritString("block_filter_entry_rules", &feed.BlockFilterEntryRules, d.BlockFilterEntryRules)
+	inheritString("keep_filter_entry_rules", &feed.KeepFilterEntryRules, d.KeepFilterEntryRules)
+	inheritString("user_agent", &feed.UserAgent, d.UserAgent)
+	inheritString("cookie", &feed.Cookie, d.Cookie)
+	inheritString("proxy_url", &feed.ProxyURL, d.ProxyURL)
+	inheritString("apprise_service_urls", &feed.AppriseServiceURLs, d.AppriseServiceURLs)
+	inheritString("webhook_url", &feed.WebhookURL, d.WebhookURL)
+	inheritString("ntfy_topic", &feed.NtfyTopic, d.NtfyTopic)
+	inheritBool("crawler", &feed.Crawler, d.Crawler)
+	inheritBool("ignore_entry_updates", &feed.IgnoreEntryUpdates, d.IgnoreEntryUpdates)
+	inheritBool("ignore_http_cache", &feed.IgnoreHTTPCache, d.IgnoreHTTPCache)
+	inheritBool("allow_self_signed_certificates", &feed.AllowSelfSignedCertificates, d.AllowSelfSignedCertificates)
+	inheritBool("fetch_via_proxy", &feed.FetchViaProxy, d.FetchViaProxy)
+	inheritBool("disable_http2", &feed.DisableHTTP2, d.DisableHTTP2)
+	inheritBool("no_media_player", &feed.NoMediaPlayer, d.NoMediaPlayer)
+	inheritBool("ntfy_enabled", &feed.NtfyEnabled, d.NtfyEnabled)
+	inheritBool("pushover_enabled", &feed.PushoverEnabled, d.PushoverEnabled)
+
+	return inherited
+}
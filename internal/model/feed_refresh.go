@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// FeedRefresh represents a refresh attempt of a feed.
+// StatusCode is zero when no HTTP response has been received.
+// Shared is true when the response was fetched for another subscriber of the same feed.
+type FeedRefresh struct {
+	ID             int64     `json:"id"`
+	FeedID         int64     `json:"feed_id"`
+	CheckedAt      time.Time `json:"checked_at"`
+	StatusCode     int       `json:"status_code"`
+	Duration       int64     `json:"duration_ms"`
+	BodySize       int64     `json:"body_size"`
+	NotModified    bool      `json:"not_modified"`
+	Shared         bool      `json:"shared"`
+	NewEntries     int       `json:"new_entries"`
+	UpdatedEntries int       `json:"updated_entries"`
+	ErrorMessage   string    `json:"error_message"`
+}
+
+// Failed returns true if the refresh attempt ended with an error.
+func (f *FeedRefresh) Failed() bool {
+	return f.ErrorMessage != ""
+}
+
+// FeedRefreshHistory represents the refresh attempts of a feed, most recent first.
+type FeedRefreshHistory []*FeedRefresh
@@ -5,6 +5,7 @@ package model // import "miniflux.app/v2/internal/model"
 
 import (
 	"os"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -30,6 +31,134 @@ func TestFeedCategorySetter(t *testing.T) {
 	}
 }
 
+func TestFeedWithCategoryDefaults(t *testing.T) {
+	feed := &Feed{
+		UserAgent:    "feed user agent",
+		ScraperRules: "",
+		Crawler:      false,
+		Category: &Category{
+			ID: 1,
+			FeedDefaults: FeedDefaults{
+				UserAgent:    "category user agent",
+				ScraperRules: "article",
+				Crawler:      true,
+			},
+		},
+	}
+
+	effectiveFeed := feed.WithCategoryDefaults()
+
+	if effectiveFeed.UserAgent != "feed user agent" {
+		t.Errorf(`The feed user agent should not be overridden, got %q`, effectiveFeed.UserAgent)
+	}
+
+	if effectiveFeed.ScraperRules != "article" {
+		t.Errorf(`The scraper rules should be inherited, got %q`, effectiveFeed.ScraperRules)
+	}
+
+	if !effectiveFeed.Crawler {
+		t.Error(`The crawler should be inherited`)
+	}
+
+	if !effectiveFeed.InheritsSetting("scraper_rules") || !effectiveFeed.InheritsSetting("crawler") || effectiveFeed.InheritsSetting("user_agent") {
+		t.Errorf(`Unexpected inherited settings: %v`, effectiveFeed.InheritedSettings)
+	}
+
+	if feed.ScraperRules != "" || feed.Crawler || feed.InheritedSettings != nil {
+		t.Error(`The original feed should not be modified`)
+	}
+}
+
+func TestFeedWithCategoryDefaultsAndOverriddenSettings(t *testing.T) {
+	feed := &Feed{
+		Crawler:            false,
+		ScraperRules:       "",
+		OverriddenSettings: []string{"crawler", "scraper_rules"},
+		Category: &Category{
+			ID: 1,
+			FeedDefaults: FeedDefaults{
+				ScraperRules:    "article",
+				Crawler:         true,
+				IgnoreHTTPCache: true,
+			},
+		},
+	}
+
+	effectiveFeed := feed.WithCategoryDefaults()
+
+	if effectiveFeed.Crawler {
+		t.Error(`The crawler is explicitly disabled on the feed and should not be inherited`)
+	}
+
+	if effectiveFeed.ScraperRules != "" {
+		t.Errorf(`The scraper rules are explicitly empty on the feed and should not be inherited, got %q`, effectiveFeed.ScraperRules)
+	}
+
+	if !effectiveFeed.IgnoreHTTPCache {
+		t.Error(`The settings that are not overridden should be inherited`)
+	}
+
+	if effectiveFeed.InheritsSetting("crawler") || effectiveFeed.InheritsSetting("scraper_rules") || !effectiveFeed.InheritsSetting("ignore_http_cache") {
+		t.Errorf(`Unexpected inherited settings: %v`, effectiveFeed.InheritedSettings)
+	}
+}
+
+func TestFeedDefaultsDefines(t *testing.T) {
+	defaults := &FeedDefaults{UserAgent: "category user agent", Crawler: true}
+
+	if !defaults.Defines("user_agent") || !defaults.Defines("crawler") {
+		t.Error(`The settings with a default value should be defined`)
+	}
+
+	if defaults.Defines("cookie") || defaults.Defines("ignore_http_cache") {
+		t.Error(`The settings without a default value should not be defined`)
+	}
+}
+
+func TestFeedDefaultSettings(t *testing.T) {
+	defaults := &FeedDefaults{
+		ScraperRules:                "a",
+		RewriteRules:                "a",
+		UrlRewriteRules:             "a",
+		BlocklistRules:              "a",
+		KeeplistRules:               "a",
+		BlockFilterEntryRules:       "a",
+		KeepFilterEntryRules:        "a",
+		UserAgent:                   "a",
+		Cookie:                      "a",
+		ProxyURL:                    "a",
+		AppriseServiceURLs:          "a",
+		WebhookURL:                  "a",
+		NtfyTopic:                   "a",
+		Crawler:                     true,
+		IgnoreEntryUpdates:          true,
+		IgnoreHTTPCache:             true,
+		AllowSelfSignedCertificates: true,
+		FetchViaProxy:               true,
+		DisableHTTP2:                true,
+		NoMediaPlayer:               true,
+		NtfyEnabled:                 true,
+		PushoverEnabled:             true,
+	}
+
+	if settings := defaults.applyTo(&Feed{}); !slices.Equal(settings, FeedDefaultSettings) {
+		t.Errorf(`The list of feed default settings is out of date, got %v`, settings)
+	}
+}
+
+func TestFeedWithCategoryDefaultsWithoutCategory(t *testing.T) {
+	feed := &Feed{UserAgent: "feed user agent"}
+
+	effectiveFeed := feed.WithCategoryDefaults()
+	if effectiveFeed == feed {
+		t.Fatal(`A copy of the feed should be returned`)
+	}
+
+	if effectiveFeed.UserAgent != "feed user agent" || len(effectiveFeed.InheritedSettings) != 0 {
+		t.Errorf(`The feed settings should be unchanged, got %+v`, effectiveFeed)
+	}
+}
+
 func TestFeedErrorCounter(t *testing.T) {
 	feed := &Feed{}
 	feed.WithTranslatedErrorMessage("Some Error")
@@ -376,3 +505,56 @@ func TestFeedScheduleNextCheckEntryFrequencyLargeNewTTL(t *testing.T) {
 		t.Error(`The next_check_at should be after timeBefore + entry frequency min interval`)
 	}
 }
+
+func TestFeedTrackPendingFeedURL(t *testing.T) {
+	feed := &Feed{FeedURL: "https://example.org/feed"}
+
+	for i := range 2 {
+		if feed.TrackPendingFeedURL("https://example.com/feed", 3) {
+			t.Fatalf(`The feed should not be moved after %d refreshes`, i+1)
+		}
+	}
+
+	if feed.PendingFeedURL != "https://example.com/feed" || feed.PendingFeedURLCount != 2 {
+		t.Fatalf(`Unexpected pending feed URL: %q (%d)`, feed.PendingFeedURL, feed.PendingFeedURLCount)
+	}
+
+	if !feed.TrackPendingFeedURL("https://example.com/feed", 3) {
+		t.Fatal(`The feed should be moved after 3 refreshes`)
+	}
+}
+
+func TestFeedTrackPendingFeedURLWithAnotherLocation(t *testing.T) {
+	feed := &Feed{FeedURL: "https://example.org/feed", PendingFeedURL: "https://example.com/feed", PendingFeedURLCount: 2}
+
+	if feed.TrackPendingFeedURL("https://example.net/feed", 3) {
+		t.Fatal(`The feed should not be moved to a new location reported once`)
+	}
+
+	if feed.PendingFeedURL != "https://example.net/feed" || feed.PendingFeedURLCount != 1 {
+		t.Fatalf(`Unexpected pending feed URL: %q (%d)`, feed.PendingFeedURL, feed.PendingFeedURLCount)
+	}
+}
+
+func TestFeedTrackPendingFeedURLResetsCounter(t *testing.T) {
+	for name, tc := range map[string]struct {
+		newFeedURL string
+		threshold  int
+	}{
+		"Not moved":        {"", 3},
+		"Same location":    {"https://example.org/feed", 3},
+		"Migration is off": {"https://example.com/feed", 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			feed := &Feed{FeedURL: "https://example.org/feed", PendingFeedURL: "https://example.com/feed", PendingFeedURLCount: 2}
+
+			if feed.TrackPendingFeedURL(tc.newFeedURL, tc.threshold) {
+				t.Fatal(`The feed should not be moved`)
+			}
+
+			if feed.PendingFeedURL != "" || feed.PendingFeedURLCount != 0 {
+				t.Fatalf(`The pending feed URL should be reset, got %q (%d)`, feed.PendingFeedURL, feed.PendingFeedURLCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// Reasons of an automatic feed URL change.
+const (
+	// FeedURLChangeReasonPermanentRedirect is used when the feed URL is permanently redirected (301 or 308).
+	FeedURLChangeReasonPermanentRedirect = "permanent_redirect"
+	// FeedURLChangeReasonDeclaredURL is used when the feed declares another location,
+	// with <itunes:new-feed-url> or a self link.
+	FeedURLChangeReasonDeclaredURL = "declared_url"
+)
+
+// FeedURLChange represents an automatic change of the feed URL.
+type FeedURLChange struct {
+	ID        int64     `json:"id"`
+	FeedID    int64     `json:"feed_id"`
+	OldURL    string    `json:"old_url"`
+	NewURL    string    `json:"new_url"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FeedURLChanges represents a list of feed URL changes.
+type FeedURLChanges []*FeedURLChange
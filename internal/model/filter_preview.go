@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// Default and maximum number of stored entries evaluated by a filter preview.
+const (
+	DefaultFilterPreviewLimit = 50
+	MaxFilterPreviewLimit     = 500
+)
+
+// FilterPreviewRequest represents the filter settings to evaluate against the stored entries of a feed.
+// Settings left empty are taken from the feed.
+type FilterPreviewRequest struct {
+	BlocklistRules        *string `json:"blocklist_rules"`
+	KeeplistRules         *string `json:"keeplist_rules"`
+	BlockFilterEntryRules *string `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules  *string `json:"keep_filter_entry_rules"`
+	BlockFilterExpression *string `json:"block_filter_expression"`
+	Limit                 int     `json:"limit"`
+}
+
+func (f *FilterPreviewRequest) Patch(feed *Feed) {
+	if f.BlocklistRules != nil {
+		feed.BlocklistRules = *f.BlocklistRules
+	}
+
+	if f.KeeplistRules != nil {
+		feed.KeeplistRules = *f.KeeplistRules
+	}
+
+	if f.BlockFilterEntryRules != nil {
+		feed.BlockFilterEntryRules = *f.BlockFilterEntryRules
+	}
+
+	if f.KeepFilterEntryRules != nil {
+		feed.KeepFilterEntryRules = *f.KeepFilterEntryRules
+	}
+
+	if f.BlockFilterExpression != nil {
+		feed.BlockFilterExpression = *f.BlockFilterExpression
+	}
+}
+
+// FilterPreviewEntry is the decision the filters would take for a stored entry.
+// MatchedRule is empty when no rule applies, or when the entry is blocked because no keep rule matches.
+type FilterPreviewEntry struct {
+	EntryID     int64  `json:"entry_id"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	Blocked     bool   `json:"blocked"`
+	MatchedRule string `json:"matched_rule"`
+}
+
+// FilterPreview represents the result of a filter preview.
+type FilterPreview struct {
+	Total   int                   `json:"total"`
+	Blocked int                   `json:"blocked"`
+	Entries []*FilterPreviewEntry `json:"entries"`
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// Highlight represents a passage of an entry highlighted by the user, with an optional note.
+// The passage is anchored in the text of the sanitized entry content, the offsets are counted in characters
+// and the prefix and the suffix allow to find the passage again when the content changes.
+type Highlight struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	EntryID     int64     `json:"entry_id"`
+	Text        string    `json:"text"`
+	Prefix      string    `json:"prefix"`
+	Suffix      string    `json:"suffix"`
+	StartOffset int       `json:"start_offset"`
+	EndOffset   int       `json:"end_offset"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	// The entry fields are only set when listing the highlights of the user.
+	FeedID     int64  `json:"feed_id,omitempty"`
+	EntryTitle string `json:"entry_title,omitempty"`
+	EntryURL   string `json:"entry_url,omitempty"`
+}
+
+// Highlights represents a list of highlights.
+type Highlights []*Highlight
+
+// HighlightCreationRequest represents the request to highlight a passage of an entry.
+// The start offset is a hint, the passage is searched in the entry content when it doesn't match.
+type HighlightCreationRequest struct {
+	Text        string `json:"text"`
+	Prefix      string `json:"prefix"`
+	Suffix      string `json:"suffix"`
+	StartOffset int    `json:"start_offset"`
+	Note        string `json:"note"`
+}
+
+// HighlightModificationRequest represents the request to update the note of a highlight.
+type HighlightModificationRequest struct {
+	Note *string `json:"note"`
+}
+
+func (h *HighlightModificationRequest) Patch(highlight *Highlight) {
+	if h.Note != nil {
+		highlight.Note = *h.Note
+	}
+}
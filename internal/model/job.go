@@ -22,3 +22,19 @@ func (jl *JobList) FeedURLs() []string {
 	}
 	return feedURLs
 }
+
+// GroupByFeedURL returns the jobs grouped by feed URL, in the order of the first job of each group.
+func (jl *JobList) GroupByFeedURL() []JobList {
+	var groups []JobList
+	groupIndexes := make(map[string]int)
+	for _, job := range *jl {
+		index, found := groupIndexes[job.FeedURL]
+		if !found {
+			index = len(groups)
+			groupIndexes[job.FeedURL] = index
+			groups = append(groups, nil)
+		}
+		groups[index] = append(groups[index], job)
+	}
+	return groups
+}
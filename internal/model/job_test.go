@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJobListGroupByFeedURL(t *testing.T) {
+	jobs := JobList{
+		{UserID: 1, FeedID: 1, FeedURL: "https://example.org/a.xml"},
+		{UserID: 1, FeedID: 2, FeedURL: "https://example.org/b.xml"},
+		{UserID: 2, FeedID: 3, FeedURL: "https://example.org/a.xml"},
+	}
+
+	expected := []JobList{
+		{{UserID: 1, FeedID: 1, FeedURL: "https://example.org/a.xml"}, {UserID: 2, FeedID: 3, FeedURL: "https://example.org/a.xml"}},
+		{{UserID: 1, FeedID: 2, FeedURL: "https://example.org/b.xml"}},
+	}
+
+	if groups := jobs.GroupByFeedURL(); !reflect.DeepEqual(groups, expected) {
+		t.Errorf(`Unexpected groups: %v`, groups)
+	}
+}
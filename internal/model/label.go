@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "fmt"
+
+// Label represents a user-defined label attached to entries.
+type Label struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"user_id"`
+	Title  string `json:"title"`
+	// Pointer is needed to avoid breaking /v1/labels when counters are not requested.
+	EntryCount *int `json:"entry_count,omitempty"`
+}
+
+func (l *Label) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s", l.ID, l.UserID, l.Title)
+}
+
+// Labels represents a list of labels.
+type Labels []*Label
+
+// Titles returns the title of each label.
+func (l Labels) Titles() []string {
+	titles := make([]string, 0, len(l))
+	for _, label := range l {
+		titles = append(titles, label.Title)
+	}
+	return titles
+}
+
+// IDs returns the ID of each label.
+func (l Labels) IDs() []int64 {
+	ids := make([]int64, 0, len(l))
+	for _, label := range l {
+		ids = append(ids, label.ID)
+	}
+	return ids
+}
+
+// LabelCreationRequest represents the request to create a label.
+type LabelCreationRequest struct {
+	Title string `json:"title"`
+}
+
+// LabelModificationRequest represents the request to rename a label.
+type LabelModificationRequest struct {
+	Title *string `json:"title"`
+}
+
+func (l *LabelModificationRequest) Patch(label *Label) {
+	if l.Title != nil {
+		label.Title = *l.Title
+	}
+}
+
+// LabelMergeRequest represents the request to merge a label into another one.
+type LabelMergeRequest struct {
+	DestinationLabelID int64 `json:"destination_label_id"`
+}
+
+// EntryLabelsUpdateRequest represents the request to replace the labels of an entry.
+type EntryLabelsUpdateRequest struct {
+	LabelIDs []int64 `json:"label_ids"`
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// LoginAttempt represents the failed logins of a client IP address or of a username.
+// The key is prefixed by its kind, like "ip:192.0.2.1" or "user:john".
+// The lockout end is zero when the key is not locked out.
+type LoginAttempt struct {
+	Key            string
+	FailedAttempts int
+	LockedUntil    time.Time
+	LastFailedAt   time.Time
+}
+
+// LoginAttempts represents a list of login attempts.
+type LoginAttempts []*LoginAttempt
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"fmt"
+	"time"
+)
+
+// Entries selected by a published feed.
+const (
+	PublishedFeedKindStarred  = "starred"
+	PublishedFeedKindShared   = "shared"
+	PublishedFeedKindCategory = "category"
+	PublishedFeedKindTag      = "tag"
+	PublishedFeedKindLabel    = "label"
+)
+
+// PublishedFeed represents a list of entries published as a feed,
+// readable without authentication by anyone knowing the token.
+type PublishedFeed struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	Token      string    `json:"token"`
+	Kind       string    `json:"kind"`
+	CategoryID int64     `json:"category_id"`
+	LabelID    int64     `json:"label_id"`
+	Tag        string    `json:"tag"`
+	Title      string    `json:"title"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (p *PublishedFeed) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Kind=%s, Title=%s", p.ID, p.UserID, p.Kind, p.Title)
+}
+
+// PublishedFeeds represents a list of published feeds.
+type PublishedFeeds []*PublishedFeed
+
+// PublishedFeedCreationRequest represents the request to publish a new feed.
+type PublishedFeedCreationRequest struct {
+	Kind       string `json:"kind"`
+	CategoryID int64  `json:"category_id"`
+	LabelID    int64  `json:"label_id"`
+	Tag        string `json:"tag"`
+	Title      string `json:"title"`
+}
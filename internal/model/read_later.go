@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// ReadLaterAdditionRequest represents a request to add entries at the end of the read later queue.
+type ReadLaterAdditionRequest struct {
+	EntryIDs []int64 `json:"entry_ids"`
+}
+
+// ReadLaterMoveRequest represents a request to move an entry of the read later queue.
+// The position starts at 1 for the first entry of the queue.
+type ReadLaterMoveRequest struct {
+	Position int `json:"position"`
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "fmt"
+
+// SavedSearch represents a persisted search query, displayed as a virtual feed.
+type SavedSearch struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	Title      string `json:"title"`
+	Query      string `json:"query"`
+	Status     string `json:"status"`
+	Starred    bool   `json:"starred"`
+	CategoryID int64  `json:"category_id"`
+	FeedID     int64  `json:"feed_id"`
+	MaxAgeDays int    `json:"max_age_days"`
+	// Pointer is needed to avoid breaking /v1/saved-searches when counters are not requested.
+	TotalUnread *int `json:"total_unread,omitempty"`
+}
+
+func (s *SavedSearch) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s, Query=%s", s.ID, s.UserID, s.Title, s.Query)
+}
+
+// SavedSearches represents a list of saved searches.
+type SavedSearches []*SavedSearch
+
+// SavedSearchCreationRequest represents the request to create a saved search.
+type SavedSearchCreationRequest struct {
+	Title      string `json:"title"`
+	Query      string `json:"query"`
+	Status     string `json:"status"`
+	Starred    bool   `json:"starred"`
+	CategoryID int64  `json:"category_id"`
+	FeedID     int64  `json:"feed_id"`
+	MaxAgeDays int    `json:"max_age_days"`
+}
+
+// SavedSearchModificationRequest represents the request to update a saved search.
+type SavedSearchModificationRequest struct {
+	Title      *string `json:"title"`
+	Query      *string `json:"query"`
+	Status     *string `json:"status"`
+	Starred    *bool   `json:"starred"`
+	CategoryID *int64  `json:"category_id"`
+	FeedID     *int64  `json:"feed_id"`
+	MaxAgeDays *int    `json:"max_age_days"`
+}
+
+func (s *SavedSearchModificationRequest) Patch(savedSearch *SavedSearch) {
+	if s.Title != nil {
+		savedSearch.Title = *s.Title
+	}
+
+	if s.Query != nil {
+		savedSearch.Query = *s.Query
+	}
+
+	if s.Status != nil {
+		savedSearch.Status = *s.Status
+	}
+
+	if s.Starred != nil {
+		savedSearch.Starred = *s.Starred
+	}
+
+	if s.CategoryID != nil {
+		savedSearch.CategoryID = *s.CategoryID
+	}
+
+	if s.FeedID != nil {
+		savedSearch.FeedID = *s.FeedID
+	}
+
+	if s.MaxAgeDays != nil {
+		savedSearch.MaxAgeDays = *s.MaxAgeDays
+	}
+}
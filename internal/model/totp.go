@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// UserTOTP represents the TOTP secret used for the two-factor authentication of a user.
+// The secret is not enabled until the user confirms the enrollment with a valid code.
+type UserTOTP struct {
+	UserID       int64
+	Secret       string
+	Enabled      bool
+	LastUsedStep int64
+	CreatedAt    time.Time
+}
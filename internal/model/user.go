@@ -35,10 +35,12 @@ type User struct {
 	MediaPlaybackRate               float64    `json:"media_playback_rate"`
 	BlockFilterEntryRules           string     `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules            string     `json:"keep_filter_entry_rules"`
+	BlockFilterExpression           string     `json:"block_filter_expression"`
 	MarkReadOnView                  bool       `json:"mark_read_on_view"`
 	MarkReadOnMediaPlayerCompletion bool       `json:"mark_read_on_media_player_completion"`
 	AlwaysOpenExternalLinks         bool       `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab       bool       `json:"open_external_links_in_new_tab"`
+	RemoveReadLaterOnRead           bool       `json:"remove_read_later_on_read"`
 	KeyboardShortcuts               bool       `json:"keyboard_shortcuts"`
 	ShowReadingTime                 bool       `json:"show_reading_time"`
 	EntrySwipe                      bool       `json:"entry_swipe"`
@@ -82,8 +84,10 @@ type UserModificationRequest struct {
 	MediaPlaybackRate               *float64 `json:"media_playback_rate"`
 	BlockFilterEntryRules           *string  `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules            *string  `json:"keep_filter_entry_rules"`
+	BlockFilterExpression           *string  `json:"block_filter_expression"`
 	AlwaysOpenExternalLinks         *bool    `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab       *bool    `json:"open_external_links_in_new_tab"`
+	RemoveReadLaterOnRead           *bool    `json:"remove_read_later_on_read"`
 }
 
 // Patch updates the User object with the modification request.
@@ -192,6 +196,10 @@ func (u *UserModificationRequest) Patch(user *User) {
 		user.KeepFilterEntryRules = *u.KeepFilterEntryRules
 	}
 
+	if u.BlockFilterExpression != nil {
+		user.BlockFilterExpression = *u.BlockFilterExpression
+	}
+
 	if u.AlwaysOpenExternalLinks != nil {
 		user.AlwaysOpenExternalLinks = *u.AlwaysOpenExternalLinks
 	}
@@ -199,6 +207,10 @@ func (u *UserModificationRequest) Patch(user *User) {
 	if u.OpenExternalLinksInNewTab != nil {
 		user.OpenExternalLinksInNewTab = *u.OpenExternalLinksInNewTab
 	}
+
+	if u.RemoveReadLaterOnRead != nil {
+		user.RemoveReadLaterOnRead = *u.RemoveReadLaterOnRead
+	}
 }
 
 // UseTimezone converts last login date to the given timezone.
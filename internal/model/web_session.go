@@ -40,6 +40,7 @@ type webSessionState struct {
 	ErrorMessage       string                `json:"error_message,omitempty"`
 	OAuth2             *WebSessionOAuth2     `json:"oauth2,omitempty"`
 	WebAuthn           *webauthn.SessionData `json:"webauthn,omitempty"`
+	TOTPLogin          *WebSessionTOTPLogin  `json:"totp_login,omitempty"`
 	LastForceRefreshAt *time.Time            `json:"last_force_refresh_at,omitempty"`
 	Language           string                `json:"language,omitempty"`
 	Theme              string                `json:"theme,omitempty"`
@@ -51,6 +52,18 @@ type WebSessionOAuth2 struct {
 	CodeVerifier string `json:"code_verifier,omitempty"`
 }
 
+// WebSessionTOTPLogin stores the pending login of a user who entered a valid password
+// and still has to enter a two-factor authentication code.
+type WebSessionTOTPLogin struct {
+	UserID         int64     `json:"user_id"`
+	RedirectURL    string    `json:"redirect_url,omitempty"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	FailedAttempts int       `json:"failed_attempts,omitempty"`
+}
+
+// totpLoginDuration is the time given to the user to enter the two-factor authentication code.
+const totpLoginDuration = 5 * time.Minute
+
 // NewWebSession builds an unauthenticated browser session with a fresh
 // identity and returns it along with the raw session secret.
 func NewWebSession(userAgent, ip string) (*WebSession, string) {
@@ -240,6 +253,41 @@ func (s *WebSession) ClearOAuth2Flow() {
 	s.state.OAuth2 = nil
 }
 
+// StartTOTPLogin stores the pending login of the user until the two-factor authentication code is entered.
+func (s *WebSession) StartTOTPLogin(userID int64, redirectURL string) {
+	s.dirty = true
+	s.state.TOTPLogin = &WebSessionTOTPLogin{
+		UserID:      userID,
+		RedirectURL: redirectURL,
+		ExpiresAt:   time.Now().Add(totpLoginDuration).UTC(),
+	}
+}
+
+// PendingTOTPLogin returns the pending login, or nil if there is none or it has expired.
+func (s *WebSession) PendingTOTPLogin() *WebSessionTOTPLogin {
+	if s.state.TOTPLogin == nil || time.Now().After(s.state.TOTPLogin.ExpiresAt) {
+		return nil
+	}
+	return s.state.TOTPLogin
+}
+
+// RecordTOTPLoginFailure increments and returns the number of invalid codes entered for the pending login.
+func (s *WebSession) RecordTOTPLoginFailure() int {
+	if s.state.TOTPLogin == nil {
+		return 0
+	}
+
+	s.dirty = true
+	s.state.TOTPLogin.FailedAttempts++
+	return s.state.TOTPLogin.FailedAttempts
+}
+
+// ClearTOTPLogin discards the pending login.
+func (s *WebSession) ClearTOTPLogin() {
+	s.dirty = true
+	s.state.TOTPLogin = nil
+}
+
 // SetUser binds the session to an authenticated user and copies their preferences.
 func (s *WebSession) SetUser(user *User) {
 	if user == nil {
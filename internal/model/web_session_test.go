@@ -282,6 +282,49 @@ func TestWebSession_OAuth2FlowLifecycle(t *testing.T) {
 	}
 }
 
+func TestWebSession_TOTPLoginLifecycle(t *testing.T) {
+	session := &WebSession{}
+
+	if session.PendingTOTPLogin() != nil {
+		t.Error("PendingTOTPLogin() must be nil by default")
+	}
+	if got := session.RecordTOTPLoginFailure(); got != 0 {
+		t.Errorf("RecordTOTPLoginFailure() without pending login = %d, want 0", got)
+	}
+
+	session.StartTOTPLogin(42, "/unread")
+
+	pending := session.PendingTOTPLogin()
+	if pending == nil {
+		t.Fatal("PendingTOTPLogin() must not be nil after StartTOTPLogin")
+	}
+	if pending.UserID != 42 || pending.RedirectURL != "/unread" {
+		t.Errorf("PendingTOTPLogin() = %+v, want user 42 and redirect /unread", pending)
+	}
+	if !session.IsDirty() {
+		t.Error("StartTOTPLogin must mark the session dirty")
+	}
+	if session.IsAuthenticated() {
+		t.Error("a pending TOTP login must not authenticate the session")
+	}
+
+	session.RecordTOTPLoginFailure()
+	if got := session.RecordTOTPLoginFailure(); got != 2 {
+		t.Errorf("RecordTOTPLoginFailure() = %d, want 2", got)
+	}
+
+	session.state.TOTPLogin.ExpiresAt = time.Now().Add(-time.Second)
+	if session.PendingTOTPLogin() != nil {
+		t.Error("PendingTOTPLogin() must be nil once expired")
+	}
+
+	session.StartTOTPLogin(42, "")
+	session.ClearTOTPLogin()
+	if session.PendingTOTPLogin() != nil {
+		t.Error("PendingTOTPLogin() after Clear must be nil")
+	}
+}
+
 func TestWebSession_ConsumeMessages(t *testing.T) {
 	t.Run("no messages", func(t *testing.T) {
 		session := &WebSession{}
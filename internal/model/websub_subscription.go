@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"fmt"
+	"time"
+)
+
+// States of a WebSub subscription.
+const (
+	WebSubStatePending    = "pending"
+	WebSubStateSubscribed = "subscribed"
+	WebSubStateDenied     = "denied"
+)
+
+// WebSubSubscription represents the subscription of a feed to the WebSub hub announced by the feed.
+type WebSubSubscription struct {
+	FeedID         int64
+	UserID         int64
+	HubURL         string
+	TopicURL       string
+	Token          string
+	Secret         string
+	State          string
+	LeaseExpiresAt *time.Time
+	RequestedAt    *time.Time
+	LastPushAt     *time.Time
+	CreatedAt      time.Time
+}
+
+func (w *WebSubSubscription) String() string {
+	return fmt.Sprintf("FeedID=%d, HubURL=%s, TopicURL=%s, State=%s", w.FeedID, w.HubURL, w.TopicURL, w.State)
+}
+
+// WebSubSubscriptions represents a list of WebSub subscriptions.
+type WebSubSubscriptions []*WebSubSubscription
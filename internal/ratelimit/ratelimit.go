@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ratelimit throttles the failed logins by client IP address and by username.
+//
+// Once a key reaches the maximum number of consecutive failures, it is locked out for the base duration.
+// Each additional failure doubles the lockout, up to the maximum duration.
+// The failures are forgotten when no new failure happens during the maximum duration.
+package ratelimit // import "miniflux.app/v2/internal/ratelimit"
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/metric"
+)
+
+// Login sources, used as metric label.
+const (
+	SourceWeb          = "web"
+	SourceAPI          = "api"
+	SourceGoogleReader = "googlereader"
+	SourceFever        = "fever"
+)
+
+// Store persists the failed logins, it is implemented by the storage.
+type Store interface {
+	LoginLockedUntil(keys ...string) (*time.Time, error)
+	IncrementLoginFailures(key string, resetInterval time.Duration) (int, error)
+	LockLogin(key string, until time.Time) error
+	ResetLoginAttempts(key string) error
+}
+
+// IPKey returns the key of the failed logins of a client IP address.
+func IPKey(clientIP string) string {
+	return "ip:" + clientIP
+}
+
+// UserKey returns the key of the failed logins of a Miniflux username.
+func UserKey(username string) string {
+	return "user:" + strings.ToLower(username)
+}
+
+// LoginKeys returns the keys of the failed logins of a client IP address and of a Miniflux username.
+func LoginKeys(clientIP, username string) []string {
+	return []string{IPKey(clientIP), UserKey(username)}
+}
+
+// GoogleReaderUserKey returns the key of the failed logins of a Google Reader username.
+func GoogleReaderUserKey(username string) string {
+	return "googlereader:" + username
+}
+
+// IsEnabled returns true when the failed logins are throttled.
+func IsEnabled() bool {
+	return config.Opts.LoginRateLimitMaxAttempts() > 0
+}
+
+// LockedUntil returns the end of the lockout of the keys, or nil when the login is allowed.
+// The login is allowed when the lockout cannot be checked, the error is only logged.
+func LockedUntil(store Store, source string, keys ...string) *time.Time {
+	if !IsEnabled() {
+		return nil
+	}
+
+	lockedUntil, err := store.LoginLockedUntil(keys...)
+	if err != nil {
+		slog.Error("Unable to check the login lockout",
+			slog.String("source", source),
+			slog.Any("keys", keys),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+
+	if lockedUntil != nil {
+		metric.LoginAttempts.WithLabelValues(source, metric.LoginResultBlocked).Inc()
+	}
+
+	return lockedUntil
+}
+
+// RecordFailure counts a failed login for each key and locks out the keys with too many consecutive failures.
+func RecordFailure(store Store, source string, keys ...string) {
+	if !IsEnabled() {
+		return
+	}
+
+	metric.LoginAttempts.WithLabelValues(source, metric.LoginResultFailure).Inc()
+
+	maxLockout := config.Opts.LoginRateLimitMaxLockoutDuration()
+	for _, key := range keys {
+		failedAttempts, err := store.IncrementLoginFailures(key, maxLockout)
+		if err != nil {
+			slog.Error("Unable to record the failed login",
+				slog.String("source", source),
+				slog.String("key", key),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		lockout := lockoutDuration(
+			failedAttempts,
+			config.Opts.LoginRateLimitMaxAttempts(),
+			config.Opts.LoginRateLimitLockoutDuration(),
+			maxLockout,
+		)
+		if lockout == 0 {
+			continue
+		}
+
+		if err := store.LockLogin(key, time.Now().Add(lockout)); err != nil {
+			slog.Error("Unable to lock out the login",
+				slog.String("source", source),
+				slog.String("key", key),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		slog.Warn("Too many failed logins, login locked out",
+			slog.String("source", source),
+			slog.String("key", key),
+			slog.Int("failed_attempts", failedAttempts),
+			slog.Duration("lockout", lockout),
+		)
+		metric.LoginLockouts.WithLabelValues(source).Inc()
+	}
+}
+
+// RecordSuccess forgets the failed logins of the keys, once the login is complete including the two-factor step.
+// The key of the client IP address should not be given, a valid account must not hide the failures of others.
+func RecordSuccess(store Store, source string, keys ...string) {
+	if !IsEnabled() {
+		return
+	}
+
+	metric.LoginAttempts.WithLabelValues(source, metric.LoginResultSuccess).Inc()
+
+	for _, key := range keys {
+		if err := store.ResetLoginAttempts(key); err != nil {
+			slog.Error("Unable to reset the failed logins",
+				slog.String("source", source),
+				slog.String("key", key),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// lockoutDuration returns the lockout after the given consecutive failures, or 0 when the login is still allowed.
+func lockoutDuration(failedAttempts, maxAttempts int, baseDuration, maxDuration time.Duration) time.Duration {
+	if maxAttempts <= 0 || failedAttempts < maxAttempts {
+		return 0
+	}
+
+	exponent := failedAttempts - maxAttempts
+	if exponent >= 32 {
+		return maxDuration
+	}
+
+	return min(baseDuration<<exponent, maxDuration)
+}
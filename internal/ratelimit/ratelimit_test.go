@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit // import "miniflux.app/v2/internal/ratelimit"
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+)
+
+type fakeStore struct {
+	failures    map[string]int
+	lockedUntil map[string]time.Time
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{failures: make(map[string]int), lockedUntil: make(map[string]time.Time)}
+}
+
+func (f *fakeStore) LoginLockedUntil(keys ...string) (*time.Time, error) {
+	var lockedUntil *time.Time
+	for _, key := range keys {
+		if until, found := f.lockedUntil[key]; found && until.After(time.Now()) {
+			if lockedUntil == nil || until.After(*lockedUntil) {
+				lockedUntil = &until
+			}
+		}
+	}
+	return lockedUntil, nil
+}
+
+func (f *fakeStore) IncrementLoginFailures(key string, resetInterval time.Duration) (int, error) {
+	f.failures[key]++
+	return f.failures[key], nil
+}
+
+func (f *fakeStore) LockLogin(key string, until time.Time) error {
+	f.lockedUntil[key] = until
+	return nil
+}
+
+func (f *fakeStore) ResetLoginAttempts(key string) error {
+	delete(f.failures, key)
+	delete(f.lockedUntil, key)
+	return nil
+}
+
+func parseConfig(t *testing.T) {
+	t.Helper()
+
+	var err error
+	config.Opts, err = config.NewConfigParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Config parsing failure: %v`, err)
+	}
+}
+
+func TestLockoutDuration(t *testing.T) {
+	scenarios := []struct {
+		failedAttempts int
+		maxAttempts    int
+		expected       time.Duration
+	}{
+		{1, 5, 0},
+		{4, 5, 0},
+		{5, 5, time.Minute},
+		{6, 5, 2 * time.Minute},
+		{7, 5, 4 * time.Minute},
+		{11, 5, time.Hour},
+		{1000, 5, time.Hour},
+		{10, 0, 0},
+	}
+
+	for _, scenario := range scenarios {
+		result := lockoutDuration(scenario.failedAttempts, scenario.maxAttempts, time.Minute, time.Hour)
+		if result != scenario.expected {
+			t.Errorf(`Unexpected lockout after %d failures with a maximum of %d attempts, got %v instead of %v`,
+				scenario.failedAttempts, scenario.maxAttempts, result, scenario.expected)
+		}
+	}
+}
+
+func TestKeys(t *testing.T) {
+	if key := IPKey("192.0.2.1"); key != "ip:192.0.2.1" {
+		t.Errorf(`Unexpected IP key, got %q`, key)
+	}
+
+	if key := UserKey("John"); key != "user:john" {
+		t.Errorf(`Unexpected user key, got %q`, key)
+	}
+
+	if key := GoogleReaderUserKey("John"); key != "googlereader:John" {
+		t.Errorf(`Unexpected Google Reader user key, got %q`, key)
+	}
+}
+
+func TestTwoFactorFailuresLockOutTheUser(t *testing.T) {
+	parseConfig(t)
+	store := newFakeStore()
+
+	// The password is valid, only the two-factor codes are wrong, from a new client IP address after each session.
+	for i := range config.Opts.LoginRateLimitMaxAttempts() {
+		keys := LoginKeys(fmt.Sprintf("192.0.2.%d", i+1), "john")
+		if lockedUntil := LockedUntil(store, SourceWeb, keys...); lockedUntil != nil {
+			t.Fatalf(`The user is locked out after only %d failures`, i)
+		}
+		RecordFailure(store, SourceWeb, keys...)
+	}
+
+	if lockedUntil := LockedUntil(store, SourceWeb, LoginKeys("198.51.100.1", "john")...); lockedUntil == nil {
+		t.Fatal(`The user is not locked out after too many invalid two-factor codes`)
+	}
+
+	RecordSuccess(store, SourceWeb, UserKey("john"))
+	if lockedUntil := LockedUntil(store, SourceWeb, LoginKeys("198.51.100.1", "john")...); lockedUntil != nil {
+		t.Fatal(`The user is still locked out after a complete login`)
+	}
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	t.Setenv("LOGIN_RATE_LIMIT_MAX_ATTEMPTS", "0")
+	parseConfig(t)
+	store := newFakeStore()
+
+	for range 10 {
+		RecordFailure(store, SourceAPI, LoginKeys("192.0.2.1", "john")...)
+	}
+
+	if lockedUntil := LockedUntil(store, SourceAPI, LoginKeys("192.0.2.1", "john")...); lockedUntil != nil {
+		t.Fatal(`The login is locked out while the rate limiting is disabled`)
+	}
+
+	if len(store.failures) != 0 {
+		t.Fatalf(`Failures are recorded while the rate limiting is disabled: %v`, store.failures)
+	}
+}
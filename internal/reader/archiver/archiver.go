@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package archiver stores a copy of the entries and of their images for offline reading.
+package archiver // import "miniflux.app/v2/internal/reader/archiver"
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"strings"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/proxyrotator"
+	"miniflux.app/v2/internal/reader/fetcher"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/reader/rewrite"
+	"miniflux.app/v2/internal/storage"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// maxImagesPerEntry limits the number of images downloaded for a single entry.
+const maxImagesPerEntry = 50
+
+// ErrQuotaExceeded is returned when the archive would make the archives of the user larger than the maximum size.
+var ErrQuotaExceeded = errors.New("archiver: the archives of the user have reached the maximum size")
+
+// ArchiveEntry downloads the web page of the entry and its images, and stores them as the archive of the entry.
+// The content of the entry is archived when the web page cannot be downloaded.
+// The archives of the user, including the new one, must not be larger than the maximum size in bytes, 0 means no limit.
+func ArchiveEntry(store *storage.Storage, entry *model.Entry, maxSizePerUser int64) (*model.EntryArchive, error) {
+	user, err := store.UserByID(entry.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("archiver: user #%d not found", entry.UserID)
+	}
+
+	var usedSize int64
+	if maxSizePerUser > 0 {
+		usedSize, err = store.EntryArchivesSize(entry.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Avoid downloading anything when the quota is already reached.
+		if usedSize >= maxSizePerUser {
+			return nil, ErrQuotaExceeded
+		}
+	}
+
+	feed, err := store.FeedByID(entry.UserID, entry.FeedID)
+	if err != nil {
+		return nil, err
+	}
+	if feed == nil {
+		return nil, fmt.Errorf("archiver: feed #%d not found", entry.FeedID)
+	}
+
+	// The entry itself is not modified, the archive keeps its own copy of the content.
+	snapshot := entry.Clone()
+	if snapshot.Feed == nil {
+		snapshot.Feed = feed
+	}
+
+	if snapshot.URL != "" {
+		if err := processor.ProcessEntryWebPage(feed.WithCategoryDefaults(), snapshot, user); err != nil {
+			slog.Warn("Unable to download the web page of the entry, archiving the entry content",
+				slog.Int64("user_id", entry.UserID),
+				slog.Int64("entry_id", entry.ID),
+				slog.String("entry_url", entry.URL),
+				slog.Any("error", err),
+			)
+			snapshot.Content = entry.Content
+		}
+	}
+
+	content := removeResponsiveImages(snapshot.Content)
+	mediaList := downloadImages(newRequestBuilder(feed), imageURLs(content))
+
+	if maxSizePerUser > 0 && usedSize+archiveSize(content, mediaList) > maxSizePerUser {
+		return nil, ErrQuotaExceeded
+	}
+
+	archive := &model.EntryArchive{
+		EntryID: entry.ID,
+		UserID:  entry.UserID,
+		URL:     snapshot.URL,
+		Title:   snapshot.Title,
+		Content: content,
+	}
+
+	if err := store.CreateEntryArchive(archive, mediaList); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// ArchivePendingEntries archives the starred entries, and the entries of the feeds with archiving enabled,
+// of the users whose archives are smaller than the maximum size in bytes, 0 means no limit.
+func ArchivePendingEntries(store *storage.Storage, batchSize int, maxSizePerUser int64) {
+	entries, err := store.EntriesToArchive(batchSize, maxSizePerUser)
+	if err != nil {
+		slog.Error("Unable to fetch the entries to archive", slog.Any("error", err))
+		return
+	}
+
+	for _, entry := range entries {
+		archive, err := ArchiveEntry(store, entry, maxSizePerUser)
+		if errors.Is(err, ErrQuotaExceeded) {
+			slog.Debug("Skipping the entry, the archives of the user have reached the maximum size",
+				slog.Int64("user_id", entry.UserID),
+				slog.Int64("entry_id", entry.ID),
+			)
+			continue
+		}
+		if err != nil {
+			slog.Error("Unable to archive entry",
+				slog.Int64("user_id", entry.UserID),
+				slog.Int64("entry_id", entry.ID),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		slog.Debug("Entry archived",
+			slog.Int64("user_id", entry.UserID),
+			slog.Int64("entry_id", entry.ID),
+			slog.Int("media_count", archive.MediaCount),
+		)
+	}
+}
+
+// archiveSize returns the size in bytes of the archived content and of its media.
+func archiveSize(content string, mediaList model.ArchivedMediaList) int64 {
+	size := int64(len(content))
+	for _, media := range mediaList {
+		size += int64(len(media.Content))
+	}
+	return size
+}
+
+func newRequestBuilder(feed *model.Feed) *fetcher.RequestBuilder {
+	return fetcher.NewRequestBuilder().
+		WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent()).
+		WithCookie(feed.Cookie).
+		WithTimeout(config.Opts.HTTPClientTimeout()).
+		WithProxyRotator(proxyrotator.ProxyRotatorInstance).
+		WithCustomFeedProxyURL(feed.ProxyURL).
+		WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL()).
+		UseCustomApplicationProxyURL(feed.FetchViaProxy).
+		IgnoreTLSErrors(feed.AllowSelfSignedCertificates).
+		DisableHTTP2(feed.DisableHTTP2)
+}
+
+// removeResponsiveImages keeps a single source for each image, the one that is archived.
+func removeResponsiveImages(content string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return content
+	}
+
+	doc.Find("picture source").Remove()
+	doc.Find("img").RemoveAttr("srcset").RemoveAttr("sizes")
+
+	output, err := doc.Find("body").First().Html()
+	if err != nil {
+		return content
+	}
+
+	return output
+}
+
+// imageURLs returns the distinct remote images of the content.
+func imageURLs(content string) []string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return nil
+	}
+
+	var urls []string
+	seen := make(map[string]bool)
+	doc.Find("img[src]").Each(func(_ int, img *goquery.Selection) {
+		src := strings.TrimSpace(img.AttrOr("src", ""))
+		if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+			return
+		}
+
+		if !seen[src] && len(urls) < maxImagesPerEntry {
+			seen[src] = true
+			urls = append(urls, src)
+		}
+	})
+
+	return urls
+}
+
+func downloadImages(requestBuilder *fetcher.RequestBuilder, urls []string) model.ArchivedMediaList {
+	mediaList := make(model.ArchivedMediaList, 0, len(urls))
+	for _, imageURL := range urls {
+		media, err := downloadImage(requestBuilder, imageURL)
+		if err != nil {
+			slog.Debug("Unable to archive image",
+				slog.String("image_url", imageURL),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		mediaList = append(mediaList, media)
+	}
+
+	return mediaList
+}
+
+func downloadImage(requestBuilder *fetcher.RequestBuilder, imageURL string) (*model.ArchivedMedia, error) {
+	requestBuilder = requestBuilder.Clone()
+	if referer := rewrite.GetRefererForURL(imageURL); referer != "" {
+		requestBuilder = requestBuilder.WithHeader("Referer", referer)
+	}
+
+	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(imageURL))
+	defer responseHandler.Close()
+
+	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
+		return nil, fmt.Errorf("archiver: unable to download image: %w", localizedError.Error())
+	}
+
+	mimeType, _, err := mime.ParseMediaType(responseHandler.ContentType())
+	if err != nil || !strings.HasPrefix(mimeType, "image/") {
+		return nil, fmt.Errorf("archiver: unexpected content type %q", responseHandler.ContentType())
+	}
+
+	responseBody, localizedError := responseHandler.ReadBody(config.Opts.HTTPClientMaxBodySize())
+	if localizedError != nil {
+		return nil, fmt.Errorf("archiver: unable to read image: %w", localizedError.Error())
+	}
+
+	return &model.ArchivedMedia{
+		URL:      imageURL,
+		Hash:     crypto.HashFromBytes(responseBody),
+		MimeType: mimeType,
+		Content:  responseBody,
+	}, nil
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package archiver // import "miniflux.app/v2/internal/reader/archiver"
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestImageURLs(t *testing.T) {
+	content := `<p><img src="https://example.org/a.png"><img src=" https://example.org/b.png "><img src="https://example.org/a.png"><img src="data:image/png;base64,AAAA"><img src="/relative.png"></p>`
+
+	urls := imageURLs(content)
+	if strings.Join(urls, ",") != "https://example.org/a.png,https://example.org/b.png" {
+		t.Errorf(`Unexpected image URLs: %v`, urls)
+	}
+}
+
+func TestImageURLsLimit(t *testing.T) {
+	var content strings.Builder
+	for i := range maxImagesPerEntry + 10 {
+		fmt.Fprintf(&content, `<img src="https://example.org/%d.png">`, i)
+	}
+
+	if urls := imageURLs(content.String()); len(urls) != maxImagesPerEntry {
+		t.Errorf(`Expected %d images, got %d`, maxImagesPerEntry, len(urls))
+	}
+}
+
+func TestRemoveResponsiveImages(t *testing.T) {
+	content := `<picture><source srcset="https://example.org/a.webp" type="image/webp"><img src="https://example.org/a.png" srcset="https://example.org/a-2x.png 2x" sizes="100vw" alt="A"></picture>`
+	expected := `<picture><img src="https://example.org/a.png" alt="A"/></picture>`
+
+	if output := removeResponsiveImages(content); output != expected {
+		t.Errorf(`Unexpected output, got %q instead of %q`, output, expected)
+	}
+}
+
+func TestArchiveSize(t *testing.T) {
+	mediaList := model.ArchivedMediaList{
+		{Content: []byte("12345")},
+		{Content: []byte("123")},
+	}
+
+	if size := archiveSize("<p>Text</p>", mediaList); size != 19 {
+		t.Errorf(`Expected a size of 19 bytes, got %d`, size)
+	}
+
+	if size := archiveSize("", nil); size != 0 {
+		t.Errorf(`Expected a size of 0 bytes, got %d`, size)
+	}
+}
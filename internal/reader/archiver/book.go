@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package archiver // import "miniflux.app/v2/internal/reader/archiver"
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/epub"
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// MaxBookEntries is the maximum number of entries exported in a single book.
+const MaxBookEntries = 500
+
+// ExportBook writes the entries as an EPUB book with one chapter per entry.
+// The images are taken from the archive of the entries or from the cache of the media proxy,
+// nothing is downloaded and the other images are left out of the book.
+func ExportBook(w io.Writer, store *storage.Storage, title string, entries model.Entries) error {
+	book := epub.NewBook(bookIdentifier(entries), title, bookLanguage(entries))
+	book.Publisher = strings.Join(feedTitles(entries), ", ")
+
+	for _, entry := range entries {
+		mediaList, err := store.EntryArchiveMedia(entry.UserID, entry.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, media := range mediaList {
+			book.AddImage(media.URL, media.MimeType, media.Content)
+		}
+
+		content := removeResponsiveImages(entry.Content)
+
+		for _, imageURL := range imageURLs(content) {
+			if book.HasImage(imageURL) {
+				continue
+			}
+
+			if media := cachedImage(mediaproxy.CacheInstance, imageURL); media != nil {
+				book.AddImage(media.URL, media.MimeType, media.Content)
+			}
+		}
+
+		if entry.URL != "" {
+			content += fmt.Sprintf(`<p><a href="%s">%s</a></p>`, html.EscapeString(entry.URL), html.EscapeString(entry.URL))
+		}
+
+		book.AddChapter(entry.Title, entrySubtitle(entry, entry.Date.Format("2006-01-02")), content)
+	}
+
+	if err := book.Write(w); err != nil {
+		return fmt.Errorf("archiver: unable to export entries as EPUB: %w", err)
+	}
+
+	return nil
+}
+
+// cachedImage returns the image stored in the cache of the media proxy, or nil if the image is not cached.
+func cachedImage(cache *mediaproxy.Cache, imageURL string) *model.ArchivedMedia {
+	cachedMedia := cache.Lookup(imageURL)
+	if cachedMedia == nil || !strings.HasPrefix(cachedMedia.ContentType, "image/") {
+		return nil
+	}
+
+	file, err := cache.Open(cachedMedia)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil
+	}
+
+	return &model.ArchivedMedia{
+		URL:      imageURL,
+		MimeType: cachedMedia.ContentType,
+		Content:  content,
+	}
+}
+
+// bookIdentifier returns the same identifier for the same set of entries,
+// so reading systems can recognize a book exported again.
+func bookIdentifier(entries model.Entries) string {
+	entryIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		entryIDs = append(entryIDs, strconv.FormatInt(entry.ID, 10))
+	}
+
+	return "urn:miniflux:entries:" + crypto.SHA256(strings.Join(entryIDs, ","))
+}
+
+// bookLanguage returns the language of the first entry.
+func bookLanguage(entries model.Entries) string {
+	for _, entry := range entries {
+		if language := entryLanguage(entry); language != "" {
+			return language
+		}
+	}
+
+	return ""
+}
+
+func feedTitles(entries model.Entries) []string {
+	var titles []string
+	for _, entry := range entries {
+		if entry.Feed != nil && entry.Feed.Title != "" && !slices.Contains(titles, entry.Feed.Title) {
+			titles = append(titles, entry.Feed.Title)
+		}
+	}
+
+	return titles
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package archiver // import "miniflux.app/v2/internal/reader/archiver"
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/model"
+)
+
+func TestBookIdentifier(t *testing.T) {
+	entries := model.Entries{{ID: 1}, {ID: 2}}
+
+	if bookIdentifier(entries) != bookIdentifier(model.Entries{{ID: 1}, {ID: 2}}) {
+		t.Error(`The same entries should have the same identifier`)
+	}
+
+	if bookIdentifier(entries) == bookIdentifier(model.Entries{{ID: 1}, {ID: 3}}) {
+		t.Error(`Different entries should have different identifiers`)
+	}
+
+	if !strings.HasPrefix(bookIdentifier(entries), "urn:miniflux:entries:") {
+		t.Errorf(`Unexpected identifier %q`, bookIdentifier(entries))
+	}
+}
+
+func TestBookLanguage(t *testing.T) {
+	entries := model.Entries{
+		{Feed: &model.Feed{}},
+		{Feed: &model.Feed{Language: "de"}},
+		{Language: "fr", Feed: &model.Feed{}},
+	}
+
+	if language := bookLanguage(entries); language != "de" {
+		t.Errorf(`Expected the language of the first entry with a language, got %q`, language)
+	}
+
+	if language := bookLanguage(nil); language != "" {
+		t.Errorf(`Expected no language, got %q`, language)
+	}
+}
+
+func TestFeedTitles(t *testing.T) {
+	entries := model.Entries{
+		{Feed: &model.Feed{Title: "Feed A"}},
+		{Feed: &model.Feed{Title: "Feed B"}},
+		{Feed: &model.Feed{Title: "Feed A"}},
+		{Feed: &model.Feed{}},
+	}
+
+	if titles := feedTitles(entries); strings.Join(titles, ",") != "Feed A,Feed B" {
+		t.Errorf(`Unexpected feed titles: %v`, titles)
+	}
+}
+
+func TestCachedImage(t *testing.T) {
+	cache, err := mediaproxy.NewCache(t.TempDir(), 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for mediaURL, contentType := range map[string]string{
+		"https://example.org/image.png": "image/png",
+		"https://example.org/video.mp4": "video/mp4",
+	} {
+		response := &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Content-Type": {contentType}},
+			Body:          io.NopCloser(strings.NewReader("data")),
+			ContentLength: 4,
+		}
+		if _, err := cache.Store(mediaURL, response, time.Hour); err != nil {
+			t.Fatalf(`Unable to store media: %v`, err)
+		}
+	}
+
+	media := cachedImage(cache, "https://example.org/image.png")
+	if media == nil || media.MimeType != "image/png" || string(media.Content) != "data" {
+		t.Errorf(`Unexpected cached image: %+v`, media)
+	}
+
+	if media := cachedImage(cache, "https://example.org/video.mp4"); media != nil {
+		t.Errorf(`Only the images should be returned, got %+v`, media)
+	}
+
+	if media := cachedImage(cache, "https://example.org/other.png"); media != nil {
+		t.Errorf(`The image should not be cached, got %+v`, media)
+	}
+
+	if media := cachedImage(nil, "https://example.org/image.png"); media != nil {
+		t.Errorf(`A disabled cache should not return any image, got %+v`, media)
+	}
+}
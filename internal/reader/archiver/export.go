@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package archiver // import "miniflux.app/v2/internal/reader/archiver"
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"miniflux.app/v2/internal/epub"
+	"miniflux.app/v2/internal/model"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+var htmlExportTemplate = template.Must(template.New("archive").Parse(`<!DOCTYPE html>
+<html{{ with .Language }} lang="{{ . }}"{{ end }}>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{ .Title }}</title>
+<style>body{max-width:45em;margin:2em auto;padding:0 1em;font-family:sans-serif;line-height:1.5}img{max-width:100%;height:auto}</style>
+</head>
+<body>
+<article>
+<h1>{{ if .URL }}<a href="{{ .URL }}">{{ .Title }}</a>{{ else }}{{ .Title }}{{ end }}</h1>
+<p><em>{{ .Subtitle }}</em></p>
+{{ .Content }}
+</article>
+</body>
+</html>
+`))
+
+// RewriteImages replaces the URL of the archived images with the URL returned by rewriteFunc.
+func RewriteImages(content string, mediaList model.ArchivedMediaList, rewriteFunc func(media *model.ArchivedMedia) string) string {
+	if len(mediaList) == 0 {
+		return content
+	}
+
+	mediaByURL := make(map[string]*model.ArchivedMedia, len(mediaList))
+	for _, media := range mediaList {
+		mediaByURL[media.URL] = media
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return content
+	}
+
+	doc.Find("img[src]").Each(func(_ int, img *goquery.Selection) {
+		if media, found := mediaByURL[strings.TrimSpace(img.AttrOr("src", ""))]; found {
+			img.SetAttr("src", rewriteFunc(media))
+		}
+	})
+
+	output, err := doc.Find("body").First().Html()
+	if err != nil {
+		return content
+	}
+
+	return output
+}
+
+// ExportHTML returns the archive as a single HTML document, the images are embedded as data URLs.
+func ExportHTML(entry *model.Entry, archive *model.EntryArchive, mediaList model.ArchivedMediaList) ([]byte, error) {
+	content := RewriteImages(archive.Content, mediaList, func(media *model.ArchivedMedia) string {
+		return media.DataURL()
+	})
+
+	var buffer bytes.Buffer
+	err := htmlExportTemplate.Execute(&buffer, map[string]any{
+		"Language": entryLanguage(entry),
+		"Title":    archive.Title,
+		"URL":      archive.URL,
+		"Subtitle": entrySubtitle(entry, archive.CreatedAt.Format("2006-01-02")),
+		"Content":  template.HTML(content),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("archiver: unable to export entry #%d as HTML: %w", archive.EntryID, err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// ExportEPUB writes the archive as an EPUB book.
+func ExportEPUB(w io.Writer, entry *model.Entry, archive *model.EntryArchive, mediaList model.ArchivedMediaList) error {
+	book := epub.NewBook(fmt.Sprintf("urn:miniflux:entry:%d", archive.EntryID), archive.Title, entryLanguage(entry))
+	book.Author = entry.Author
+	book.Modified = archive.CreatedAt
+	if entry.Feed != nil {
+		book.Publisher = entry.Feed.Title
+	}
+
+	for _, media := range mediaList {
+		book.AddImage(media.URL, media.MimeType, media.Content)
+	}
+	book.AddChapter(archive.Title, entrySubtitle(entry, archive.CreatedAt.Format("2006-01-02")), archive.Content)
+
+	if err := book.Write(w); err != nil {
+		return fmt.Errorf("archiver: unable to export entry #%d as EPUB: %w", archive.EntryID, err)
+	}
+
+	return nil
+}
+
+func entryLanguage(entry *model.Entry) string {
+	if entry.Language == "" && entry.Feed != nil {
+		return entry.Feed.Language
+	}
+
+	return entry.Language
+}
+
+// entrySubtitle returns the feed, the author and the date of the entry.
+func entrySubtitle(entry *model.Entry, date string) string {
+	var parts []string
+	if entry.Feed != nil && entry.Feed.Title != "" {
+		parts = append(parts, entry.Feed.Title)
+	}
+
+	if entry.Author != "" {
+		parts = append(parts, entry.Author)
+	}
+
+	parts = append(parts, date)
+	return strings.Join(parts, " – ")
+}
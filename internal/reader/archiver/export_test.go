@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package archiver // import "miniflux.app/v2/internal/reader/archiver"
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func newTestArchive() (*model.Entry, *model.EntryArchive, model.ArchivedMediaList) {
+	entry := &model.Entry{
+		ID:     1,
+		Author: "Author",
+		Feed:   &model.Feed{Title: "Feed", Language: "fr"},
+	}
+
+	archive := &model.EntryArchive{
+		EntryID:   1,
+		URL:       "https://example.org/article",
+		Title:     "Article <title>",
+		Content:   `<p>Text</p><img src="https://example.org/a.png"><img src="https://example.org/b.png">`,
+		CreatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	mediaList := model.ArchivedMediaList{
+		{URL: "https://example.org/a.png", MimeType: "image/png", Content: []byte("png")},
+	}
+
+	return entry, archive, mediaList
+}
+
+func TestRewriteImages(t *testing.T) {
+	_, archive, mediaList := newTestArchive()
+
+	output := RewriteImages(archive.Content, mediaList, func(media *model.ArchivedMedia) string {
+		return "/archived/" + media.MimeType
+	})
+
+	expected := `<p>Text</p><img src="/archived/image/png"/><img src="https://example.org/b.png"/>`
+	if output != expected {
+		t.Errorf(`Unexpected output, got %q instead of %q`, output, expected)
+	}
+}
+
+func TestRewriteImagesWithoutMedia(t *testing.T) {
+	content := `<img src="https://example.org/a.png">`
+	if output := RewriteImages(content, nil, nil); output != content {
+		t.Errorf(`The content should not be modified, got %q`, output)
+	}
+}
+
+func TestExportHTML(t *testing.T) {
+	entry, archive, mediaList := newTestArchive()
+
+	document, err := ExportHTML(entry, archive, mediaList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		`<html lang="fr">`,
+		`<title>Article &lt;title&gt;</title>`,
+		`<a href="https://example.org/article">`,
+		`Feed – Author – 2024-01-02`,
+		`<img src="data:image/png;base64,cG5n"/>`,
+		`<img src="https://example.org/b.png"/>`,
+	} {
+		if !strings.Contains(string(document), expected) {
+			t.Errorf(`The document should contain %q, got %s`, expected, document)
+		}
+	}
+}
+
+func TestExportEPUB(t *testing.T) {
+	entry, archive, mediaList := newTestArchive()
+
+	var buffer bytes.Buffer
+	if err := ExportEPUB(&buffer, entry, archive, mediaList); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := make([]string, 0, len(reader.File))
+	for _, file := range reader.File {
+		names = append(names, file.Name)
+	}
+
+	if !strings.Contains(strings.Join(names, ","), "OEBPS/chapter-1.xhtml") || !strings.Contains(strings.Join(names, ","), "OEBPS/images/") {
+		t.Errorf(`Unexpected files in the book: %v`, names)
+	}
+}
@@ -34,6 +34,13 @@ func (a *atom03Adapter) buildFeed(baseURL string) *model.Feed {
 		}
 	}
 
+	// Populate the WebSub hub URL.
+	if hubURL := a.atomFeed.Links.firstLinkWithRelation("hub"); hubURL != "" {
+		if absoluteHubURL, err := urllib.ResolveToAbsoluteURL(baseURL, hubURL); err == nil {
+			feed.HubURL = absoluteHubURL
+		}
+	}
+
 	// Populate the site URL.
 	siteURL := a.atomFeed.Links.originalLink()
 	if siteURL != "" {
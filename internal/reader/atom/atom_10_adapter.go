@@ -35,6 +35,13 @@ func (a *atom10Adapter) buildFeed(baseURL string) *model.Feed {
 		}
 	}
 
+	// Populate the WebSub hub URL.
+	if hubURL := a.atomFeed.Links.firstLinkWithRelation("hub"); hubURL != "" {
+		if absoluteHubURL, err := urllib.ResolveToAbsoluteURL(baseURL, hubURL); err == nil {
+			feed.HubURL = absoluteHubURL
+		}
+	}
+
 	// Populate the site URL.
 	siteURL := a.atomFeed.Links.originalLink()
 	if siteURL != "" {
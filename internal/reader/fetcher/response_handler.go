@@ -95,6 +95,14 @@ func (r *ResponseHandler) ParseRetryDelay() time.Duration {
 	return 0
 }
 
+// StatusCode returns the HTTP status code of the response, or 0 when no response has been received.
+func (r *ResponseHandler) StatusCode() int {
+	if r.httpResponse == nil {
+		return 0
+	}
+	return r.httpResponse.StatusCode
+}
+
 func (r *ResponseHandler) IsRateLimited() bool {
 	return r.httpResponse != nil && r.httpResponse.StatusCode == http.StatusTooManyRequests
 }
@@ -124,6 +132,27 @@ func (r *ResponseHandler) IsRedirect() bool {
 			r.httpResponse.StatusCode == http.StatusPermanentRedirect)
 }
 
+// PermanentRedirectURL returns the effective URL when the request has been redirected
+// only with permanent redirects (301 or 308), and an empty string otherwise.
+func (r *ResponseHandler) PermanentRedirectURL() string {
+	if r.httpResponse == nil || r.httpResponse.Request == nil || r.httpResponse.Request.Response == nil {
+		return ""
+	}
+
+	for redirectResponse := r.httpResponse.Request.Response; redirectResponse != nil; {
+		if redirectResponse.StatusCode != http.StatusMovedPermanently && redirectResponse.StatusCode != http.StatusPermanentRedirect {
+			return ""
+		}
+
+		if redirectResponse.Request == nil {
+			break
+		}
+		redirectResponse = redirectResponse.Request.Response
+	}
+
+	return r.EffectiveURL()
+}
+
 func (r *ResponseHandler) Close() {
 	if r.httpResponse != nil && r.httpResponse.Body != nil {
 		r.httpResponse.Body.Close()
@@ -292,3 +292,49 @@ func TestResponseHandlerCloseClosesBodyOnClientError(t *testing.T) {
 		t.Error("Expected response body to be closed")
 	}
 }
+
+func TestResponseHandlerStatusCode(t *testing.T) {
+	rh := NewResponseHandler(&http.Response{StatusCode: http.StatusNotModified}, nil)
+	if rh.StatusCode() != http.StatusNotModified {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotModified, rh.StatusCode())
+	}
+
+	rh = NewResponseHandler(nil, errors.New("boom"))
+	if rh.StatusCode() != 0 {
+		t.Errorf("Expected status code 0 without response, got %d", rh.StatusCode())
+	}
+}
+
+func TestResponseHandlerPermanentRedirectURL(t *testing.T) {
+	newRedirectedResponse := func(statusCodes ...int) *http.Response {
+		originalRequest, _ := http.NewRequest(http.MethodGet, "https://example.org/feed", nil)
+		request := originalRequest
+		for i, statusCode := range statusCodes {
+			redirectResponse := &http.Response{StatusCode: statusCode, Request: request}
+			request, _ = http.NewRequest(http.MethodGet, "https://example.org/feed/"+string(rune('a'+i)), nil)
+			request.Response = redirectResponse
+		}
+		return &http.Response{StatusCode: http.StatusOK, Request: request}
+	}
+
+	var testCases = map[string]struct {
+		response    *http.Response
+		expectedURL string
+	}{
+		"No redirect":                 {newRedirectedResponse(), ""},
+		"Moved permanently":           {newRedirectedResponse(http.StatusMovedPermanently), "https://example.org/feed/a"},
+		"Permanent redirects chain":   {newRedirectedResponse(http.StatusMovedPermanently, http.StatusPermanentRedirect), "https://example.org/feed/b"},
+		"Temporary redirect":          {newRedirectedResponse(http.StatusFound), ""},
+		"Temporary redirect in chain": {newRedirectedResponse(http.StatusMovedPermanently, http.StatusTemporaryRedirect), ""},
+		"No response":                 {nil, ""},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rh := NewResponseHandler(tc.response, nil)
+			if url := rh.PermanentRedirectURL(); url != tc.expectedURL {
+				t.Errorf("Expected %q, got %q", tc.expectedURL, url)
+			}
+		})
+	}
+}
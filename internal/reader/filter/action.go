@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"log/slog"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// ActionRule is an action rule with its compiled expression.
+type ActionRule struct {
+	rule       *model.ActionRule
+	expression *Expression
+}
+
+// ParseActionRules compiles the expression of each action rule.
+// Rules with an invalid expression are logged and ignored.
+func ParseActionRules(rules model.ActionRules) []*ActionRule {
+	actionRules := make([]*ActionRule, 0, len(rules))
+	for _, rule := range rules {
+		expression, err := ParseExpression(rule.Expression)
+		if err != nil {
+			slog.Warn("Ignoring action rule with an invalid expression",
+				slog.Int64("user_id", rule.UserID),
+				slog.Int64("action_rule_id", rule.ID),
+				slog.String("expression", rule.Expression),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		if expression != nil {
+			actionRules = append(actionRules, &ActionRule{rule: rule, expression: expression})
+		}
+	}
+	return actionRules
+}
+
+// ApplyActionRules applies the action of every rule matching the entry.
+// When several rules set a priority, the last matching rule wins.
+func ApplyActionRules(rules []*ActionRule, feed *model.Feed, entry *model.Entry) {
+	for _, actionRule := range rules {
+		if !actionRule.expression.Match(feed, entry) {
+			continue
+		}
+
+		slog.Debug("Entry matches action rule",
+			slog.String("entry_url", entry.URL),
+			slog.String("entry_title", entry.Title),
+			slog.Int64("action_rule_id", actionRule.rule.ID),
+			slog.String("action", actionRule.rule.Action),
+		)
+
+		switch actionRule.rule.Action {
+		case model.ActionRuleMarkAsRead:
+			entry.Status = model.EntryStatusRead
+		case model.ActionRuleStar:
+			entry.Starred = true
+		case model.ActionRuleAddLabel:
+			if !entryHasLabel(entry, actionRule.rule.LabelID) {
+				entry.Labels = append(entry.Labels, &model.Label{ID: actionRule.rule.LabelID, UserID: actionRule.rule.UserID})
+			}
+		case model.ActionRuleSendEntry:
+			entry.SendToIntegrations = true
+		case model.ActionRuleSetPriority:
+			entry.Priority = actionRule.rule.Priority
+		}
+	}
+}
+
+func entryHasLabel(entry *model.Entry, labelID int64) bool {
+	for _, label := range entry.Labels {
+		if label.ID == labelID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestParseActionRulesSkipsInvalidExpressions(t *testing.T) {
+	rules := ParseActionRules(model.ActionRules{
+		{ID: 1, Expression: `title ~ "release"`, Action: model.ActionRuleStar},
+		{ID: 2, Expression: `title ~`, Action: model.ActionRuleStar},
+		{ID: 3, Expression: ``, Action: model.ActionRuleStar},
+	})
+
+	if len(rules) != 1 {
+		t.Fatalf("Expected 1 action rule, got %d", len(rules))
+	}
+}
+
+func TestApplyActionRules(t *testing.T) {
+	feed := createTestFeed()
+	entry := createTestEntry()
+
+	rules := ParseActionRules(model.ActionRules{
+		{ID: 1, Expression: `title ~ "Entry"`, Action: model.ActionRuleMarkAsRead},
+		{ID: 2, Expression: `tag == "golang"`, Action: model.ActionRuleStar},
+		{ID: 3, Expression: `tag == "golang"`, Action: model.ActionRuleAddLabel, LabelID: 42},
+		{ID: 4, Expression: `tag == "testing"`, Action: model.ActionRuleAddLabel, LabelID: 42},
+		{ID: 5, Expression: `author == "Test Author"`, Action: model.ActionRuleSendEntry},
+		{ID: 6, Expression: `title ~ "Entry"`, Action: model.ActionRuleSetPriority, Priority: 5},
+		{ID: 7, Expression: `title ~ "Entry"`, Action: model.ActionRuleSetPriority, Priority: -1},
+	})
+
+	ApplyActionRules(rules, feed, entry)
+
+	if entry.Status != model.EntryStatusRead {
+		t.Errorf("Expected the entry to be marked as read, got %q", entry.Status)
+	}
+
+	if !entry.Starred {
+		t.Error("Expected the entry to be starred")
+	}
+
+	if len(entry.Labels) != 1 || entry.Labels[0].ID != 42 {
+		t.Errorf("Expected the label 42 to be added once, got %v", entry.Labels.IDs())
+	}
+
+	if !entry.SendToIntegrations {
+		t.Error("Expected the entry to be sent to integrations")
+	}
+
+	if entry.Priority != -1 {
+		t.Errorf("Expected the last matching priority to win, got %d", entry.Priority)
+	}
+}
+
+func TestApplyActionRulesWithoutMatch(t *testing.T) {
+	feed := createTestFeed()
+	entry := createTestEntry()
+
+	rules := ParseActionRules(model.ActionRules{
+		{ID: 1, Expression: `author == "bot"`, Action: model.ActionRuleMarkAsRead},
+		{ID: 2, Expression: `tag == "rust"`, Action: model.ActionRuleStar},
+	})
+
+	ApplyActionRules(rules, feed, entry)
+
+	if entry.Status != "" || entry.Starred {
+		t.Errorf("The entry should not be modified, got status=%q starred=%v", entry.Status, entry.Starred)
+	}
+}
@@ -0,0 +1,477 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// Filter expressions are boolean conditions evaluated against each entry, for example:
+//
+//	title ~ "(?i)release" and not author == "bot" and age < 7d
+//
+// Comparisons:
+//
+//   - title, url, comments_url, content, author, feed, category: == and != (case-insensitive), ~ and !~ (RE2 regex)
+//   - tag: same operators, true when any tag matches (!= and !~ are true when no tag matches)
+//   - age: <, <=, > and >= against a duration such as 30m, 12h, 7d or 2w
+//
+// Comparisons can be combined with "and", "or", "not" and parentheses.
+// "not" binds tighter than "and", which binds tighter than "or".
+
+// ExpressionError describes why a filter expression is invalid.
+type ExpressionError struct {
+	// Position is the 1-based character offset where the error was detected.
+	Position int
+	Message  string
+}
+
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Position, e.Message)
+}
+
+// Expression is a parsed filter expression.
+type Expression struct {
+	source string
+	root   expressionNode
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Match returns true if the entry satisfies the expression.
+func (e *Expression) Match(feed *model.Feed, entry *model.Entry) bool {
+	return e.root.eval(feed, entry)
+}
+
+// ParseExpression parses a filter expression.
+// An empty or blank expression returns a nil expression without error.
+func ParseExpression(source string) (*Expression, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, nil
+	}
+
+	tokens, err := tokenizeExpression(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &expressionParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := p.peek(); token.kind != tokenEOF {
+		return nil, &ExpressionError{Position: token.position, Message: fmt.Sprintf("unexpected %s", token)}
+	}
+
+	return &Expression{source: source, root: root}, nil
+}
+
+// ParseExpressions parses the filter expressions defined at different levels
+// (user, category, feed). Empty and invalid expressions are skipped.
+func ParseExpressions(sources ...string) []*Expression {
+	expressions := make([]*Expression, 0, len(sources))
+	for _, source := range sources {
+		expression, err := ParseExpression(source)
+		if err != nil {
+			slog.Warn("Ignoring invalid filter expression",
+				slog.String("expression", source),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		if expression != nil {
+			expressions = append(expressions, expression)
+		}
+	}
+	return expressions
+}
+
+// MatchesAnyExpression returns true if the entry satisfies at least one expression.
+func MatchesAnyExpression(expressions []*Expression, feed *model.Feed, entry *model.Entry) bool {
+	return MatchingExpression(expressions, feed, entry) != nil
+}
+
+// MatchingExpression returns the first expression satisfied by the entry, or nil.
+func MatchingExpression(expressions []*Expression, feed *model.Feed, entry *model.Entry) *Expression {
+	for _, expression := range expressions {
+		if expression.Match(feed, entry) {
+			slog.Debug("Entry matches filter expression",
+				slog.String("entry_url", entry.URL),
+				slog.String("entry_title", entry.Title),
+				slog.String("feed_url", feed.FeedURL),
+				slog.String("expression", expression.source),
+			)
+			return expression
+		}
+	}
+	return nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenDuration
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+)
+
+type expressionToken struct {
+	kind     tokenKind
+	value    string
+	position int
+}
+
+func (t expressionToken) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return strconv.Quote(t.value)
+	default:
+		return fmt.Sprintf("%q", t.value)
+	}
+}
+
+func tokenizeExpression(source string) ([]expressionToken, error) {
+	var tokens []expressionToken
+	runes := []rune(source)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		position := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, expressionToken{kind: tokenLeftParen, value: "(", position: position})
+			i++
+		case r == ')':
+			tokens = append(tokens, expressionToken{kind: tokenRightParen, value: ")", position: position})
+			i++
+		case r == '"':
+			var value strings.Builder
+			closed := false
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+					i++
+					value.WriteRune(runes[i])
+					continue
+				}
+				if runes[i] == '"' {
+					closed = true
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+			}
+			if !closed {
+				return nil, &ExpressionError{Position: position, Message: "unterminated string"}
+			}
+			tokens = append(tokens, expressionToken{kind: tokenString, value: value.String(), position: position})
+		case strings.ContainsRune("=!~<>", r):
+			operator := string(r)
+			if i+1 < len(runes) && (runes[i+1] == '=' || (r == '!' && runes[i+1] == '~')) {
+				operator += string(runes[i+1])
+			}
+			switch operator {
+			case "==", "!=", "~", "!~", "<", "<=", ">", ">=":
+			default:
+				return nil, &ExpressionError{Position: position, Message: fmt.Sprintf("unknown operator %q", operator)}
+			}
+			tokens = append(tokens, expressionToken{kind: tokenOperator, value: operator, position: position})
+			i += len([]rune(operator))
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || unicode.IsLetter(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, expressionToken{kind: tokenDuration, value: string(runes[start:i]), position: position})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, expressionToken{kind: tokenIdentifier, value: string(runes[start:i]), position: position})
+		default:
+			return nil, &ExpressionError{Position: position, Message: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+
+	return append(tokens, expressionToken{kind: tokenEOF, position: len(runes) + 1}), nil
+}
+
+type expressionParser struct {
+	tokens  []expressionToken
+	current int
+}
+
+func (p *expressionParser) peek() expressionToken {
+	return p.tokens[p.current]
+}
+
+func (p *expressionParser) next() expressionToken {
+	token := p.tokens[p.current]
+	if token.kind != tokenEOF {
+		p.current++
+	}
+	return token
+}
+
+func (p *expressionParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == tokenIdentifier && strings.EqualFold(token.value, keyword)
+}
+
+func (p *expressionParser) parseOr() (expressionNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *expressionParser) parseAnd() (expressionNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("and") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *expressionParser) parseNot() (expressionNode, error) {
+	if p.isKeyword("not") {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *expressionParser) parsePrimary() (expressionNode, error) {
+	token := p.next()
+
+	switch token.kind {
+	case tokenLeftParen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRightParen {
+			return nil, &ExpressionError{Position: closing.position, Message: fmt.Sprintf("expected \")\", got %s", closing)}
+		}
+		return node, nil
+	case tokenIdentifier:
+		return p.parseComparison(token)
+	default:
+		return nil, &ExpressionError{Position: token.position, Message: fmt.Sprintf("expected a field name, got %s", token)}
+	}
+}
+
+func (p *expressionParser) parseComparison(field expressionToken) (expressionNode, error) {
+	fieldName := strings.ToLower(field.value)
+
+	operator := p.next()
+	if operator.kind != tokenOperator {
+		return nil, &ExpressionError{Position: operator.position, Message: fmt.Sprintf("expected an operator after %q, got %s", field.value, operator)}
+	}
+
+	value := p.next()
+
+	switch fieldName {
+	case "title", "url", "comments_url", "content", "author", "feed", "category", "tag":
+		if operator.value != "==" && operator.value != "!=" && operator.value != "~" && operator.value != "!~" {
+			return nil, &ExpressionError{Position: operator.position, Message: fmt.Sprintf("operator %q cannot be used with %q", operator.value, fieldName)}
+		}
+
+		if value.kind != tokenString {
+			return nil, &ExpressionError{Position: value.position, Message: fmt.Sprintf("expected a quoted string, got %s", value)}
+		}
+
+		node := &textComparisonNode{field: fieldName, operator: operator.value, value: value.value}
+		if operator.value == "~" || operator.value == "!~" {
+			re, err := regexp.Compile(value.value)
+			if err != nil {
+				return nil, &ExpressionError{Position: value.position, Message: fmt.Sprintf("invalid regular expression: %v", err)}
+			}
+			node.re = re
+		}
+		return node, nil
+	case "age":
+		if operator.value != "<" && operator.value != "<=" && operator.value != ">" && operator.value != ">=" {
+			return nil, &ExpressionError{Position: operator.position, Message: fmt.Sprintf("operator %q cannot be used with %q", operator.value, fieldName)}
+		}
+
+		if value.kind != tokenDuration {
+			return nil, &ExpressionError{Position: value.position, Message: fmt.Sprintf("expected a duration such as 12h or 7d, got %s", value)}
+		}
+
+		duration, err := parseExpressionDuration(value.value)
+		if err != nil {
+			return nil, &ExpressionError{Position: value.position, Message: err.Error()}
+		}
+		return &ageComparisonNode{operator: operator.value, duration: duration}, nil
+	}
+
+	return nil, &ExpressionError{Position: field.position, Message: fmt.Sprintf("unknown field %q", field.value)}
+}
+
+func parseExpressionDuration(value string) (time.Duration, error) {
+	units := map[string]time.Duration{
+		"s": time.Second,
+		"m": time.Minute,
+		"h": time.Hour,
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
+
+	digits := strings.TrimRightFunc(value, unicode.IsLetter)
+	unit, found := units[value[len(digits):]]
+	if !found {
+		return 0, fmt.Errorf("invalid duration %q, the unit must be one of s, m, h, d or w", value)
+	}
+
+	number, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	return time.Duration(number) * unit, nil
+}
+
+type expressionNode interface {
+	eval(feed *model.Feed, entry *model.Entry) bool
+}
+
+type andNode struct {
+	left, right expressionNode
+}
+
+func (n *andNode) eval(feed *model.Feed, entry *model.Entry) bool {
+	return n.left.eval(feed, entry) && n.right.eval(feed, entry)
+}
+
+type orNode struct {
+	left, right expressionNode
+}
+
+func (n *orNode) eval(feed *model.Feed, entry *model.Entry) bool {
+	return n.left.eval(feed, entry) || n.right.eval(feed, entry)
+}
+
+type notNode struct {
+	operand expressionNode
+}
+
+func (n *notNode) eval(feed *model.Feed, entry *model.Entry) bool {
+	return !n.operand.eval(feed, entry)
+}
+
+type textComparisonNode struct {
+	field    string
+	operator string
+	value    string
+	re       *regexp.Regexp
+}
+
+func (n *textComparisonNode) eval(feed *model.Feed, entry *model.Entry) bool {
+	var values []string
+	switch n.field {
+	case "title":
+		values = []string{entry.Title}
+	case "url":
+		values = []string{entry.URL}
+	case "comments_url":
+		values = []string{entry.CommentsURL}
+	case "content":
+		values = []string{entry.Content}
+	case "author":
+		values = []string{entry.Author}
+	case "feed":
+		values = []string{feed.Title}
+	case "category":
+		if feed.Category != nil {
+			values = []string{feed.Category.Title}
+		}
+	case "tag":
+		values = entry.Tags
+	}
+
+	switch n.operator {
+	case "==":
+		return slices.ContainsFunc(values, func(value string) bool { return strings.EqualFold(value, n.value) })
+	case "!=":
+		return !slices.ContainsFunc(values, func(value string) bool { return strings.EqualFold(value, n.value) })
+	case "~":
+		return slices.ContainsFunc(values, n.re.MatchString)
+	case "!~":
+		return !slices.ContainsFunc(values, n.re.MatchString)
+	}
+
+	return false
+}
+
+type ageComparisonNode struct {
+	operator string
+	duration time.Duration
+}
+
+func (n *ageComparisonNode) eval(feed *model.Feed, entry *model.Entry) bool {
+	age := time.Since(entry.Date)
+
+	switch n.operator {
+	case "<":
+		return age < n.duration
+	case "<=":
+		return age <= n.duration
+	case ">":
+		return age > n.duration
+	case ">=":
+		return age >= n.duration
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestExpressionMatch(t *testing.T) {
+	feed := createTestFeed()
+	feed.Title = "Example Blog"
+	feed.Category = &model.Category{Title: "Programming"}
+
+	entry := createTestEntry()
+	entry.Date = time.Now().Add(-48 * time.Hour)
+
+	tests := []struct {
+		expression string
+		expected   bool
+	}{
+		{`title ~ "Entry"`, true},
+		{`title ~ "(?i)entry title$"`, true},
+		{`title !~ "Entry"`, false},
+		{`title == "test entry title"`, true},
+		{`title != "test entry title"`, false},
+		{`url ~ "^https://example\\.com/"`, true},
+		{`comments_url ~ "/comments$"`, true},
+		{`content ~ "test entry content"`, true},
+		{`author == "bot"`, false},
+		{`feed == "Example Blog"`, true},
+		{`category == "programming"`, true},
+		{`tag == "golang"`, true},
+		{`tag ~ "^mini"`, true},
+		{`tag != "golang"`, false},
+		{`tag !~ "^rust"`, true},
+		{`age < 7d`, true},
+		{`age > 1d`, true},
+		{`age >= 3d`, false},
+		{`age <= 49h`, true},
+		{`age < 1w`, true},
+		{`title ~ "Entry" and not author == "bot" and age < 7d`, true},
+		{`title ~ "rust" or tag == "golang"`, true},
+		{`title ~ "rust" or tag == "golang" and author == "bot"`, false},
+		{`(title ~ "rust" or tag == "golang") and author == "Test Author"`, true},
+		{`not not title ~ "Entry"`, true},
+		{`TITLE ~ "Entry" AND NOT age > 7d`, true},
+		{`title == "say \"hi\""`, false},
+	}
+
+	for _, test := range tests {
+		expression, err := ParseExpression(test.expression)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", test.expression, err)
+			continue
+		}
+
+		if result := expression.Match(feed, entry); result != test.expected {
+			t.Errorf("Expression %q: expected %v, got %v", test.expression, test.expected, result)
+		}
+	}
+}
+
+func TestParseExpressionWithEmptyInput(t *testing.T) {
+	for _, input := range []string{"", "  ", "\n"} {
+		expression, err := ParseExpression(input)
+		if err != nil || expression != nil {
+			t.Errorf("Expected a nil expression without error for %q, got %v, %v", input, expression, err)
+		}
+	}
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	tests := []struct {
+		expression       string
+		expectedPosition int
+	}{
+		{`title`, 6},
+		{`title ~`, 8},
+		{`title = "rust"`, 7},
+		{`title < "rust"`, 7},
+		{`title ~ rust`, 9},
+		{`title ~ "rust`, 9},
+		{`title ~ "[a-"`, 9},
+		{`subject ~ "rust"`, 1},
+		{`age < "7d"`, 7},
+		{`age < 7y`, 7},
+		{`age == 7d`, 5},
+		{`title ~ "rust" and`, 19},
+		{`title ~ "rust" author == "bot"`, 16},
+		{`(title ~ "rust"`, 16},
+		{`title ~ "rust")`, 15},
+		{`title ~ "rust" & author == "bot"`, 16},
+	}
+
+	for _, test := range tests {
+		_, err := ParseExpression(test.expression)
+		if err == nil {
+			t.Errorf("Expected an error for %q", test.expression)
+			continue
+		}
+
+		var expressionErr *ExpressionError
+		if !errors.As(err, &expressionErr) {
+			t.Errorf("Expected an ExpressionError for %q, got %T", test.expression, err)
+			continue
+		}
+
+		if expressionErr.Position != test.expectedPosition {
+			t.Errorf("Expression %q: expected error at position %d, got %d (%v)", test.expression, test.expectedPosition, expressionErr.Position, err)
+		}
+	}
+}
+
+func TestParseExpressionsSkipsInvalidExpressions(t *testing.T) {
+	expressions := ParseExpressions(`title ~ "rust"`, "", `title ~`, `author == "bot"`)
+	if len(expressions) != 2 {
+		t.Fatalf("Expected 2 expressions, got %d", len(expressions))
+	}
+}
+
+func TestMatchesAnyExpression(t *testing.T) {
+	feed := createTestFeed()
+	entry := createTestEntry()
+
+	if MatchesAnyExpression(nil, feed, entry) {
+		t.Error("No expressions should not match")
+	}
+
+	if !MatchesAnyExpression(ParseExpressions(`author == "bot"`, `tag == "golang"`), feed, entry) {
+		t.Error("The second expression should match")
+	}
+
+	if MatchesAnyExpression(ParseExpressions(`author == "bot"`, `category == "news"`), feed, entry) {
+		t.Error("No expression should match")
+	}
+}
@@ -20,6 +20,9 @@
 // The provided regex should use the RE2 syntax.
 // The order of the rules matters as the processor stops on the first match for both Block and Keep rules.
 // Invalid rules are ignored.
+//
+// Block filter expressions can also be defined at the user, category and feed levels,
+// see ParseExpression for the syntax. An entry matching any of them is ignored.
 
 package filter // import "miniflux.app/v2/internal/reader/filter"
 
@@ -98,32 +101,53 @@ func parseRule(userDefinedRule string) (bool, filterRule) {
 	}
 }
 
+func (r filterRule) String() string {
+	return r.Type + "=" + r.Value
+}
+
+// Verdict describes the decision taken for an entry and the rule responsible for it.
+// MatchedRule is empty when the entry is blocked because it doesn't match any keep rule,
+// or when the entry is kept because no rule applies.
+type Verdict struct {
+	Blocked     bool
+	MatchedRule string
+}
+
 func IsBlockedEntry(blockRules filterRules, allowRules filterRules, feed *model.Feed, entry *model.Entry) bool {
-	if matchesEntryFilterRules(blockRules, feed, entry) {
-		return true
+	return EvaluateEntry(blockRules, allowRules, feed, entry).Blocked
+}
+
+// EvaluateEntry applies the same logic as IsBlockedEntry and reports which rule matched.
+func EvaluateEntry(blockRules filterRules, allowRules filterRules, feed *model.Feed, entry *model.Entry) Verdict {
+	if rule, matches := matchingEntryFilterRule(blockRules, feed, entry); matches {
+		return Verdict{Blocked: true, MatchedRule: rule.String()}
 	}
 
 	if matches, valid := matchesEntryRegexRules(feed.BlocklistRules, feed, entry); valid && matches {
-		return true
+		return Verdict{Blocked: true, MatchedRule: feed.BlocklistRules}
 	}
 
 	// If allow rules exist, only entries that match them should be retained
 	if len(allowRules) > 0 {
-		if !matchesEntryFilterRules(allowRules, feed, entry) {
-			return true // Block entry if it doesn't match any allow rules
+		if rule, matches := matchingEntryFilterRule(allowRules, feed, entry); matches {
+			return Verdict{MatchedRule: rule.String()} // Allow entry if it matches allow rules
 		}
-		return false // Allow entry if it matches allow rules
+		return Verdict{Blocked: true} // Block entry if it doesn't match any allow rules
 	}
 
 	// If keeplist rules exist, only entries that match them should be retained
 	if feed.KeeplistRules != "" {
-		if matches, valid := matchesEntryRegexRules(feed.KeeplistRules, feed, entry); valid && !matches {
-			return true // Block entry if it doesn't match keeplist rules
+		matches, valid := matchesEntryRegexRules(feed.KeeplistRules, feed, entry)
+		if valid && !matches {
+			return Verdict{Blocked: true} // Block entry if it doesn't match keeplist rules
+		}
+		if valid {
+			return Verdict{MatchedRule: feed.KeeplistRules} // Allow entry if it matches keeplist rules
 		}
-		return false // Allow entry if it matches keeplist rules or rule is invalid (ignored)
+		return Verdict{} // Allow entry if the keeplist rule is invalid (ignored)
 	}
 
-	return false
+	return Verdict{}
 }
 
 // matchesEntryRegexRules checks if the entry matches the regex rules defined in the feed or user settings.
@@ -160,6 +184,11 @@ func matchesEntryRegexRules(regexPattern string, feed *model.Feed, entry *model.
 }
 
 func matchesEntryFilterRules(rules filterRules, feed *model.Feed, entry *model.Entry) bool {
+	_, matches := matchingEntryFilterRule(rules, feed, entry)
+	return matches
+}
+
+func matchingEntryFilterRule(rules filterRules, feed *model.Feed, entry *model.Entry) (filterRule, bool) {
 	for _, rule := range rules {
 		if matchesRule(rule, entry) {
 			slog.Debug("Entry matches filter rule",
@@ -170,10 +199,10 @@ func matchesEntryFilterRules(rules filterRules, feed *model.Feed, entry *model.E
 				slog.String("rule_type", rule.Type),
 				slog.String("rule_value", rule.Value),
 			)
-			return true
+			return rule, true
 		}
 	}
-	return false
+	return filterRule{}, false
 }
 
 func matchesRule(rule filterRule, entry *model.Entry) bool {
@@ -1417,3 +1417,63 @@ func BenchmarkParseDuration(b *testing.B) {
 		parseDuration("30d")
 	}
 }
+
+func TestEvaluateEntry(t *testing.T) {
+	entry := createTestEntry()
+
+	tests := []struct {
+		name       string
+		blockRules filterRules
+		allowRules filterRules
+		blocklist  string
+		keeplist   string
+		expected   Verdict
+	}{
+		{
+			name:     "no rules",
+			expected: Verdict{},
+		},
+		{
+			name:       "block rule matches",
+			blockRules: filterRules{{Type: "EntryAuthor", Value: "Nobody"}, {Type: "EntryTitle", Value: "Test"}},
+			expected:   Verdict{Blocked: true, MatchedRule: "EntryTitle=Test"},
+		},
+		{
+			name:      "blocklist matches",
+			blocklist: "(?i)test",
+			expected:  Verdict{Blocked: true, MatchedRule: "(?i)test"},
+		},
+		{
+			name:       "allow rule matches",
+			allowRules: filterRules{{Type: "EntryTag", Value: "golang"}},
+			expected:   Verdict{MatchedRule: "EntryTag=golang"},
+		},
+		{
+			name:       "no allow rule matches",
+			allowRules: filterRules{{Type: "EntryTag", Value: "rust"}},
+			expected:   Verdict{Blocked: true},
+		},
+		{
+			name:     "keeplist matches",
+			keeplist: "Test",
+			expected: Verdict{MatchedRule: "Test"},
+		},
+		{
+			name:     "keeplist doesn't match",
+			keeplist: "Rust",
+			expected: Verdict{Blocked: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := createTestFeed()
+			feed.BlocklistRules = tt.blocklist
+			feed.KeeplistRules = tt.keeplist
+
+			if result := EvaluateEntry(tt.blockRules, tt.allowRules, feed, entry); result != tt.expected {
+				t.Errorf("EvaluateEntry() = %+v, expected %+v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -5,19 +5,23 @@ package handler // import "miniflux.app/v2/internal/reader/handler"
 
 import (
 	"bytes"
+	"cmp"
 	"errors"
 	"log/slog"
+	"strings"
 	"time"
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/integration"
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/mediaproxy"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/proxyrotator"
 	"miniflux.app/v2/internal/reader/fetcher"
 	"miniflux.app/v2/internal/reader/icon"
 	"miniflux.app/v2/internal/reader/parser"
 	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/reader/rewrite"
 	"miniflux.app/v2/internal/storage"
 )
 
@@ -44,11 +48,12 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 		slog.String("proxy_url", feedCreationRequest.ProxyURL),
 	)
 
-	categoryExists, storeErr := store.CategoryIDExists(userID, feedCreationRequest.CategoryID)
+	// The category is loaded to apply its feed defaults to the new subscription.
+	category, storeErr := store.Category(userID, feedCreationRequest.CategoryID)
 	if storeErr != nil {
 		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
 	}
-	if !categoryExists {
+	if category == nil {
 		return nil, locale.NewLocalizedErrorWrapper(ErrCategoryNotFound, "error.category_not_found")
 	}
 
@@ -79,11 +84,12 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 	subscription.UrlRewriteRules = feedCreationRequest.UrlRewriteRules
 	subscription.BlockFilterEntryRules = feedCreationRequest.BlockFilterEntryRules
 	subscription.KeepFilterEntryRules = feedCreationRequest.KeepFilterEntryRules
+	subscription.BlockFilterExpression = feedCreationRequest.BlockFilterExpression
 	subscription.EtagHeader = feedCreationRequest.ETag
 	subscription.LastModifiedHeader = feedCreationRequest.LastModified
 	subscription.FeedURL = feedCreationRequest.FeedURL
 	subscription.DisableHTTP2 = feedCreationRequest.DisableHTTP2
-	subscription.WithCategoryID(feedCreationRequest.CategoryID)
+	subscription.Category = category
 	subscription.ProxyURL = feedCreationRequest.ProxyURL
 	subscription.CheckedNow()
 
@@ -112,25 +118,27 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 		slog.String("proxy_url", feedCreationRequest.ProxyURL),
 	)
 
-	categoryExists, storeErr := store.CategoryIDExists(userID, feedCreationRequest.CategoryID)
+	// The category is loaded to apply its feed defaults to the new subscription.
+	category, storeErr := store.Category(userID, feedCreationRequest.CategoryID)
 	if storeErr != nil {
 		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
 	}
-	if !categoryExists {
+	if category == nil {
 		return nil, locale.NewLocalizedErrorWrapper(ErrCategoryNotFound, "error.category_not_found")
 	}
 
+	feedDefaults := category.FeedDefaults
 	requestBuilder := fetcher.NewRequestBuilder().
 		WithUsernameAndPassword(feedCreationRequest.Username, feedCreationRequest.Password).
-		WithUserAgent(feedCreationRequest.UserAgent, config.Opts.HTTPClientUserAgent()).
-		WithCookie(feedCreationRequest.Cookie).
+		WithUserAgent(cmp.Or(feedCreationRequest.UserAgent, feedDefaults.UserAgent), config.Opts.HTTPClientUserAgent()).
+		WithCookie(cmp.Or(feedCreationRequest.Cookie, feedDefaults.Cookie)).
 		WithTimeout(config.Opts.HTTPClientTimeout()).
 		WithProxyRotator(proxyrotator.ProxyRotatorInstance).
-		WithCustomFeedProxyURL(feedCreationRequest.ProxyURL).
+		WithCustomFeedProxyURL(cmp.Or(feedCreationRequest.ProxyURL, feedDefaults.ProxyURL)).
 		WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL()).
-		UseCustomApplicationProxyURL(feedCreationRequest.FetchViaProxy).
-		IgnoreTLSErrors(feedCreationRequest.AllowSelfSignedCertificates).
-		DisableHTTP2(feedCreationRequest.DisableHTTP2)
+		UseCustomApplicationProxyURL(feedCreationRequest.FetchViaProxy || feedDefaults.FetchViaProxy).
+		IgnoreTLSErrors(feedCreationRequest.AllowSelfSignedCertificates || feedDefaults.AllowSelfSignedCertificates).
+		DisableHTTP2(feedCreationRequest.DisableHTTP2 || feedDefaults.DisableHTTP2)
 
 	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(feedCreationRequest.FeedURL))
 	defer responseHandler.Close()
@@ -174,13 +182,14 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 	subscription.KeeplistRules = feedCreationRequest.KeeplistRules
 	subscription.BlockFilterEntryRules = feedCreationRequest.BlockFilterEntryRules
 	subscription.KeepFilterEntryRules = feedCreationRequest.KeepFilterEntryRules
+	subscription.BlockFilterExpression = feedCreationRequest.BlockFilterExpression
 	subscription.HideGlobally = feedCreationRequest.HideGlobally
 	subscription.NoMediaPlayer = feedCreationRequest.NoMediaPlayer
 	subscription.EtagHeader = responseHandler.ETag()
 	subscription.LastModifiedHeader = responseHandler.LastModified()
 	subscription.FeedURL = responseHandler.EffectiveURL()
 	subscription.ProxyURL = feedCreationRequest.ProxyURL
-	subscription.WithCategoryID(feedCreationRequest.CategoryID)
+	subscription.Category = category
 	subscription.CheckedNow()
 
 	processor.ProcessFeedEntries(store, subscription, userID, true)
@@ -200,8 +209,97 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 	return subscription, nil
 }
 
-// RefreshFeed refreshes a feed.
+// RefreshFeed refreshes a feed and records the attempt in the feed refresh history.
 func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool) *locale.LocalizedErrorWrapper {
+	return recordFeedRefresh(store, userID, feedID, forceRefresh, nil)
+}
+
+func recordFeedRefresh(store *storage.Storage, userID, feedID int64, forceRefresh bool, group *FetchGroup) *locale.LocalizedErrorWrapper {
+	refresh := &model.FeedRefresh{}
+	startTime := time.Now()
+
+	localizedError := refreshFeed(store, userID, feedID, forceRefresh, group, refresh)
+
+	// Attempts are not recorded when the feed does not exist or cannot be loaded.
+	if refresh.FeedID == 0 {
+		return localizedError
+	}
+
+	refresh.Duration = time.Since(startTime).Milliseconds()
+	if localizedError != nil {
+		if err := localizedError.Error(); err != nil {
+			refresh.ErrorMessage = err.Error()
+		}
+	}
+
+	if err := store.CreateFeedRefresh(refresh, config.Opts.FeedRefreshHistorySize()); err != nil {
+		slog.Error("Unable to record feed refresh",
+			slog.Int64("user_id", userID),
+			slog.Int64("feed_id", feedID),
+			slog.Any("error", err),
+		)
+	}
+
+	return localizedError
+}
+
+// ProcessPushedFeed stores the entries of the feed content pushed by a WebSub hub, like a refresh of the feed.
+func ProcessPushedFeed(store *storage.Storage, userID, feedID int64, body []byte) error {
+	originalFeed, err := store.FeedByID(userID, feedID)
+	if err != nil {
+		return err
+	}
+
+	if originalFeed == nil {
+		return ErrFeedNotFound
+	}
+
+	if originalFeed.Disabled {
+		return nil
+	}
+
+	pushedFeed, err := parser.ParseFeed(originalFeed.FeedURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	effectiveFeed := originalFeed.WithCategoryDefaults()
+	originalFeed.Entries = pushedFeed.Entries
+	processor.ProcessFeedEntries(store, originalFeed, userID, false)
+
+	updateExistingEntries := !effectiveFeed.Crawler && !effectiveFeed.IgnoreEntryUpdates
+	newEntries, updatedEntries, err := store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, updateExistingEntries, config.Opts.EntryRevisionHistorySize())
+	if err != nil {
+		return err
+	}
+
+	slog.Debug("Processed feed content pushed by the WebSub hub",
+		slog.Int64("user_id", userID),
+		slog.Int64("feed_id", feedID),
+		slog.Int("new_entries", len(newEntries)),
+		slog.Int("updated_entries", updatedEntries),
+	)
+
+	if config.Opts.MediaProxyCachePrefetch() {
+		prefetchProxifiedImages(newEntries)
+	}
+
+	userIntegrations, err := store.Integration(userID)
+	if err != nil {
+		slog.Error("Fetching integrations failed; no integrations will run for the pushed entries",
+			slog.Int64("user_id", userID),
+			slog.Int64("feed_id", feedID),
+			slog.Any("error", err),
+		)
+	} else if userIntegrations != nil && len(newEntries) > 0 {
+		go integration.PushEntries(effectiveFeed, newEntries, userIntegrations)
+		go integration.SendFlaggedEntries(newEntries, userIntegrations)
+	}
+
+	return nil
+}
+
+func refreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool, group *FetchGroup, refresh *model.FeedRefresh) *locale.LocalizedErrorWrapper {
 	slog.Debug("Begin feed refresh process",
 		slog.Int64("user_id", userID),
 		slog.Int64("feed_id", feedID),
@@ -217,6 +315,8 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		return locale.NewLocalizedErrorWrapper(ErrFeedNotFound, "error.feed_not_found")
 	}
 
+	refresh.FeedID = originalFeed.ID
+
 	weeklyEntryCount := 0
 	if config.Opts.PollingScheduler() == model.SchedulerEntryFrequency {
 		var weeklyCountErr error
@@ -229,30 +329,65 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 	originalFeed.CheckedNow()
 	originalFeed.ScheduleNextCheck(weeklyEntryCount, time.Duration(0))
 
+	// The settings inherited from the category are only used to refresh the feed,
+	// the original feed is the one saved at the end of the process.
+	effectiveFeed := originalFeed.WithCategoryDefaults()
+
 	requestBuilder := fetcher.NewRequestBuilder().
 		WithUsernameAndPassword(originalFeed.Username, originalFeed.Password).
-		WithUserAgent(originalFeed.UserAgent, config.Opts.HTTPClientUserAgent()).
-		WithCookie(originalFeed.Cookie).
+		WithUserAgent(effectiveFeed.UserAgent, config.Opts.HTTPClientUserAgent()).
+		WithCookie(effectiveFeed.Cookie).
 		WithTimeout(config.Opts.HTTPClientTimeout()).
 		WithProxyRotator(proxyrotator.ProxyRotatorInstance).
-		WithCustomFeedProxyURL(originalFeed.ProxyURL).
+		WithCustomFeedProxyURL(effectiveFeed.ProxyURL).
 		WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL()).
-		UseCustomApplicationProxyURL(originalFeed.FetchViaProxy).
-		IgnoreTLSErrors(originalFeed.AllowSelfSignedCertificates).
-		DisableHTTP2(originalFeed.DisableHTTP2)
+		UseCustomApplicationProxyURL(effectiveFeed.FetchViaProxy).
+		IgnoreTLSErrors(effectiveFeed.AllowSelfSignedCertificates).
+		DisableHTTP2(effectiveFeed.DisableHTTP2)
+
+	// The new location declared by the feed is checked without the conditional headers.
+	movedFeedRequestBuilder := requestBuilder.Clone()
 
-	ignoreHTTPCache := originalFeed.IgnoreHTTPCache || forceRefresh
-	if !ignoreHTTPCache {
-		requestBuilder = requestBuilder.
-			WithETag(originalFeed.EtagHeader).
-			WithLastModified(originalFeed.LastModifiedHeader)
+	etagHeader, lastModifiedHeader := originalFeed.EtagHeader, originalFeed.LastModifiedHeader
+	ignoreHTTPCache := effectiveFeed.IgnoreHTTPCache || forceRefresh
+	if ignoreHTTPCache {
+		etagHeader, lastModifiedHeader = "", ""
 	}
 
-	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(originalFeed.FeedURL))
-	defer responseHandler.Close()
+	requestBuilder = requestBuilder.
+		WithETag(etagHeader).
+		WithLastModified(lastModifiedHeader)
+
+	// Subscribers of the same feed with the same request settings share the response fetched
+	// for the other feeds of the group, or during the window.
+	fetchKey := sharedFetchKey(originalFeed.FeedURL, effectiveFeed)
+	response, sharedResponse := group.fetch(fetchKey, etagHeader, lastModifiedHeader, func() (*feedResponse, bool) {
+		return sharedFeedResponses.fetch(
+			fetchKey,
+			config.Opts.PollingSharedFetchWindow(),
+			forceRefresh,
+			etagHeader,
+			lastModifiedHeader,
+			func() *feedResponse {
+				return fetchFeedResponse(requestBuilder, originalFeed.FeedURL, etagHeader, lastModifiedHeader)
+			},
+		)
+	})
 
-	if responseHandler.IsRateLimited() {
-		retryDelay := responseHandler.ParseRetryDelay()
+	refresh.StatusCode = response.statusCode
+	refresh.Shared = sharedResponse
+
+	if sharedResponse {
+		slog.Debug("Using the response fetched for another subscriber",
+			slog.Int64("user_id", userID),
+			slog.Int64("feed_id", feedID),
+			slog.String("feed_url", originalFeed.FeedURL),
+			slog.Time("fetched_at", response.fetchedAt),
+		)
+	}
+
+	if response.rateLimited {
+		retryDelay := response.retryDelay
 		calculatedNextCheckInterval := originalFeed.ScheduleNextCheck(weeklyEntryCount, retryDelay)
 
 		slog.Warn("Feed is rate limited",
@@ -263,7 +398,7 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		)
 	}
 
-	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
+	if localizedError := response.fetchErr; localizedError != nil {
 		slog.Warn("Unable to fetch feed",
 			slog.Int64("user_id", userID),
 			slog.Int64("feed_id", feedID),
@@ -273,12 +408,16 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		return getTranslatedLocalizedError(store, userID, originalFeed, localizedError)
 	}
 
-	if store.AnotherFeedURLExists(userID, originalFeed.ID, responseHandler.EffectiveURL()) {
+	if store.AnotherFeedURLExists(userID, originalFeed.ID, response.effectiveURL) {
 		localizedError := locale.NewLocalizedErrorWrapper(ErrDuplicatedFeed, "error.duplicated_feed")
 		return getTranslatedLocalizedError(store, userID, originalFeed, localizedError)
 	}
 
-	if ignoreHTTPCache || responseHandler.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
+	// A feed is considered moved when all redirects are permanent, or when it declares another location.
+	movedFeedURL, movedFeedReason := response.permanentRedirectURL, model.FeedURLChangeReasonPermanentRedirect
+	trackMovedFeedURL := true
+
+	if ignoreHTTPCache || response.isModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
 		slog.Debug("Feed modified",
 			slog.Int64("user_id", userID),
 			slog.Int64("feed_id", feedID),
@@ -286,14 +425,14 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 			slog.String("last_modified_header", originalFeed.LastModifiedHeader),
 		)
 
-		responseBody, localizedError := responseHandler.ReadBody(config.Opts.HTTPClientMaxBodySize())
-		if localizedError != nil {
+		if localizedError := response.readErr; localizedError != nil {
 			slog.Warn("Unable to fetch feed", slog.String("feed_url", originalFeed.FeedURL), slog.Any("error", localizedError.Error()))
 			return localizedError
 		}
 
-		updatedFeed, parseErr := parser.ParseFeed(responseHandler.EffectiveURL(), bytes.NewReader(responseBody))
-		if parseErr != nil {
+		refresh.BodySize = response.bodySize
+
+		if parseErr := response.parseErr; parseErr != nil {
 			localizedError := locale.NewLocalizedErrorWrapper(parseErr, "error.unable_to_parse_feed", parseErr)
 			if errors.Is(parseErr, parser.ErrFeedFormatNotDetected) {
 				localizedError = locale.NewLocalizedErrorWrapper(parseErr, "error.feed_format_not_detected", parseErr)
@@ -301,11 +440,18 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 			return getTranslatedLocalizedError(store, userID, originalFeed, localizedError)
 		}
 
+		// Each subscriber rewrites and filters its own copy of the entries.
+		updatedFeed := response.parsedFeed()
+
+		if config.Opts.WebSub() {
+			updateWebSubHub(store, originalFeed, updatedFeed)
+		}
+
 		// Use the RSS TTL value, or the Cache-Control or Expires HTTP headers if available.
 		// Otherwise, we use the default value from the configuration (min interval parameter).
 		feedTTLValue := updatedFeed.TTL
-		cacheControlMaxAgeValue := responseHandler.CacheControlMaxAge()
-		expiresValue := responseHandler.Expires()
+		cacheControlMaxAgeValue := response.cacheControlMaxAge
+		expiresValue := response.expires
 		refreshDelay := max(feedTTLValue, cacheControlMaxAgeValue, expiresValue)
 
 		// Set the next check at with updated arguments.
@@ -323,19 +469,30 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 			slog.Time("new_next_check_at", originalFeed.NextCheckAt),
 		)
 
+		if movedFeedURL == "" && updatedFeed.FeedURL != response.effectiveURL {
+			movedFeedURL, movedFeedReason = updatedFeed.FeedURL, model.FeedURLChangeReasonDeclaredURL
+		}
+
 		originalFeed.Entries = updatedFeed.Entries
 		processor.ProcessFeedEntries(store, originalFeed, userID, forceRefresh)
 
 		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
 		// We also skip updating existing entries if the feed has ignore_entry_updates enabled.
 		// Unless it is forced to refresh.
-		updateExistingEntries := forceRefresh || (!originalFeed.Crawler && !originalFeed.IgnoreEntryUpdates)
-		newEntries, storeErr := store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, updateExistingEntries)
+		updateExistingEntries := forceRefresh || (!effectiveFeed.Crawler && !effectiveFeed.IgnoreEntryUpdates)
+		newEntries, updatedEntries, storeErr := store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, updateExistingEntries, config.Opts.EntryRevisionHistorySize())
 		if storeErr != nil {
 			localizedError := locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
 			return getTranslatedLocalizedError(store, userID, originalFeed, localizedError)
 		}
 
+		refresh.NewEntries = len(newEntries)
+		refresh.UpdatedEntries = updatedEntries
+
+		if config.Opts.MediaProxyCachePrefetch() {
+			prefetchProxifiedImages(newEntries)
+		}
+
 		userIntegrations, intErr := store.Integration(userID)
 		if intErr != nil {
 			slog.Error("Fetching integrations failed; the refresh process will go on, but no integrations will run this time",
@@ -344,11 +501,12 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 				slog.Any("error", intErr),
 			)
 		} else if userIntegrations != nil && len(newEntries) > 0 {
-			go integration.PushEntries(originalFeed, newEntries, userIntegrations)
+			go integration.PushEntries(effectiveFeed, newEntries, userIntegrations)
+			go integration.SendFlaggedEntries(newEntries, userIntegrations)
 		}
 
-		originalFeed.EtagHeader = responseHandler.ETag()
-		originalFeed.LastModifiedHeader = responseHandler.LastModified()
+		originalFeed.EtagHeader = response.etag
+		originalFeed.LastModifiedHeader = response.lastModified
 		originalFeed.Language = updatedFeed.Language
 		originalFeed.IconURL = updatedFeed.IconURL
 		iconChecker := icon.NewIconChecker(store, originalFeed)
@@ -363,11 +521,22 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 			slog.Int64("feed_id", feedID),
 		)
 
+		refresh.NotModified = true
+
 		// Last-Modified may be updated even if ETag is not. In this case, per
 		// RFC9111 sections 3.2 and 4.3.4, the stored response must be updated.
-		if responseHandler.LastModified() != "" {
-			originalFeed.LastModifiedHeader = responseHandler.LastModified()
+		if response.lastModified != "" {
+			originalFeed.LastModifiedHeader = response.lastModified
 		}
+
+		// The location declared by the feed is unknown without its content,
+		// the pending location is kept unless the feed is redirected.
+		trackMovedFeedURL = movedFeedURL != ""
+	}
+
+	var feedURLChange *model.FeedURLChange
+	if trackMovedFeedURL && originalFeed.TrackPendingFeedURL(movedFeedURL, config.Opts.FeedURLMigrationThreshold()) {
+		feedURLChange = migrateFeedURL(store, originalFeed, movedFeedReason, movedFeedRequestBuilder)
 	}
 
 	originalFeed.ResetErrorCounter()
@@ -377,5 +546,144 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		return getTranslatedLocalizedError(store, userID, originalFeed, localizedError)
 	}
 
+	if feedURLChange != nil {
+		notifyFeedURLChange(store, originalFeed, feedURLChange)
+	}
+
 	return nil
 }
+
+// migrateFeedURL replaces the feed URL with the pending feed URL.
+// A location declared by the feed is used only if it serves a valid feed without redirects.
+// It returns nil when the feed URL is left unchanged.
+func migrateFeedURL(store *storage.Storage, feed *model.Feed, reason string, requestBuilder *fetcher.RequestBuilder) *model.FeedURLChange {
+	newFeedURL := feed.PendingFeedURL
+	feed.ResetPendingFeedURL()
+
+	if reason == model.FeedURLChangeReasonDeclaredURL {
+		if strings.HasPrefix(feed.FeedURL, "https://") && !strings.HasPrefix(newFeedURL, "https://") {
+			slog.Debug("Ignoring insecure feed location",
+				slog.Int64("feed_id", feed.ID),
+				slog.String("feed_url", feed.FeedURL),
+				slog.String("new_feed_url", newFeedURL),
+			)
+			return nil
+		}
+
+		if store.AnotherFeedURLExists(feed.UserID, feed.ID, newFeedURL) {
+			slog.Debug("Ignoring feed location already subscribed",
+				slog.Int64("feed_id", feed.ID),
+				slog.String("new_feed_url", newFeedURL),
+			)
+			return nil
+		}
+
+		responseHandler := fetcher.NewResponseHandler(requestBuilder.WithoutRedirects().ExecuteRequest(newFeedURL))
+		defer responseHandler.Close()
+
+		if localizedError := responseHandler.LocalizedError(); localizedError != nil || responseHandler.IsRedirect() {
+			slog.Debug("Ignoring unreachable feed location",
+				slog.Int64("feed_id", feed.ID),
+				slog.String("new_feed_url", newFeedURL),
+				slog.Int("status_code", responseHandler.StatusCode()),
+			)
+			return nil
+		}
+
+		responseBody, localizedError := responseHandler.ReadBody(config.Opts.HTTPClientMaxBodySize())
+		if localizedError != nil {
+			return nil
+		}
+
+		if _, parseErr := parser.ParseFeed(newFeedURL, bytes.NewReader(responseBody)); parseErr != nil {
+			slog.Debug("Ignoring invalid feed location",
+				slog.Int64("feed_id", feed.ID),
+				slog.String("new_feed_url", newFeedURL),
+				slog.Any("error", parseErr),
+			)
+			return nil
+		}
+	}
+
+	slog.Info("Feed URL updated",
+		slog.Int64("user_id", feed.UserID),
+		slog.Int64("feed_id", feed.ID),
+		slog.String("old_feed_url", feed.FeedURL),
+		slog.String("new_feed_url", newFeedURL),
+		slog.String("reason", reason),
+	)
+
+	change := &model.FeedURLChange{
+		FeedID: feed.ID,
+		OldURL: feed.FeedURL,
+		NewURL: newFeedURL,
+		Reason: reason,
+	}
+	feed.FeedURL = newFeedURL
+
+	return change
+}
+
+// notifyFeedURLChange records the feed URL change and notifies the user integrations.
+func notifyFeedURLChange(store *storage.Storage, feed *model.Feed, change *model.FeedURLChange) {
+	if err := store.CreateFeedURLChange(change); err != nil {
+		slog.Error("Unable to record feed URL change",
+			slog.Int64("user_id", feed.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.Any("error", err),
+		)
+	}
+
+	userIntegrations, err := store.Integration(feed.UserID)
+	if err != nil {
+		slog.Error("Unable to fetch integrations to notify the feed URL change",
+			slog.Int64("user_id", feed.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	if userIntegrations != nil {
+		go integration.PushFeedURLChange(feed, change, userIntegrations)
+	}
+}
+
+// updateWebSubHub records the WebSub hub announced by the feed, the subscription is sent by the WebSub scheduler.
+// The topic is the URL declared by the feed, or the URL of the feed when it does not declare its location.
+func updateWebSubHub(store *storage.Storage, feed, parsedFeed *model.Feed) {
+	var err error
+	if parsedFeed.HubURL == "" {
+		err = store.RemoveWebSubSubscription(feed.ID)
+	} else {
+		err = store.SaveWebSubHub(feed.ID, parsedFeed.HubURL, parsedFeed.FeedURL)
+	}
+
+	if err != nil {
+		slog.Error("Unable to update the WebSub hub of the feed",
+			slog.Int64("user_id", feed.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("hub_url", parsedFeed.HubURL),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// prefetchProxifiedImages downloads the images served by the media proxy to its cache,
+// so they remain available if they are removed from the origin.
+func prefetchProxifiedImages(entries model.Entries) {
+	for _, entry := range entries {
+		imageURLs := mediaproxy.ProxifiedImageURLs(entry.Content)
+
+		for _, enclosure := range entry.Enclosures {
+			if strings.HasPrefix(strings.ToLower(enclosure.MimeType), "image/") &&
+				mediaproxy.ShouldProxifyURLWithMimeType(enclosure.URL, enclosure.MimeType, config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes()) {
+				imageURLs = append(imageURLs, enclosure.URL)
+			}
+		}
+
+		for _, imageURL := range imageURLs {
+			mediaproxy.CacheInstance.Prefetch(imageURL, rewrite.GetRefererForURL(imageURL))
+		}
+	}
+}
@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package handler // import "miniflux.app/v2/internal/reader/handler"
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/fetcher"
+	"miniflux.app/v2/internal/reader/parser"
+	"miniflux.app/v2/internal/storage"
+)
+
+// sharedFeedResponses deduplicates the requests of the feeds subscribed by several users.
+var sharedFeedResponses = newSharedFetchCache()
+
+// feedResponse holds everything the refresh process needs from a feed request,
+// so the response can be shared between the subscribers of the same feed.
+type feedResponse struct {
+	fetchedAt time.Time
+
+	// Conditional headers sent with the request.
+	requestETag         string
+	requestLastModified string
+
+	statusCode           int
+	rateLimited          bool
+	retryDelay           time.Duration
+	fetchErr             *locale.LocalizedErrorWrapper
+	effectiveURL         string
+	permanentRedirectURL string
+	etag                 string
+	lastModified         string
+	cacheControlMaxAge   time.Duration
+	expires              time.Duration
+	notModified          bool
+	readErr              *locale.LocalizedErrorWrapper
+	bodySize             int64
+	feed                 *model.Feed
+	parseErr             error
+}
+
+// fetchFeedResponse requests the feed, then reads and parses the response body unless the feed is not modified.
+// The body is parsed even if the validators match, the response may be reused by a subscriber with other validators.
+func fetchFeedResponse(requestBuilder *fetcher.RequestBuilder, feedURL, etag, lastModified string) *feedResponse {
+	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(feedURL))
+	defer responseHandler.Close()
+
+	response := &feedResponse{
+		fetchedAt:           time.Now(),
+		requestETag:         etag,
+		requestLastModified: lastModified,
+		statusCode:          responseHandler.StatusCode(),
+	}
+
+	if responseHandler.IsRateLimited() {
+		response.rateLimited = true
+		response.retryDelay = responseHandler.ParseRetryDelay()
+	}
+
+	if response.fetchErr = responseHandler.LocalizedError(); response.fetchErr != nil {
+		return response
+	}
+
+	response.effectiveURL = responseHandler.EffectiveURL()
+	response.permanentRedirectURL = responseHandler.PermanentRedirectURL()
+	response.etag = responseHandler.ETag()
+	response.lastModified = responseHandler.LastModified()
+	response.cacheControlMaxAge = responseHandler.CacheControlMaxAge()
+	response.expires = responseHandler.Expires()
+
+	if response.statusCode == http.StatusNotModified && (etag != "" || lastModified != "") {
+		response.notModified = true
+		return response
+	}
+
+	responseBody, localizedError := responseHandler.ReadBody(config.Opts.HTTPClientMaxBodySize())
+	if localizedError != nil {
+		response.readErr = localizedError
+		return response
+	}
+
+	response.bodySize = int64(len(responseBody))
+	response.feed, response.parseErr = parser.ParseFeed(response.effectiveURL, bytes.NewReader(responseBody))
+
+	return response
+}
+
+// isModified returns true if the feed has changed since the given validators were received.
+func (r *feedResponse) isModified(lastETag, lastModified string) bool {
+	if r.notModified {
+		return false
+	}
+
+	if r.etag != "" {
+		return r.etag != lastETag
+	}
+
+	if r.lastModified != "" {
+		return r.lastModified != lastModified
+	}
+
+	return true
+}
+
+// reusableWith returns true if a subscriber sending the given conditional headers would get the same response.
+// A "not modified" response only applies to the validators sent with the request.
+func (r *feedResponse) reusableWith(etag, lastModified string) bool {
+	if !r.notModified {
+		return true
+	}
+
+	return r.requestETag == etag && r.requestLastModified == lastModified
+}
+
+// parsedFeed returns a copy of the parsed feed, the entries are modified by the processing of each subscriber.
+func (r *feedResponse) parsedFeed() *model.Feed {
+	feed := *r.feed
+	feed.Entries = r.feed.Entries.Clone()
+	return &feed
+}
+
+type sharedFetchCall struct {
+	done chan struct{}
+}
+
+type sharedFetchCache struct {
+	mu          sync.Mutex
+	responses   map[string]*feedResponse
+	calls       map[string]*sharedFetchCall
+	lastCleanup time.Time
+}
+
+func newSharedFetchCache() *sharedFetchCache {
+	return &sharedFetchCache{
+		responses: make(map[string]*feedResponse),
+		calls:     make(map[string]*sharedFetchCall),
+	}
+}
+
+// fetch returns the response of a request made for the same key during the window, when it can be reused with
+// the given conditional headers. Otherwise, fetchFunc is called and its response is kept for the next subscribers.
+// Concurrent calls for the same key wait for the request in progress.
+// The second return value is true when the response comes from another request.
+func (c *sharedFetchCache) fetch(key string, window time.Duration, forceFetch bool, etag, lastModified string, fetchFunc func() *feedResponse) (*feedResponse, bool) {
+	if window <= 0 {
+		return fetchFunc(), false
+	}
+
+	c.mu.Lock()
+	for {
+		call, found := c.calls[key]
+		if !found {
+			break
+		}
+		c.mu.Unlock()
+		<-call.done
+		c.mu.Lock()
+	}
+
+	if response, found := c.responses[key]; found && !forceFetch && time.Since(response.fetchedAt) < window && response.reusableWith(etag, lastModified) {
+		c.mu.Unlock()
+		return response, true
+	}
+
+	call := &sharedFetchCall{done: make(chan struct{})}
+	c.calls[key] = call
+	c.mu.Unlock()
+
+	// The waiting subscribers are released even if the request panics.
+	var response *feedResponse
+	defer func() {
+		c.mu.Lock()
+		delete(c.calls, key)
+		if response != nil {
+			c.responses[key] = response
+			c.removeExpiredResponses(window)
+		}
+		c.mu.Unlock()
+		close(call.done)
+	}()
+
+	response = fetchFunc()
+	return response, false
+}
+
+// removeExpiredResponses drops the responses older than the window, at most once per window.
+func (c *sharedFetchCache) removeExpiredResponses(window time.Duration) {
+	if time.Since(c.lastCleanup) < window {
+		return
+	}
+
+	for key, response := range c.responses {
+		if time.Since(response.fetchedAt) >= window {
+			delete(c.responses, key)
+		}
+	}
+	c.lastCleanup = time.Now()
+}
+
+// FetchGroup shares the responses between the subscribers of the same feed refreshed together,
+// even when the shared fetch window is disabled. A group is not safe for concurrent use.
+type FetchGroup struct {
+	responses map[string]*feedResponse
+}
+
+// NewFetchGroup returns an empty group, the responses are kept as long as the group is used.
+func NewFetchGroup() *FetchGroup {
+	return &FetchGroup{responses: make(map[string]*feedResponse)}
+}
+
+// RefreshFeed refreshes a feed like RefreshFeed, reusing the response fetched for another feed of the group.
+func (g *FetchGroup) RefreshFeed(store *storage.Storage, userID, feedID int64) *locale.LocalizedErrorWrapper {
+	return recordFeedRefresh(store, userID, feedID, false, g)
+}
+
+// fetch returns the response fetched for the same key in the group, when it can be reused with the given
+// conditional headers. Otherwise, fetchFunc is called and its response is kept for the next feeds of the group.
+// The second return value is true when the response comes from another request.
+func (g *FetchGroup) fetch(key, etag, lastModified string, fetchFunc func() (*feedResponse, bool)) (*feedResponse, bool) {
+	if g == nil {
+		return fetchFunc()
+	}
+
+	if response, found := g.responses[key]; found && response.reusableWith(etag, lastModified) {
+		return response, true
+	}
+
+	response, shared := fetchFunc()
+	g.responses[key] = response
+	return response, shared
+}
+
+// sharedFetchKey identifies the requests returning the same response to all subscribers:
+// the normalized feed URL and the settings changing the request.
+// The key is hashed to avoid keeping credentials in memory.
+func sharedFetchKey(feedURL string, feed *model.Feed) string {
+	return crypto.SHA256(strings.Join([]string{
+		normalizeFeedURL(feedURL),
+		feed.Username,
+		feed.Password,
+		feed.UserAgent,
+		feed.Cookie,
+		feed.ProxyURL,
+		strconv.FormatBool(feed.FetchViaProxy),
+		strconv.FormatBool(feed.AllowSelfSignedCertificates),
+		strconv.FormatBool(feed.DisableHTTP2),
+	}, "\x00"))
+}
+
+// normalizeFeedURL lowercases the scheme and the host, and removes the default port and the fragment.
+func normalizeFeedURL(feedURL string) string {
+	parsedURL, err := url.Parse(strings.TrimSpace(feedURL))
+	if err != nil || parsedURL.Host == "" {
+		return feedURL
+	}
+
+	parsedURL.Scheme = strings.ToLower(parsedURL.Scheme)
+	parsedURL.Host = strings.ToLower(parsedURL.Host)
+	parsedURL.Fragment = ""
+	parsedURL.RawFragment = ""
+
+	switch port := parsedURL.Port(); {
+	case parsedURL.Scheme == "http" && port == "80", parsedURL.Scheme == "https" && port == "443":
+		parsedURL.Host = parsedURL.Hostname()
+	}
+
+	if parsedURL.Path == "" {
+		parsedURL.Path = "/"
+	}
+
+	return parsedURL.String()
+}
@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package handler // import "miniflux.app/v2/internal/reader/handler"
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestSharedFetchCacheReusesResponseDuringWindow(t *testing.T) {
+	cache := newSharedFetchCache()
+	calls := 0
+	fetchFunc := func() *feedResponse {
+		calls++
+		return &feedResponse{fetchedAt: time.Now(), statusCode: 200}
+	}
+
+	first, shared := cache.fetch("key", time.Minute, false, "", "", fetchFunc)
+	if shared {
+		t.Error("The first response should not be shared")
+	}
+
+	second, shared := cache.fetch("key", time.Minute, false, `"etag"`, "", fetchFunc)
+	if !shared {
+		t.Error("The second response should be shared")
+	}
+
+	if first != second || calls != 1 {
+		t.Errorf("Expected a single request, got %d", calls)
+	}
+
+	if _, shared := cache.fetch("other-key", time.Minute, false, "", "", fetchFunc); shared || calls != 2 {
+		t.Error("A response should not be shared between different keys")
+	}
+}
+
+func TestSharedFetchCacheIgnoresExpiredResponse(t *testing.T) {
+	cache := newSharedFetchCache()
+	cache.responses["key"] = &feedResponse{fetchedAt: time.Now().Add(-2 * time.Minute)}
+
+	calls := 0
+	_, shared := cache.fetch("key", time.Minute, false, "", "", func() *feedResponse {
+		calls++
+		return &feedResponse{fetchedAt: time.Now()}
+	})
+
+	if shared || calls != 1 {
+		t.Error("An expired response should not be reused")
+	}
+}
+
+func TestSharedFetchCacheDisabled(t *testing.T) {
+	cache := newSharedFetchCache()
+	calls := 0
+	fetchFunc := func() *feedResponse {
+		calls++
+		return &feedResponse{fetchedAt: time.Now()}
+	}
+
+	cache.fetch("key", 0, false, "", "", fetchFunc)
+	if _, shared := cache.fetch("key", 0, false, "", "", fetchFunc); shared || calls != 2 {
+		t.Error("Responses should not be shared when the window is zero")
+	}
+
+	if len(cache.responses) != 0 {
+		t.Error("Responses should not be kept when the window is zero")
+	}
+}
+
+func TestSharedFetchCacheForceFetch(t *testing.T) {
+	cache := newSharedFetchCache()
+	calls := 0
+	fetchFunc := func() *feedResponse {
+		calls++
+		return &feedResponse{fetchedAt: time.Now()}
+	}
+
+	cache.fetch("key", time.Minute, false, "", "", fetchFunc)
+	forced, shared := cache.fetch("key", time.Minute, true, "", "", fetchFunc)
+	if shared || calls != 2 {
+		t.Error("A forced fetch should not reuse the previous response")
+	}
+
+	if latest, _ := cache.fetch("key", time.Minute, false, "", "", fetchFunc); latest != forced {
+		t.Error("The response of a forced fetch should be kept for the next subscribers")
+	}
+}
+
+func TestSharedFetchCacheNotModifiedResponse(t *testing.T) {
+	cache := newSharedFetchCache()
+	cache.fetch("key", time.Minute, false, `"v1"`, "", func() *feedResponse {
+		return &feedResponse{fetchedAt: time.Now(), requestETag: `"v1"`, notModified: true}
+	})
+
+	calls := 0
+	fetchFunc := func() *feedResponse {
+		calls++
+		return &feedResponse{fetchedAt: time.Now()}
+	}
+
+	if _, shared := cache.fetch("key", time.Minute, false, `"v1"`, "", fetchFunc); !shared {
+		t.Error("A not modified response should be reused with the same validators")
+	}
+
+	if _, shared := cache.fetch("key", time.Minute, false, `"v0"`, "", fetchFunc); shared || calls != 1 {
+		t.Error("A not modified response should not be reused with other validators")
+	}
+}
+
+func TestSharedFetchCacheCoalescesConcurrentRequests(t *testing.T) {
+	cache := newSharedFetchCache()
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go cache.fetch("key", time.Minute, false, "", "", func() *feedResponse {
+		calls.Add(1)
+		close(started)
+		<-release
+		return &feedResponse{fetchedAt: time.Now()}
+	})
+	<-started
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, shared := cache.fetch("key", time.Minute, false, "", "", func() *feedResponse {
+				calls.Add(1)
+				return &feedResponse{fetchedAt: time.Now()}
+			}); !shared {
+				t.Error("Concurrent requests should wait for the request in progress")
+			}
+		}()
+	}
+
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single request, got %d", calls.Load())
+	}
+}
+
+func TestSharedFetchCacheRemovesExpiredResponses(t *testing.T) {
+	cache := newSharedFetchCache()
+	cache.responses["expired"] = &feedResponse{fetchedAt: time.Now().Add(-time.Hour)}
+
+	cache.fetch("key", time.Minute, false, "", "", func() *feedResponse {
+		return &feedResponse{fetchedAt: time.Now()}
+	})
+
+	if _, found := cache.responses["expired"]; found {
+		t.Error("Expired responses should be removed")
+	}
+
+	if _, found := cache.responses["key"]; !found {
+		t.Error("The latest response should be kept")
+	}
+}
+
+func TestFeedResponseIsModified(t *testing.T) {
+	scenarios := []struct {
+		name         string
+		response     feedResponse
+		lastETag     string
+		lastModified string
+		expected     bool
+	}{
+		{"not modified status", feedResponse{notModified: true}, "", "", false},
+		{"same etag", feedResponse{etag: `"v1"`}, `"v1"`, "", false},
+		{"different etag", feedResponse{etag: `"v2"`}, `"v1"`, "", true},
+		{"same last modified", feedResponse{lastModified: "Mon, 01 Jan 2024 00:00:00 GMT"}, "", "Mon, 01 Jan 2024 00:00:00 GMT", false},
+		{"different last modified", feedResponse{lastModified: "Tue, 02 Jan 2024 00:00:00 GMT"}, "", "Mon, 01 Jan 2024 00:00:00 GMT", true},
+		{"no validators", feedResponse{}, `"v1"`, "Mon, 01 Jan 2024 00:00:00 GMT", true},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			if result := scenario.response.isModified(scenario.lastETag, scenario.lastModified); result != scenario.expected {
+				t.Errorf("Expected %v, got %v", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestFeedResponseParsedFeedIsACopy(t *testing.T) {
+	response := &feedResponse{feed: &model.Feed{
+		Title:   "Feed",
+		Entries: model.Entries{{Title: "Entry", Tags: []string{"tag"}}},
+	}}
+
+	feed := response.parsedFeed()
+	feed.Title = "Modified"
+	feed.Entries[0].Title = "Modified"
+	feed.Entries[0].Tags[0] = "modified"
+
+	if response.feed.Title != "Feed" || response.feed.Entries[0].Title != "Entry" || response.feed.Entries[0].Tags[0] != "tag" {
+		t.Error("Modifying the parsed feed should not change the shared response")
+	}
+}
+
+func TestSharedFetchKey(t *testing.T) {
+	feed := &model.Feed{}
+
+	if sharedFetchKey("HTTPS://Example.org:443/feed.xml#top", feed) != sharedFetchKey("https://example.org/feed.xml", feed) {
+		t.Error("Equivalent feed URLs should have the same key")
+	}
+
+	if sharedFetchKey("https://example.org/feed.xml", feed) == sharedFetchKey("https://example.org/feed.xml?page=2", feed) {
+		t.Error("Different feed URLs should have different keys")
+	}
+
+	scenarios := map[string]*model.Feed{
+		"username":        {Username: "user"},
+		"password":        {Password: "secret"},
+		"user agent":      {UserAgent: "Custom"},
+		"cookie":          {Cookie: "session=1"},
+		"proxy URL":       {ProxyURL: "http://proxy.example.org"},
+		"fetch via proxy": {FetchViaProxy: true},
+		"self-signed":     {AllowSelfSignedCertificates: true},
+		"disabled HTTP/2": {DisableHTTP2: true},
+	}
+
+	for name, otherFeed := range scenarios {
+		if sharedFetchKey("https://example.org/feed.xml", feed) == sharedFetchKey("https://example.org/feed.xml", otherFeed) {
+			t.Errorf("Feeds with a different %s should have different keys", name)
+		}
+	}
+}
+
+func TestNormalizeFeedURL(t *testing.T) {
+	scenarios := map[string]string{
+		"https://example.org/feed.xml":          "https://example.org/feed.xml",
+		"HTTP://EXAMPLE.org:80/Feed.xml":        "http://example.org/Feed.xml",
+		"https://example.org:8443/feed.xml":     "https://example.org:8443/feed.xml",
+		"http://example.org:443/feed.xml":       "http://example.org:443/feed.xml",
+		"https://example.org/feed.xml#fragment": "https://example.org/feed.xml",
+		"https://example.org":                   "https://example.org/",
+		"https://example.org/feed?format=rss":   "https://example.org/feed?format=rss",
+		"not a URL":                             "not a URL",
+	}
+
+	for input, expected := range scenarios {
+		if result := normalizeFeedURL(input); result != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestSharedFetchCacheReleasesWaitersWhenRequestPanics(t *testing.T) {
+	cache := newSharedFetchCache()
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("The panic should be propagated")
+			}
+		}()
+		cache.fetch("key", time.Minute, false, "", "", func() *feedResponse {
+			panic("request failed")
+		})
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, shared := cache.fetch("key", time.Minute, false, "", "", func() *feedResponse {
+			return &feedResponse{fetchedAt: time.Now()}
+		}); shared {
+			t.Error("The failed request should not be reused")
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("The next request waited for the request that panicked")
+	}
+}
+
+func TestFetchGroupReusesResponse(t *testing.T) {
+	group := NewFetchGroup()
+	calls := 0
+	fetchFunc := func() (*feedResponse, bool) {
+		calls++
+		return &feedResponse{fetchedAt: time.Now(), notModified: true, requestETag: `"v1"`}, false
+	}
+
+	if _, shared := group.fetch("key", `"v1"`, "", fetchFunc); shared || calls != 1 {
+		t.Fatal("The first feed of the group should fetch the response")
+	}
+
+	if _, shared := group.fetch("key", `"v1"`, "", fetchFunc); !shared || calls != 1 {
+		t.Error("The response should be reused by the next feed of the group")
+	}
+
+	if _, shared := group.fetch("key", `"v2"`, "", fetchFunc); shared || calls != 2 {
+		t.Error("A not modified response should not be reused with other validators")
+	}
+
+	if _, shared := group.fetch("other", "", "", fetchFunc); shared || calls != 3 {
+		t.Error("Another key should fetch its own response")
+	}
+}
+
+func TestFetchGroupDisabled(t *testing.T) {
+	var group *FetchGroup
+	calls := 0
+	for range 2 {
+		group.fetch("key", "", "", func() (*feedResponse, bool) {
+			calls++
+			return &feedResponse{fetchedAt: time.Now()}, false
+		})
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected a request per feed without a group, got %d", calls)
+	}
+}
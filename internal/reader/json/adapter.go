@@ -53,6 +53,19 @@ func (j *JSONAdapter) BuildFeed(baseURL string) *model.Feed {
 		feed.SiteURL = siteURL
 	}
 
+	// Only WebSub hubs are supported, rssCloud is ignored.
+	for _, hub := range j.jsonFeed.Hubs {
+		hubURL := strings.TrimSpace(hub.URL)
+		if hubURL == "" || !strings.EqualFold(strings.TrimSpace(hub.Type), "WebSub") {
+			continue
+		}
+
+		if hubURL, err := urllib.ResolveToAbsoluteURL(baseURL, hubURL); err == nil {
+			feed.HubURL = hubURL
+			break
+		}
+	}
+
 	// Fallback to the feed URL if the title is empty.
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
@@ -240,6 +240,29 @@ func TestParseFeedWithRelativeFeedURL(t *testing.T) {
 	}
 }
 
+func TestParseFeedWithHubs(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1.1",
+		"title": "My Example Feed",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"hubs": [
+			{"type": "rssCloud", "url": "https://cloud.example.org/"},
+			{"type": "WebSub", "url": "https://hub.example.org/"}
+		],
+		"items": []
+	}`
+
+	feed, err := Parse("https://example.org/feed.json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.HubURL != "https://hub.example.org/" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+}
+
 func TestParseFeedSiteURLWithTrailingSpace(t *testing.T) {
 	data := `{
 		"version": "https://jsonfeed.org/version/1",
@@ -24,6 +24,21 @@ func (h *Handler) Export(userID int64) (string, error) {
 		return "", err
 	}
 
+	categories, err := h.store.Categories(userID)
+	if err != nil {
+		return "", err
+	}
+
+	categoryTitles := make(map[int64]string, len(categories))
+	for _, category := range categories {
+		categoryTitles[category.ID] = category.Title
+	}
+
+	categoryParents := make(map[string]string, len(categories))
+	for _, category := range categories {
+		categoryParents[category.Title] = categoryTitles[category.ParentID]
+	}
+
 	subscriptions := make([]subcription, 0, len(feeds))
 	for _, feed := range feeds {
 		subscriptions = append(subscriptions, subcription{
@@ -53,12 +68,12 @@ func (h *Handler) Export(userID int64) (string, error) {
 		})
 	}
 
-	return serialize(subscriptions), nil
+	return serialize(subscriptions, categoryParents), nil
 }
 
 // Import parses and create feeds from an OPML import.
 func (h *Handler) Import(userID int64, data io.Reader) error {
-	subscriptions, err := parse(data)
+	subscriptions, categoryParents, err := parseWithCategories(data)
 	if err != nil {
 		return err
 	}
@@ -68,7 +83,7 @@ func (h *Handler) Import(userID int64, data io.Reader) error {
 			continue
 		}
 
-		category, err := h.resolveCategory(userID, subscription.CategoryName)
+		category, err := h.resolveCategory(userID, subscription.CategoryName, categoryParents)
 		if err != nil {
 			return err
 		}
@@ -94,7 +109,9 @@ func (h *Handler) Import(userID int64, data io.Reader) error {
 	return nil
 }
 
-func (h *Handler) resolveCategory(userID int64, categoryName string) (*model.Category, error) {
+// resolveCategory finds or creates the category with the given name.
+// New categories are nested under their parent outline, which is created as well if needed.
+func (h *Handler) resolveCategory(userID int64, categoryName string, categoryParents map[string]string) (*model.Category, error) {
 	if categoryName == "" {
 		category, err := h.store.FirstCategory(userID)
 		if err != nil {
@@ -109,7 +126,17 @@ func (h *Handler) resolveCategory(userID int64, categoryName string) (*model.Cat
 	}
 
 	if category == nil {
-		category, err = h.store.CreateCategory(userID, &model.CategoryCreationRequest{Title: categoryName})
+		categoryCreationRequest := &model.CategoryCreationRequest{Title: categoryName}
+
+		if parentName := categoryParents[categoryName]; parentName != "" {
+			parentCategory, err := h.resolveCategory(userID, parentName, categoryParents)
+			if err != nil {
+				return nil, err
+			}
+			categoryCreationRequest.ParentID = parentCategory.ID
+		}
+
+		category, err = h.store.CreateCategory(userID, categoryCreationRequest)
 		if err != nil {
 			return nil, fmt.Errorf(`opml: unable to create this category: %q`, categoryName)
 		}
@@ -13,6 +13,13 @@ import (
 
 // parse reads an OPML file and returns a list of subscription.
 func parse(data io.Reader) ([]subcription, error) {
+	subscriptions, _, err := parseWithCategories(data)
+	return subscriptions, err
+}
+
+// parseWithCategories reads an OPML file and returns a list of subscription,
+// and the name of the parent category of each nested category outline.
+func parseWithCategories(data io.Reader) ([]subcription, map[string]string, error) {
 	opmlDocument := &opmlDocument{}
 	decoder := xml.NewDecoder(data)
 	decoder.Entity = xml.HTMLEntity
@@ -21,10 +28,30 @@ func parse(data io.Reader) ([]subcription, error) {
 
 	err := decoder.Decode(opmlDocument)
 	if err != nil {
-		return nil, fmt.Errorf("opml: unable to parse document: %w", err)
+		return nil, nil, fmt.Errorf("opml: unable to parse document: %w", err)
 	}
 
-	return getSubscriptionsFromOutlines(opmlDocument.Outlines, ""), nil
+	categoryParents := make(map[string]string)
+	getCategoryParentsFromOutlines(opmlDocument.Outlines, "", categoryParents)
+
+	return getSubscriptionsFromOutlines(opmlDocument.Outlines, ""), categoryParents, nil
+}
+
+// getCategoryParentsFromOutlines records the parent of the category outlines.
+// Only the first occurrence of a category name is kept, so a parent is always recorded before its subcategories.
+func getCategoryParentsFromOutlines(outlines opmlOutlineCollection, parentName string, categoryParents map[string]string) {
+	for _, outline := range outlines {
+		if outline.IsSubscription() || !outline.Outlines.HasChildren() {
+			continue
+		}
+
+		categoryName := outline.GetTitle()
+		if _, found := categoryParents[categoryName]; !found {
+			categoryParents[categoryName] = parentName
+		}
+
+		getCategoryParentsFromOutlines(outline.Outlines, categoryName, categoryParents)
+	}
 }
 
 func getSubscriptionsFromOutlines(outlines opmlOutlineCollection, category string) []subcription {
@@ -235,6 +235,58 @@ func TestParseOpmlVersion1WithSeveralNestedOutlines(t *testing.T) {
 	}
 }
 
+func TestParseOpmlWithNestedCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0">
+		<head>
+			<title>Miniflux</title>
+		</head>
+		<body>
+			<outline text="Tech">
+				<outline text="Feed 1" type="rss" xmlUrl="http://example.org/feed1/" htmlUrl="http://example.org/1"></outline>
+				<outline text="Programming">
+					<outline text="Go">
+						<outline text="Feed 2" type="rss" xmlUrl="http://example.org/feed2/" htmlUrl="http://example.org/2"></outline>
+					</outline>
+				</outline>
+			</outline>
+			<outline text="Go">
+				<outline text="Feed 3" type="rss" xmlUrl="http://example.org/feed3/" htmlUrl="http://example.org/3"></outline>
+			</outline>
+		</body>
+	</opml>
+	`
+
+	subscriptions, categoryParents, err := parseWithCategories(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 3 {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(subscriptions), 3)
+	}
+
+	if subscriptions[1].CategoryName != "Go" {
+		t.Errorf(`The subscription should belong to the innermost category, got %q`, subscriptions[1].CategoryName)
+	}
+
+	expectedParents := map[string]string{
+		"Tech":        "",
+		"Programming": "Tech",
+		"Go":          "Programming",
+	}
+
+	if len(categoryParents) != len(expectedParents) {
+		t.Fatalf(`Unexpected category parents: %v`, categoryParents)
+	}
+
+	for categoryName, parentName := range expectedParents {
+		if categoryParents[categoryName] != parentName {
+			t.Errorf(`The parent of %q should be %q, got %q`, categoryName, parentName, categoryParents[categoryName])
+		}
+	}
+}
+
 func TestParseOpmlWithInvalidCharacterEntity(t *testing.T) {
 	data := `<?xml version="1.0"?>
 	<opml version="1.0">
@@ -13,12 +13,13 @@ import (
 )
 
 // serialize returns a SubcriptionList in OPML format.
-func serialize(subscriptions []subcription) string {
+// Categories are nested according to categoryParents, which maps a category name to the name of its parent.
+func serialize(subscriptions []subcription, categoryParents map[string]string) string {
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
 	writer.WriteString(xml.Header)
 
-	opmlDocument := convertSubscriptionsToOPML(subscriptions)
+	opmlDocument := convertSubscriptionsToOPML(subscriptions, categoryParents)
 	encoder := xml.NewEncoder(writer)
 	encoder.Indent("", "    ")
 	if err := encoder.Encode(opmlDocument); err != nil {
@@ -31,7 +32,7 @@ func serialize(subscriptions []subcription) string {
 	return b.String()
 }
 
-func convertSubscriptionsToOPML(subscriptions []subcription) *opmlDocument {
+func convertSubscriptionsToOPML(subscriptions []subcription, categoryParents map[string]string) *opmlDocument {
 	opmlDocument := &opmlDocument{}
 	opmlDocument.Version = "2.0"
 	opmlDocument.MinifluxNamespace = minifluxOPMLNamespace
@@ -39,48 +40,75 @@ func convertSubscriptionsToOPML(subscriptions []subcription) *opmlDocument {
 	opmlDocument.Header.DateCreated = time.Now().Format("Mon, 02 Jan 2006 15:04:05 MST")
 
 	groupedSubs := groupSubscriptionsByFeed(subscriptions)
-	categories := make([]string, 0, len(groupedSubs))
-	for k := range groupedSubs {
-		categories = append(categories, k)
+
+	// Categories are exported with all their ancestors, even the ancestors without feeds.
+	var categories []string
+	subcategories := make(map[string][]string)
+	exported := make(map[string]bool)
+	for categoryName := range groupedSubs {
+		for name := categoryName; !exported[name]; {
+			exported[name] = true
+
+			parentName := categoryParents[name]
+			if parentName == "" {
+				categories = append(categories, name)
+				break
+			}
+
+			subcategories[parentName] = append(subcategories[parentName], name)
+			name = parentName
+		}
 	}
+
 	sort.Strings(categories)
+	for _, names := range subcategories {
+		sort.Strings(names)
+	}
 
 	for _, categoryName := range categories {
-		category := opmlOutline{Text: categoryName, Outlines: make(opmlOutlineCollection, 0, len(groupedSubs[categoryName]))}
-		for _, subscription := range groupedSubs[categoryName] {
-			category.Outlines = append(category.Outlines, opmlOutline{
-				Title:       subscription.Title,
-				Text:        subscription.Title,
-				FeedURL:     subscription.FeedURL,
-				SiteURL:     subscription.SiteURL,
-				Description: subscription.Description,
-
-				ScraperRules:                subscription.ScraperRules,
-				RewriteRules:                subscription.RewriteRules,
-				UrlRewriteRules:             subscription.UrlRewriteRules,
-				BlocklistRules:              subscription.BlocklistRules,
-				KeeplistRules:               subscription.KeeplistRules,
-				BlockFilterEntryRules:       subscription.BlockFilterEntryRules,
-				KeepFilterEntryRules:        subscription.KeepFilterEntryRules,
-				UserAgent:                   subscription.UserAgent,
-				Crawler:                     subscription.Crawler,
-				IgnoreHTTPCache:             subscription.IgnoreHTTPCache,
-				FetchViaProxy:               subscription.FetchViaProxy,
-				Disabled:                    subscription.Disabled,
-				NoMediaPlayer:               subscription.NoMediaPlayer,
-				HideGlobally:                subscription.HideGlobally,
-				AllowSelfSignedCertificates: subscription.AllowSelfSignedCertificates,
-				DisableHTTP2:                subscription.DisableHTTP2,
-				IgnoreEntryUpdates:          subscription.IgnoreEntryUpdates,
-			})
-		}
-
-		opmlDocument.Outlines = append(opmlDocument.Outlines, category)
+		opmlDocument.Outlines = append(opmlDocument.Outlines, convertCategoryToOPML(categoryName, groupedSubs, subcategories))
 	}
 
 	return opmlDocument
 }
 
+func convertCategoryToOPML(categoryName string, groupedSubs map[string][]subcription, subcategories map[string][]string) opmlOutline {
+	category := opmlOutline{Text: categoryName, Outlines: make(opmlOutlineCollection, 0, len(groupedSubs[categoryName])+len(subcategories[categoryName]))}
+	for _, subscription := range groupedSubs[categoryName] {
+		category.Outlines = append(category.Outlines, opmlOutline{
+			Title:       subscription.Title,
+			Text:        subscription.Title,
+			FeedURL:     subscription.FeedURL,
+			SiteURL:     subscription.SiteURL,
+			Description: subscription.Description,
+
+			ScraperRules:                subscription.ScraperRules,
+			RewriteRules:                subscription.RewriteRules,
+			UrlRewriteRules:             subscription.UrlRewriteRules,
+			BlocklistRules:              subscription.BlocklistRules,
+			KeeplistRules:               subscription.KeeplistRules,
+			BlockFilterEntryRules:       subscription.BlockFilterEntryRules,
+			KeepFilterEntryRules:        subscription.KeepFilterEntryRules,
+			UserAgent:                   subscription.UserAgent,
+			Crawler:                     subscription.Crawler,
+			IgnoreHTTPCache:             subscription.IgnoreHTTPCache,
+			FetchViaProxy:               subscription.FetchViaProxy,
+			Disabled:                    subscription.Disabled,
+			NoMediaPlayer:               subscription.NoMediaPlayer,
+			HideGlobally:                subscription.HideGlobally,
+			AllowSelfSignedCertificates: subscription.AllowSelfSignedCertificates,
+			DisableHTTP2:                subscription.DisableHTTP2,
+			IgnoreEntryUpdates:          subscription.IgnoreEntryUpdates,
+		})
+	}
+
+	for _, subcategoryName := range subcategories[categoryName] {
+		category.Outlines = append(category.Outlines, convertCategoryToOPML(subcategoryName, groupedSubs, subcategories))
+	}
+
+	return category
+}
+
 func groupSubscriptionsByFeed(subscriptions []subcription) map[string][]subcription {
 	groups := make(map[string][]subcription)
 
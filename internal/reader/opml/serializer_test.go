@@ -15,7 +15,7 @@ func TestSerialize(t *testing.T) {
 	subscriptions = append(subscriptions, subcription{Title: "Feed 2", FeedURL: "http://example.org/feed/2", SiteURL: "http://example.org/2", CategoryName: "Category 1"})
 	subscriptions = append(subscriptions, subcription{Title: "Feed 3", FeedURL: "http://example.org/feed/3", SiteURL: "http://example.org/3", CategoryName: "Category 2"})
 
-	output := serialize(subscriptions)
+	output := serialize(subscriptions, nil)
 	feeds, err := parse(bytes.NewBufferString(output))
 	if err != nil {
 		t.Error(err)
@@ -64,7 +64,7 @@ func TestSerializeWithMinifluxSettings(t *testing.T) {
 		IgnoreEntryUpdates:          true,
 	}
 
-	output := serialize([]subcription{input})
+	output := serialize([]subcription{input}, nil)
 	if !strings.Contains(output, `xmlns:miniflux="https://miniflux.app/opml"`) {
 		t.Fatal("Miniflux OPML namespace is missing")
 	}
@@ -102,7 +102,7 @@ func TestSerializePreservesNewlinesInRules(t *testing.T) {
 		BlockFilterEntryRules: "EntryTitle=~\"ad\"\nEntryURL=~\"click\"",
 	}
 
-	output := serialize([]subcription{input})
+	output := serialize([]subcription{input}, nil)
 	feeds, err := parse(bytes.NewBufferString(output))
 	if err != nil {
 		t.Fatal(err)
@@ -140,7 +140,7 @@ func TestNormalizedCategoriesOrder(t *testing.T) {
 	subscriptions = append(subscriptions, subcription{Title: "Feed 2", FeedURL: "http://example.org/feed/2", SiteURL: "http://example.org/2", CategoryName: orderTests[1].naturalOrderName})
 	subscriptions = append(subscriptions, subcription{Title: "Feed 3", FeedURL: "http://example.org/feed/3", SiteURL: "http://example.org/3", CategoryName: orderTests[2].naturalOrderName})
 
-	feeds := convertSubscriptionsToOPML(subscriptions)
+	feeds := convertSubscriptionsToOPML(subscriptions, nil)
 
 	for i, o := range orderTests {
 		if feeds.Outlines[i].Text != o.correctOrderName {
@@ -148,3 +148,50 @@ func TestNormalizedCategoriesOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestSerializeNestedCategories(t *testing.T) {
+	var subscriptions []subcription
+	subscriptions = append(subscriptions, subcription{Title: "Feed 1", FeedURL: "http://example.org/feed/1", SiteURL: "http://example.org/1", CategoryName: "Go"})
+	subscriptions = append(subscriptions, subcription{Title: "Feed 2", FeedURL: "http://example.org/feed/2", SiteURL: "http://example.org/2", CategoryName: "News"})
+
+	categoryParents := map[string]string{
+		"Go":          "Programming",
+		"Programming": "Tech",
+		"Tech":        "",
+		"News":        "",
+		"Empty":       "Tech",
+	}
+
+	document := convertSubscriptionsToOPML(subscriptions, categoryParents)
+
+	if len(document.Outlines) != 2 {
+		t.Fatalf("Wrong number of top-level outlines: %d instead of %d", len(document.Outlines), 2)
+	}
+
+	if document.Outlines[0].Text != "News" || document.Outlines[1].Text != "Tech" {
+		t.Fatalf("Unexpected top-level outlines: %q and %q", document.Outlines[0].Text, document.Outlines[1].Text)
+	}
+
+	tech := document.Outlines[1]
+	if len(tech.Outlines) != 1 || tech.Outlines[0].Text != "Programming" {
+		t.Fatalf("The category without feeds should not be exported, got %+v", tech.Outlines)
+	}
+
+	programming := tech.Outlines[0]
+	if len(programming.Outlines) != 1 || programming.Outlines[0].Text != "Go" || len(programming.Outlines[0].Outlines) != 1 {
+		t.Fatalf("Unexpected nested outlines: %+v", programming.Outlines)
+	}
+
+	subscriptions, parsedParents, err := parseWithCategories(bytes.NewBufferString(serialize(subscriptions, categoryParents)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 2 {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(subscriptions), 2)
+	}
+
+	if parsedParents["Go"] != "Programming" || parsedParents["Programming"] != "Tech" || parsedParents["Tech"] != "" {
+		t.Errorf("The category tree is not preserved: %v", parsedParents)
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"log/slog"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+)
+
+// newEntryFilter combines the block and keep rules of the user and the feed
+// with the block filter expressions of the user, the category and the feed.
+func newEntryFilter(user *model.User, feed *model.Feed) func(entry *model.Entry) filter.Verdict {
+	blockRules := filter.ParseRules(user.BlockFilterEntryRules, feed.BlockFilterEntryRules)
+	allowRules := filter.ParseRules(user.KeepFilterEntryRules, feed.KeepFilterEntryRules)
+
+	categoryBlockFilterExpression := ""
+	if feed.Category != nil {
+		categoryBlockFilterExpression = feed.Category.BlockFilterExpression
+	}
+	blockExpressions := filter.ParseExpressions(user.BlockFilterExpression, categoryBlockFilterExpression, feed.BlockFilterExpression)
+
+	slog.Debug("Filter rules",
+		slog.String("user_block_filter_rules", user.BlockFilterEntryRules),
+		slog.String("feed_block_filter_rules", feed.BlockFilterEntryRules),
+		slog.String("user_keep_filter_rules", user.KeepFilterEntryRules),
+		slog.String("feed_keep_filter_rules", feed.KeepFilterEntryRules),
+		slog.Any("block_rules", blockRules),
+		slog.Any("allow_rules", allowRules),
+		slog.Int("block_expressions", len(blockExpressions)),
+		slog.Int64("user_id", user.ID),
+		slog.Int64("feed_id", feed.ID),
+	)
+
+	return func(entry *model.Entry) filter.Verdict {
+		verdict := filter.EvaluateEntry(blockRules, allowRules, feed, entry)
+		if verdict.Blocked {
+			return verdict
+		}
+
+		if expression := filter.MatchingExpression(blockExpressions, feed, entry); expression != nil {
+			return filter.Verdict{Blocked: true, MatchedRule: expression.String()}
+		}
+
+		return verdict
+	}
+}
+
+// PreviewFilters reports which of the given entries would be blocked by the current filters of the feed,
+// including the filters inherited from its category.
+// The entries are not modified.
+func PreviewFilters(user *model.User, feed *model.Feed, entries model.Entries) *model.FilterPreview {
+	evaluateEntry := newEntryFilter(user, feed.WithCategoryDefaults())
+
+	preview := &model.FilterPreview{
+		Total:   len(entries),
+		Entries: make([]*model.FilterPreviewEntry, 0, len(entries)),
+	}
+
+	for _, entry := range entries {
+		verdict := evaluateEntry(entry)
+		if verdict.Blocked {
+			preview.Blocked++
+		}
+
+		preview.Entries = append(preview.Entries, &model.FilterPreviewEntry{
+			EntryID:     entry.ID,
+			Title:       entry.Title,
+			URL:         entry.URL,
+			Blocked:     verdict.Blocked,
+			MatchedRule: verdict.MatchedRule,
+		})
+	}
+
+	return preview
+}
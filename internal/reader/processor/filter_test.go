@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestPreviewFilters(t *testing.T) {
+	user := &model.User{BlockFilterExpression: `author == "bot"`}
+	feed := &model.Feed{
+		Category:              &model.Category{},
+		BlockFilterEntryRules: "EntryTitle=(?i)release",
+		KeepFilterEntryRules:  "EntryTag=golang",
+	}
+
+	entries := model.Entries{
+		{ID: 1, Title: "Release notes", Tags: []string{"golang"}},
+		{ID: 2, Title: "Generics", Tags: []string{"golang"}},
+		{ID: 3, Title: "Weekly digest", Author: "bot", Tags: []string{"golang"}},
+		{ID: 4, Title: "Ownership", Tags: []string{"rust"}},
+	}
+
+	preview := PreviewFilters(user, feed, entries)
+
+	if preview.Total != 4 || preview.Blocked != 3 {
+		t.Fatalf("Expected 3 blocked entries out of 4, got %d out of %d", preview.Blocked, preview.Total)
+	}
+
+	expected := []model.FilterPreviewEntry{
+		{EntryID: 1, Title: "Release notes", Blocked: true, MatchedRule: "EntryTitle=(?i)release"},
+		{EntryID: 2, Title: "Generics", MatchedRule: "EntryTag=golang"},
+		{EntryID: 3, Title: "Weekly digest", Blocked: true, MatchedRule: `author == "bot"`},
+		{EntryID: 4, Title: "Ownership", Blocked: true},
+	}
+
+	for i, result := range preview.Entries {
+		if *result != expected[i] {
+			t.Errorf("Unexpected preview for entry #%d, got %+v instead of %+v", result.EntryID, *result, expected[i])
+		}
+	}
+}
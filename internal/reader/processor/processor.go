@@ -24,7 +24,14 @@ import (
 )
 
 // ProcessFeedEntries downloads original web page for entries and apply filters.
+// The settings left empty on the feed are taken from the category defaults.
 func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64, forceRefresh bool) {
+	effectiveFeed := feed.WithCategoryDefaults()
+	processFeedEntries(store, effectiveFeed, userID, forceRefresh)
+	feed.Entries = effectiveFeed.Entries
+}
+
+func processFeedEntries(store *storage.Storage, feed *model.Feed, userID int64, forceRefresh bool) {
 	var filteredEntries model.Entries
 
 	user, storeErr := store.UserByID(userID)
@@ -37,15 +44,16 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 	parsedFeedURL, _ := url.Parse(feed.FeedURL)
 	parsedSiteURL, _ := url.Parse(feed.SiteURL)
 
-	blockRules := filter.ParseRules(user.BlockFilterEntryRules, feed.BlockFilterEntryRules)
-	allowRules := filter.ParseRules(user.KeepFilterEntryRules, feed.KeepFilterEntryRules)
-	slog.Debug("Filter rules",
-		slog.String("user_block_filter_rules", user.BlockFilterEntryRules),
-		slog.String("feed_block_filter_rules", feed.BlockFilterEntryRules),
-		slog.String("user_keep_filter_rules", user.KeepFilterEntryRules),
-		slog.String("feed_keep_filter_rules", feed.KeepFilterEntryRules),
-		slog.Any("block_rules", blockRules),
-		slog.Any("allow_rules", allowRules),
+	userActionRules, storeErr := store.ActionRules(userID)
+	if storeErr != nil {
+		slog.Error("Database error", slog.Any("error", storeErr))
+		return
+	}
+	actionRules := filter.ParseActionRules(userActionRules)
+
+	evaluateEntry := newEntryFilter(user, feed)
+	slog.Debug("Action rules",
+		slog.Int("action_rules", len(actionRules)),
 		slog.Int64("user_id", user.ID),
 		slog.Int64("feed_id", feed.ID),
 	)
@@ -72,7 +80,7 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 			slog.String("feed_url", feed.FeedURL),
 		)
 
-		if filter.IsBlockedEntry(blockRules, allowRules, feed, entry) {
+		if evaluateEntry(entry).Blocked {
 			slog.Debug("Entry is blocked by filter rules",
 				slog.Int64("user_id", user.ID),
 				slog.String("entry_url", entry.URL),
@@ -144,7 +152,7 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 		rewrite.ApplyContentRewriteRules(entry, feed.RewriteRules)
 
 		// Re-run filters only when extracted content replaced entry.Content.
-		if contentExtractedSuccessfully && filter.IsBlockedEntry(blockRules, allowRules, feed, entry) {
+		if contentExtractedSuccessfully && evaluateEntry(entry).Blocked {
 			slog.Debug("Entry is blocked by filter rules",
 				slog.Int64("user_id", user.ID),
 				slog.String("entry_url", entry.URL),
@@ -166,6 +174,11 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 
 		updateEntryReadingTime(store, feed, entry, entryIsNew, user)
 
+		// Actions are only applied once, when the entry is created.
+		if entryIsNew {
+			filter.ApplyActionRules(actionRules, feed, entry)
+		}
+
 		filteredEntries = append(filteredEntries, entry)
 	}
 
@@ -59,6 +59,26 @@ func (r *rssAdapter) buildFeed(baseURL string) *model.Feed {
 		}
 	}
 
+	// Find the WebSub hub from the Channel links.
+	for _, link := range r.rss.Channel.Links {
+		href := strings.TrimSpace(link.Href)
+		if href == "" || link.Rel != "hub" {
+			continue
+		}
+
+		if absoluteHubURL, err := urllib.ResolveToAbsoluteURL(baseURL, href); err == nil {
+			feed.HubURL = absoluteHubURL
+			break
+		}
+	}
+
+	// Podcasts announce their new location with <itunes:new-feed-url>, it takes precedence over the self link.
+	if newFeedURL := strings.TrimSpace(r.rss.Channel.ItunesNewFeedURL); newFeedURL != "" {
+		if absoluteFeedURL, err := urllib.ResolveToAbsoluteURL(baseURL, newFeedURL); err == nil {
+			feed.FeedURL = absoluteFeedURL
+		}
+	}
+
 	// Fallback to the site URL if the title is empty.
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package searchquery parses the search syntax used to find entries.
+//
+// A query is a list of terms that must all match. Terms separated by the
+// uppercase keyword OR match if any of them matches. A term is either a
+// word, a "quoted phrase", or a field filter such as title:golang or
+// is:starred. Any term can be negated with a leading dash.
+package searchquery // import "miniflux.app/v2/internal/searchquery"
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Field is the part of an entry a term applies to.
+type Field string
+
+const (
+	FieldText     Field = ""
+	FieldTitle    Field = "title"
+	FieldAuthor   Field = "author"
+	FieldFeed     Field = "feed"
+	FieldCategory Field = "category"
+	FieldTag      Field = "tag"
+	FieldIs       Field = "is"
+	FieldBefore   Field = "before"
+	FieldAfter    Field = "after"
+)
+
+// Values accepted by the "is:" field.
+const (
+	IsStarred = "starred"
+	IsUnread  = "unread"
+	IsRead    = "read"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	keywordOR  = "OR"
+)
+
+// Term is a single search criterion.
+type Term struct {
+	Field   Field
+	Value   string
+	Phrase  bool
+	Negated bool
+
+	// Date is set for the "before:" and "after:" fields.
+	Date time.Time
+}
+
+// Clause is a list of terms where at least one must match.
+type Clause []Term
+
+// Query is a list of clauses that must all match.
+type Query struct {
+	Clauses []Clause
+}
+
+// IsEmpty returns true if the query has no criteria.
+func (q *Query) IsEmpty() bool {
+	return len(q.Clauses) == 0
+}
+
+// FullTextQuery returns the positive text terms of the query in the
+// web search syntax understood by PostgreSQL, for ranking purposes.
+func (q *Query) FullTextQuery() string {
+	var parts []string
+	for _, clause := range q.Clauses {
+		for _, term := range clause {
+			if term.Field != FieldText || term.Negated {
+				continue
+			}
+
+			if term.Phrase {
+				parts = append(parts, `"`+term.Value+`"`)
+			} else {
+				parts = append(parts, term.Value)
+			}
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Parse converts the input string to a query.
+//
+// Unknown field names are searched as plain text, so URLs and words
+// containing a colon keep working. Invalid dates and unsupported "is:"
+// values are reported as errors.
+func Parse(input string) (*Query, error) {
+	query := &Query{}
+
+	var clause Clause
+	joinWithPrevious := false
+
+	for _, token := range tokenize(input) {
+		if token.text == keywordOR && !token.quoted && !token.negated {
+			joinWithPrevious = len(clause) > 0
+			continue
+		}
+
+		term, err := parseTerm(token)
+		if err != nil {
+			return nil, err
+		}
+
+		if term == nil {
+			continue
+		}
+
+		if !joinWithPrevious && len(clause) > 0 {
+			query.Clauses = append(query.Clauses, clause)
+			clause = nil
+		}
+
+		clause = append(clause, *term)
+		joinWithPrevious = false
+	}
+
+	if len(clause) > 0 {
+		query.Clauses = append(query.Clauses, clause)
+	}
+
+	return query, nil
+}
+
+type token struct {
+	text    string
+	quoted  bool
+	literal bool
+	negated bool
+}
+
+// tokenize splits the input on whitespace, keeping quoted sections together.
+// A quote that is never closed extends to the end of the input.
+func tokenize(input string) []token {
+	var tokens []token
+	var current strings.Builder
+	var currentToken token
+	inQuotes := false
+	started := false
+
+	flush := func() {
+		if started {
+			currentToken.text = current.String()
+			tokens = append(tokens, currentToken)
+		}
+		current.Reset()
+		currentToken = token{}
+		started = false
+	}
+
+	for _, r := range input {
+		switch {
+		case r == '"':
+			// A token starting with a quote is never parsed as a field filter.
+			if !started {
+				currentToken.literal = true
+			}
+			inQuotes = !inQuotes
+			currentToken.quoted = true
+			started = true
+		case unicode.IsSpace(r) && !inQuotes:
+			flush()
+		case r == '-' && !started && !inQuotes:
+			currentToken.negated = true
+		default:
+			current.WriteRune(r)
+			started = true
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+func parseTerm(t token) (*Term, error) {
+	term := &Term{Field: FieldText, Value: t.text, Phrase: t.quoted, Negated: t.negated}
+
+	name, value, found := strings.Cut(t.text, ":")
+	if found && !t.literal {
+		field := Field(strings.ToLower(name))
+		switch field {
+		case FieldTitle, FieldAuthor, FieldFeed, FieldCategory, FieldTag:
+			term.Field = field
+			term.Value = value
+		case FieldIs:
+			value = strings.ToLower(value)
+			switch value {
+			case IsStarred, IsUnread, IsRead:
+			default:
+				return nil, fmt.Errorf(`unsupported value %q for "is:", valid values are %q, %q and %q`, value, IsStarred, IsUnread, IsRead)
+			}
+			term.Field = field
+			term.Value = value
+			term.Phrase = false
+		case FieldBefore, FieldAfter:
+			date, err := time.Parse(dateLayout, value)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid date %q for "%s:", the expected format is YYYY-MM-DD`, value, field)
+			}
+			term.Field = field
+			term.Value = value
+			term.Date = date
+			term.Phrase = false
+		}
+	}
+
+	if strings.TrimSpace(term.Value) == "" {
+		return nil, nil
+	}
+
+	return term, nil
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package searchquery // import "miniflux.app/v2/internal/searchquery"
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	scenarios := []struct {
+		input    string
+		expected []Clause
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"golang", []Clause{{{Field: FieldText, Value: "golang"}}}},
+		{"golang rust", []Clause{
+			{{Field: FieldText, Value: "golang"}},
+			{{Field: FieldText, Value: "rust"}},
+		}},
+		{`"static typing"`, []Clause{{{Field: FieldText, Value: "static typing", Phrase: true}}}},
+		{`"unterminated phrase`, []Clause{{{Field: FieldText, Value: "unterminated phrase", Phrase: true}}}},
+		{"-golang", []Clause{{{Field: FieldText, Value: "golang", Negated: true}}}},
+		{`-"static typing"`, []Clause{{{Field: FieldText, Value: "static typing", Phrase: true, Negated: true}}}},
+		{"golang OR rust", []Clause{{
+			{Field: FieldText, Value: "golang"},
+			{Field: FieldText, Value: "rust"},
+		}}},
+		{"golang OR rust OR zig release", []Clause{
+			{
+				{Field: FieldText, Value: "golang"},
+				{Field: FieldText, Value: "rust"},
+				{Field: FieldText, Value: "zig"},
+			},
+			{{Field: FieldText, Value: "release"}},
+		}},
+		{"golang or rust", []Clause{
+			{{Field: FieldText, Value: "golang"}},
+			{{Field: FieldText, Value: "or"}},
+			{{Field: FieldText, Value: "rust"}},
+		}},
+		{"OR golang OR", []Clause{{{Field: FieldText, Value: "golang"}}}},
+		{"title:golang", []Clause{{{Field: FieldTitle, Value: "golang"}}}},
+		{`title:"go 1.22"`, []Clause{{{Field: FieldTitle, Value: "go 1.22", Phrase: true}}}},
+		{"TITLE:golang", []Clause{{{Field: FieldTitle, Value: "golang"}}}},
+		{"author:rob", []Clause{{{Field: FieldAuthor, Value: "rob"}}}},
+		{"feed:blog", []Clause{{{Field: FieldFeed, Value: "blog"}}}},
+		{"category:tech", []Clause{{{Field: FieldCategory, Value: "tech"}}}},
+		{"tag:go", []Clause{{{Field: FieldTag, Value: "go"}}}},
+		{"-tag:go", []Clause{{{Field: FieldTag, Value: "go", Negated: true}}}},
+		{"is:starred", []Clause{{{Field: FieldIs, Value: IsStarred}}}},
+		{"is:Unread", []Clause{{{Field: FieldIs, Value: IsUnread}}}},
+		{"-is:read", []Clause{{{Field: FieldIs, Value: IsRead, Negated: true}}}},
+		{"before:2024-01-02", []Clause{{{Field: FieldBefore, Value: "2024-01-02", Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}}}},
+		{"after:2024-01-02", []Clause{{{Field: FieldAfter, Value: "2024-01-02", Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}}}},
+		{"title:", nil},
+		{"https://example.org/", []Clause{{{Field: FieldText, Value: "https://example.org/"}}}},
+		{`"title:golang"`, []Clause{{{Field: FieldText, Value: "title:golang", Phrase: true}}}},
+		{"well-known", []Clause{{{Field: FieldText, Value: "well-known"}}}},
+		{"title:golang OR author:rob is:unread", []Clause{
+			{
+				{Field: FieldTitle, Value: "golang"},
+				{Field: FieldAuthor, Value: "rob"},
+			},
+			{{Field: FieldIs, Value: IsUnread}},
+		}},
+	}
+
+	for _, scenario := range scenarios {
+		query, err := Parse(scenario.input)
+		if err != nil {
+			t.Errorf(`Unexpected error for %q: %v`, scenario.input, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(query.Clauses, scenario.expected) {
+			t.Errorf(`Unexpected result for %q, got %+v instead of %+v`, scenario.input, query.Clauses, scenario.expected)
+		}
+	}
+}
+
+func TestParseWithInvalidInput(t *testing.T) {
+	for _, input := range []string{"is:archived", "before:yesterday", "after:2024-13-01", "golang before:01/02/2024"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf(`An error should be returned for %q`, input)
+		}
+	}
+}
+
+func TestFullTextQuery(t *testing.T) {
+	query, err := Parse(`golang "static typing" -rust title:release is:unread`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := query.FullTextQuery(); result != `golang "static typing"` {
+		t.Errorf(`Unexpected full-text query, got %q`, result)
+	}
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// ErrActionRuleNotFound is returned when an action rule does not exist or belongs to another user.
+var ErrActionRuleNotFound = errors.New("store: action rule not found")
+
+const actionRuleColumns = `
+	id,
+	user_id,
+	expression,
+	action,
+	coalesce(label_id, 0),
+	priority
+`
+
+// ActionRule returns an action rule from the database.
+func (s *Storage) ActionRule(userID, actionRuleID int64) (*model.ActionRule, error) {
+	query := `SELECT ` + actionRuleColumns + ` FROM action_rules WHERE user_id=$1 AND id=$2`
+	rule, err := scanActionRule(s.db.QueryRow(query, userID, actionRuleID))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch action rule: %v`, err)
+	default:
+		return rule, nil
+	}
+}
+
+// ActionRules returns all action rules that belongs to the given user, in creation order.
+func (s *Storage) ActionRules(userID int64) (model.ActionRules, error) {
+	query := `SELECT ` + actionRuleColumns + ` FROM action_rules WHERE user_id=$1 ORDER BY id ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch action rules: %v`, err)
+	}
+	defer rows.Close()
+
+	rules := make(model.ActionRules, 0)
+	for rows.Next() {
+		rule, err := scanActionRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch action rule row: %v`, err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// CreateActionRule creates a new action rule.
+func (s *Storage) CreateActionRule(userID int64, request *model.ActionRuleCreationRequest) (*model.ActionRule, error) {
+	query := `
+		INSERT INTO action_rules
+			(user_id, expression, action, label_id, priority)
+		VALUES
+			($1, $2, $3, NULLIF($4, 0), $5)
+		RETURNING
+	` + actionRuleColumns
+
+	rule, err := scanActionRule(s.db.QueryRow(
+		query,
+		userID,
+		request.Expression,
+		request.Action,
+		request.LabelID,
+		request.Priority,
+	))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create action rule for user ID %d: %v`, userID, err)
+	}
+
+	return rule, nil
+}
+
+// UpdateActionRule updates an existing action rule.
+func (s *Storage) UpdateActionRule(rule *model.ActionRule) error {
+	query := `
+		UPDATE action_rules
+		SET
+			expression=$1,
+			action=$2,
+			label_id=NULLIF($3, 0),
+			priority=$4
+		WHERE
+			id=$5 AND user_id=$6
+	`
+	_, err := s.db.Exec(
+		query,
+		rule.Expression,
+		rule.Action,
+		rule.LabelID,
+		rule.Priority,
+		rule.ID,
+		rule.UserID,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update action rule: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveActionRule deletes an action rule.
+func (s *Storage) RemoveActionRule(userID, actionRuleID int64) error {
+	result, err := s.db.Exec(`DELETE FROM action_rules WHERE id=$1 AND user_id=$2`, actionRuleID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this action rule: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this action rule: %v`, err)
+	}
+
+	if count == 0 {
+		return ErrActionRuleNotFound
+	}
+
+	return nil
+}
+
+type actionRuleScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanActionRule(scanner actionRuleScanner) (*model.ActionRule, error) {
+	var rule model.ActionRule
+	err := scanner.Scan(
+		&rule.ID,
+		&rule.UserID,
+		&rule.Expression,
+		&rule.Action,
+		&rule.LabelID,
+		&rule.Priority,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
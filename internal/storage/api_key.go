@@ -4,9 +4,12 @@
 package storage // import "miniflux.app/v2/internal/storage"
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 
+	"github.com/lib/pq"
+
 	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/model"
 )
@@ -21,10 +24,28 @@ func (s *Storage) APIKeyExists(userID int64, description string) bool {
 	return result
 }
 
-// SetAPIKeyUsedTimestamp updates the last used date of an API Key.
-func (s *Storage) SetAPIKeyUsedTimestamp(userID int64, token string) error {
-	query := `UPDATE api_keys SET last_used_at=now() WHERE user_id=$1 and token=$2`
-	_, err := s.db.Exec(query, userID, token)
+// APIKeyByToken returns the API Key with the given token.
+func (s *Storage) APIKeyByToken(token string) (*model.APIKey, error) {
+	query := `
+		SELECT
+			id, user_id, token, description, scopes, expires_at, last_used_at, last_used_ip, created_at
+		FROM
+			api_keys
+		WHERE
+			token=$1
+	`
+	apiKey, err := scanAPIKey(s.db.QueryRow(query, token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return apiKey, err
+}
+
+// SetAPIKeyUsedTimestamp updates the last used date and IP address of an API Key.
+func (s *Storage) SetAPIKeyUsedTimestamp(userID int64, token, clientIP string) error {
+	query := `UPDATE api_keys SET last_used_at=now(), last_used_ip=$3 WHERE user_id=$1 and token=$2`
+	_, err := s.db.Exec(query, userID, token, clientIP)
 	if err != nil {
 		return fmt.Errorf(`store: unable to update last used date for API key: %v`, err)
 	}
@@ -36,7 +57,7 @@ func (s *Storage) SetAPIKeyUsedTimestamp(userID int64, token string) error {
 func (s *Storage) APIKeys(userID int64) (model.APIKeys, error) {
 	query := `
 		SELECT
-			id, user_id, token, description, last_used_at, created_at
+			id, user_id, token, description, scopes, expires_at, last_used_at, last_used_ip, created_at
 		FROM
 			api_keys
 		WHERE
@@ -51,53 +72,45 @@ func (s *Storage) APIKeys(userID int64) (model.APIKeys, error) {
 
 	apiKeys := make(model.APIKeys, 0)
 	for rows.Next() {
-		var apiKey model.APIKey
-		if err := rows.Scan(
-			&apiKey.ID,
-			&apiKey.UserID,
-			&apiKey.Token,
-			&apiKey.Description,
-			&apiKey.LastUsedAt,
-			&apiKey.CreatedAt,
-		); err != nil {
-			return nil, fmt.Errorf(`store: unable to fetch API Key row: %v`, err)
+		apiKey, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
 		}
 
-		apiKeys = append(apiKeys, apiKey)
+		apiKeys = append(apiKeys, *apiKey)
 	}
 
 	return apiKeys, nil
 }
 
 // CreateAPIKey inserts a new API key.
-func (s *Storage) CreateAPIKey(userID int64, description string) (*model.APIKey, error) {
+func (s *Storage) CreateAPIKey(userID int64, request *model.APIKeyCreationRequest) (*model.APIKey, error) {
+	scopes := request.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
 	query := `
 		INSERT INTO api_keys
-			(user_id, token, description)
+			(user_id, token, description, scopes, expires_at)
 		VALUES
-			($1, $2, $3)
+			($1, $2, $3, $4, $5)
 		RETURNING
-			id, user_id, token, description, last_used_at, created_at
+			id, user_id, token, description, scopes, expires_at, last_used_at, last_used_ip, created_at
 	`
-	var apiKey model.APIKey
-	err := s.db.QueryRow(
+	apiKey, err := scanAPIKey(s.db.QueryRow(
 		query,
 		userID,
 		crypto.GenerateRandomStringHex(32),
-		description,
-	).Scan(
-		&apiKey.ID,
-		&apiKey.UserID,
-		&apiKey.Token,
-		&apiKey.Description,
-		&apiKey.LastUsedAt,
-		&apiKey.CreatedAt,
-	)
+		request.Description,
+		pq.Array(scopes),
+		request.ExpiresAt,
+	))
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to create API Key: %v`, err)
 	}
 
-	return &apiKey, nil
+	return apiKey, nil
 }
 
 // DeleteAPIKey deletes an API Key.
@@ -118,3 +131,30 @@ func (s *Storage) DeleteAPIKey(userID, keyID int64) error {
 
 	return nil
 }
+
+type apiKeyScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(scanner apiKeyScanner) (*model.APIKey, error) {
+	var apiKey model.APIKey
+	err := scanner.Scan(
+		&apiKey.ID,
+		&apiKey.UserID,
+		&apiKey.Token,
+		&apiKey.Description,
+		pq.Array(&apiKey.Scopes),
+		&apiKey.ExpiresAt,
+		&apiKey.LastUsedAt,
+		&apiKey.LastUsedIP,
+		&apiKey.CreatedAt,
+	)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, err
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch API Key row: %v`, err)
+	}
+
+	return &apiKey, nil
+}
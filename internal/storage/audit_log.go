@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// auditLogCondition filters the audit log by actor and action when they are set,
+// and matches the search in the username, the target, or the IP address.
+const auditLogCondition = `
+	($1 = 0 OR user_id=$1) AND
+	($2 = '' OR action=$2) AND
+	($3 = '' OR
+	strpos(lower(username), lower($3)) > 0 OR
+	strpos(lower(target), lower($3)) > 0 OR
+	strpos(ip, $3) > 0)
+`
+
+// CreateAuditLogEntry appends an entry to the audit log, the entries are never modified.
+func (s *Storage) CreateAuditLogEntry(entry *model.AuditLogEntry) error {
+	query := `
+		INSERT INTO audit_log
+			(user_id, username, action, target, ip, user_agent)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		entry.UserID,
+		entry.Username,
+		entry.Action,
+		entry.Target,
+		entry.IP,
+		entry.UserAgent,
+	).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create audit log entry %q: %v`, entry.Action, err)
+	}
+
+	return nil
+}
+
+// AuditLog returns the entries of the audit log, the most recent first.
+// The entries are not filtered by user when userID is 0, nor by action when action is empty.
+func (s *Storage) AuditLog(userID int64, action, search string, limit, offset int) (model.AuditLogEntries, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			username,
+			action,
+			target,
+			ip,
+			user_agent,
+			created_at
+		FROM
+			audit_log
+		WHERE
+			` + auditLogCondition + `
+		ORDER BY
+			created_at DESC, id DESC
+		LIMIT $4
+		OFFSET $5
+	`
+	rows, err := s.db.Query(query, userID, action, search, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch audit log: %v`, err)
+	}
+	defer rows.Close()
+
+	entries := make(model.AuditLogEntries, 0)
+	for rows.Next() {
+		var entry model.AuditLogEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.UserID,
+			&entry.Username,
+			&entry.Action,
+			&entry.Target,
+			&entry.IP,
+			&entry.UserAgent,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch audit log row: %v`, err)
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+// CountAuditLog returns the number of audit log entries matching the filters.
+func (s *Storage) CountAuditLog(userID int64, action, search string) (int, error) {
+	query := `SELECT count(*) FROM audit_log WHERE ` + auditLogCondition
+	var count int
+	if err := s.db.QueryRow(query, userID, action, search).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`store: unable to count audit log entries: %v`, err)
+	}
+
+	return count, nil
+}
+
+// CleanOldAuditLog removes the audit log entries older than the given interval.
+func (s *Storage) CleanOldAuditLog(interval time.Duration) (int64, error) {
+	query := `
+		DELETE FROM
+			audit_log
+		WHERE
+			created_at < now() - $1::interval
+	`
+
+	days := max(int(interval/(24*time.Hour)), 1)
+
+	result, err := s.db.Exec(query, fmt.Sprintf("%d days", days))
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to clean old audit log entries: %v`, err)
+	}
+
+	n, _ := result.RowsAffected()
+	return n, nil
+}
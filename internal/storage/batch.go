@@ -40,7 +40,7 @@ func (b *batchBuilder) WithUserID(userID int64) *batchBuilder {
 }
 
 func (b *batchBuilder) WithCategoryID(categoryID int64) *batchBuilder {
-	b.conditions = append(b.conditions, "category_id = $"+strconv.Itoa(len(b.args)+1))
+	b.conditions = append(b.conditions, categorySubtreeCondition("category_id", len(b.args)+1))
 	b.args = append(b.args, categoryID)
 	return b
 }
@@ -4,9 +4,11 @@
 package storage // import "miniflux.app/v2/internal/storage"
 
 import (
+	"cmp"
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/lib/pq"
 	"miniflux.app/v2/internal/model"
@@ -45,8 +47,8 @@ func (s *Storage) CategoryIDExists(userID, categoryID int64) (bool, error) {
 func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 AND id=$2`
-	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally)
+	query := `SELECT id, user_id, title, hide_globally, coalesce(parent_id, 0), block_filter_expression, feed_defaults FROM categories WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.ParentID, &category.BlockFilterExpression, &category.FeedDefaults)
 
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
@@ -60,10 +62,10 @@ func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 
 // FirstCategory returns the first category for the given user.
 func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
+	query := `SELECT id, user_id, title, hide_globally, coalesce(parent_id, 0), block_filter_expression, feed_defaults FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
 
 	var category model.Category
-	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally)
+	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.ParentID, &category.BlockFilterExpression, &category.FeedDefaults)
 
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
@@ -79,8 +81,8 @@ func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
 func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 AND title=$2`
-	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally)
+	query := `SELECT id, user_id, title, hide_globally, coalesce(parent_id, 0), block_filter_expression, feed_defaults FROM categories WHERE user_id=$1 AND title=$2`
+	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.ParentID, &category.BlockFilterExpression, &category.FeedDefaults)
 
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
@@ -94,7 +96,7 @@ func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category,
 
 // Categories returns all categories that belongs to the given user.
 func (s *Storage) Categories(userID int64) (model.Categories, error) {
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 ORDER BY title ASC`
+	query := `SELECT id, user_id, title, hide_globally, coalesce(parent_id, 0), block_filter_expression, feed_defaults FROM categories WHERE user_id=$1 ORDER BY title ASC`
 	rows, err := s.db.Query(query, userID)
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to fetch categories: %v`, err)
@@ -104,17 +106,18 @@ func (s *Storage) Categories(userID int64) (model.Categories, error) {
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.ParentID, &category.BlockFilterExpression, &category.FeedDefaults); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
 		categories = append(categories, category)
 	}
 
-	return categories, nil
+	return categories.SortAsTree(), nil
 }
 
 // CategoriesWithFeedCount returns all categories with the number of feeds, sorted according to sortOrder.
+// The unread counter of a category includes the entries of its subcategories, while the feed counter does not.
 func (s *Storage) CategoriesWithFeedCount(userID int64, sortOrder string) (model.Categories, error) {
 	query := `
 		SELECT
@@ -122,6 +125,9 @@ func (s *Storage) CategoriesWithFeedCount(userID int64, sortOrder string) (model
 			c.user_id,
 			c.title,
 			c.hide_globally,
+			coalesce(c.parent_id, 0),
+			c.block_filter_expression,
+			c.feed_defaults,
 			coalesce(fc.feed_count, 0),
 			coalesce(uc.unread_count, 0)
 		FROM categories c
@@ -164,14 +170,22 @@ func (s *Storage) CategoriesWithFeedCount(userID int64, sortOrder string) (model
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.FeedCount, &category.TotalUnread); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.ParentID, &category.BlockFilterExpression, &category.FeedDefaults, &category.FeedCount, &category.TotalUnread); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
 		categories = append(categories, category)
 	}
 
-	return categories, nil
+	categories.AggregateUnreadCounters()
+	if sortOrder != "alphabetical" {
+		// The query sorts by direct unread count, siblings are sorted again with the counters of their subtree.
+		slices.SortStableFunc(categories, func(a, b model.Category) int {
+			return cmp.Compare(*b.TotalUnread, *a.TotalUnread)
+		})
+	}
+
+	return categories.SortAsTree(), nil
 }
 
 // CreateCategory creates a new category.
@@ -180,25 +194,34 @@ func (s *Storage) CreateCategory(userID int64, request *model.CategoryCreationRe
 
 	query := `
 		INSERT INTO categories
-			(user_id, title, hide_globally)
+			(user_id, title, hide_globally, parent_id, block_filter_expression, feed_defaults)
 		VALUES
-			($1, $2, $3)
+			($1, $2, $3, NULLIF($4, 0), $5, $6)
 		RETURNING
 			id,
 			user_id,
 			title,
-			hide_globally
+			hide_globally,
+			coalesce(parent_id, 0),
+			block_filter_expression,
+			feed_defaults
 	`
 	err := s.db.QueryRow(
 		query,
 		userID,
 		request.Title,
 		request.HideGlobally,
+		request.ParentID,
+		request.BlockFilterExpression,
+		request.FeedDefaults,
 	).Scan(
 		&category.ID,
 		&category.UserID,
 		&category.Title,
 		&category.HideGlobally,
+		&category.ParentID,
+		&category.BlockFilterExpression,
+		&category.FeedDefaults,
 	)
 
 	if err != nil {
@@ -210,11 +233,14 @@ func (s *Storage) CreateCategory(userID int64, request *model.CategoryCreationRe
 
 // UpdateCategory updates an existing category.
 func (s *Storage) UpdateCategory(category *model.Category) error {
-	query := `UPDATE categories SET title=$1, hide_globally=$2 WHERE id=$3 AND user_id=$4`
+	query := `UPDATE categories SET title=$1, hide_globally=$2, parent_id=NULLIF($3, 0), block_filter_expression=$4, feed_defaults=$5 WHERE id=$6 AND user_id=$7`
 	_, err := s.db.Exec(
 		query,
 		category.Title,
 		category.HideGlobally,
+		category.ParentID,
+		category.BlockFilterExpression,
+		category.FeedDefaults,
 		category.ID,
 		category.UserID,
 	)
@@ -226,6 +252,43 @@ func (s *Storage) UpdateCategory(category *model.Category) error {
 	return nil
 }
 
+// categorySubtreeQuery selects the given category and all its subcategories.
+// UNION discards the rows already visited, so an inconsistent tree cannot loop forever.
+const categorySubtreeQuery = `
+	WITH RECURSIVE category_subtree(id) AS (
+		SELECT id FROM categories WHERE id=$%[1]d
+		UNION
+		SELECT c.id FROM categories c INNER JOIN category_subtree cs ON c.parent_id=cs.id
+	)
+	SELECT id FROM category_subtree
+`
+
+// categorySubtreeCondition returns a condition matching a category column against a category and its subcategories.
+func categorySubtreeCondition(column string, argPosition int) string {
+	return column + " IN (" + fmt.Sprintf(categorySubtreeQuery, argPosition) + ")"
+}
+
+// CategorySubtreeIDs returns the ID of the given category and of all its subcategories.
+func (s *Storage) CategorySubtreeIDs(userID, categoryID int64) ([]int64, error) {
+	query := `SELECT id FROM categories WHERE user_id=$2 AND ` + categorySubtreeCondition("id", 1)
+	rows, err := s.db.Query(query, categoryID, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch category subtree: %v`, err)
+	}
+	defer rows.Close()
+
+	var categoryIDs []int64
+	for rows.Next() {
+		var categoryID int64
+		if err := rows.Scan(&categoryID); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch category subtree row: %v`, err)
+		}
+		categoryIDs = append(categoryIDs, categoryID)
+	}
+
+	return categoryIDs, nil
+}
+
 // RemoveCategory deletes a category.
 func (s *Storage) RemoveCategory(userID, categoryID int64) error {
 	query := `DELETE FROM categories WHERE id = $1 AND user_id = $2`
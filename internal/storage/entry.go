@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/events"
 	"miniflux.app/v2/internal/model"
 
 	"github.com/lib/pq"
@@ -80,6 +81,13 @@ func (s *Storage) UpdateEntryTitleAndContent(entry *model.Entry) error {
 // createEntry add a new entry.
 func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 	truncatedTitle, truncatedContent := truncateTitleAndContentForTSVectorField(entry.Title, entry.Content)
+
+	// Action rules may have already marked the entry as read.
+	status := entry.Status
+	if status == "" {
+		status = model.EntryStatusUnread
+	}
+
 	// The WHERE NOT EXISTS guard makes the tombstone check atomic with the insert, so a
 	// concurrent archive committing between an earlier existence check and this statement
 	// cannot bring a deleted entry back as unread.
@@ -99,7 +107,10 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 				changed_at,
 				document_vectors,
 				tags,
-				language
+				language,
+				status,
+				starred,
+				priority
 			)
 		SELECT
 			$1,
@@ -115,7 +126,10 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 			now(),
 			setweight(to_tsvector($11), 'A') || setweight(to_tsvector($12), 'B'),
 			$13,
-			$14
+			$14,
+			$15,
+			$16,
+			$17
 		WHERE NOT EXISTS (
 			SELECT 1 FROM entry_tombstones WHERE feed_id=$9 AND hash=$2
 		)
@@ -138,6 +152,9 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 		truncatedContent,
 		pq.Array(entry.Tags),
 		entry.Language,
+		status,
+		entry.Starred,
+		entry.Priority,
 	).Scan(
 		&entry.ID,
 		&entry.Status,
@@ -160,18 +177,27 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 		}
 	}
 
-	return nil
+	return addLabelsToEntries(tx, entry.UserID, entry.Labels.IDs(), []int64{entry.ID})
 }
 
 // updateEntry updates an entry when a feed is refreshed.
 // Note: we do not update the published date because some feeds do not contains any date,
 // it default to time.Now() which could change the order of items on the history page.
-func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
+func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry, revisionHistorySize int) error {
+	revisionCreated := false
+	if revisionHistorySize > 0 {
+		var err error
+		if revisionCreated, err = s.createEntryRevision(tx, entry); err != nil {
+			return err
+		}
+	}
+
 	truncatedTitle, truncatedContent := truncateTitleAndContentForTSVectorField(entry.Title, entry.Content)
 	query := `
 		UPDATE
 			entries
 		SET
+			revision_count = revision_count + CASE WHEN title <> $1 OR content <> $4 THEN 1 ELSE 0 END,
 			title=$1,
 			url=$2,
 			comments_url=$3,
@@ -206,6 +232,12 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 		return fmt.Errorf(`store: unable to update entry %q: %v`, entry.URL, err)
 	}
 
+	if revisionCreated {
+		if err := s.trimEntryRevisions(tx, entry.ID, revisionHistorySize); err != nil {
+			return err
+		}
+	}
+
 	for _, enclosure := range entry.Enclosures {
 		enclosure.UserID = entry.UserID
 		enclosure.EntryID = entry.ID
@@ -317,27 +349,32 @@ func (s *Storage) GetReadTime(feedID int64, entryHash string) int {
 }
 
 // RefreshFeedEntries updates feed entries while refreshing a feed.
-func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (newEntries model.Entries, err error) {
+// When the title or the content of an existing entry changes, the previous version is kept
+// in the entry revisions, up to revisionHistorySize revisions per entry.
+// It returns the created entries and the number of updated entries.
+func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool, revisionHistorySize int) (newEntries model.Entries, updatedEntries int, err error) {
 	for _, entry := range entries {
 		entry.UserID = userID
 		entry.FeedID = feedID
 
 		tx, err := s.db.Begin()
 		if err != nil {
-			return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
+			return nil, 0, fmt.Errorf(`store: unable to start transaction: %v`, err)
 		}
 
 		entryExists, err := s.entryExists(tx, entry)
 		if err != nil {
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				return nil, fmt.Errorf(`store: unable to rollback transaction: %v (rolled back due to: %v)`, rollbackErr, err)
+				return nil, 0, fmt.Errorf(`store: unable to rollback transaction: %v (rolled back due to: %v)`, rollbackErr, err)
 			}
-			return nil, err
+			return nil, 0, err
 		}
 
 		if entryExists {
 			if updateExistingEntries {
-				err = s.updateEntry(tx, entry)
+				if err = s.updateEntry(tx, entry, revisionHistorySize); err == nil {
+					updatedEntries++
+				}
 			}
 		} else {
 			err = s.createEntry(tx, entry)
@@ -351,20 +388,29 @@ func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries
 
 		if err != nil {
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				return nil, fmt.Errorf(`store: unable to rollback transaction: %v (rolled back due to: %v)`, rollbackErr, err)
+				return nil, 0, fmt.Errorf(`store: unable to rollback transaction: %v (rolled back due to: %v)`, rollbackErr, err)
 			}
-			return nil, err
+			return nil, 0, err
 		}
 
 		if err := tx.Commit(); err != nil {
-			return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+			return nil, 0, fmt.Errorf(`store: unable to commit transaction: %v`, err)
 		}
 	}
 
-	return newEntries, nil
+	if len(newEntries) > 0 {
+		entryIDs := make([]int64, 0, len(newEntries))
+		for _, entry := range newEntries {
+			entryIDs = append(entryIDs, entry.ID)
+		}
+		events.Publish(userID, events.TypeNewEntries, &events.NewEntriesData{FeedID: feedID, EntryIDs: entryIDs})
+	}
+
+	return newEntries, updatedEntries, nil
 }
 
 // ArchiveEntries deletes entries older than the given interval and records tombstones so they are not re-ingested.
+// Starred, shared and labeled entries are kept.
 func (s *Storage) ArchiveEntries(status string, interval time.Duration, limit int) (int64, error) {
 	if interval < 0 || limit <= 0 {
 		return 0, nil
@@ -378,7 +424,10 @@ func (s *Storage) ArchiveEntries(status string, interval time.Duration, limit in
 				status=$1 AND
 				starred is false AND
 				share_code='' AND
-				created_at < now() - $2::interval
+				created_at < now() - $2::interval AND
+				NOT EXISTS (SELECT 1 FROM entry_labels el WHERE el.entry_id=entries.id) AND
+				NOT EXISTS (SELECT 1 FROM read_later_entries rl WHERE rl.entry_id=entries.id) AND
+				NOT EXISTS (SELECT 1 FROM highlights h WHERE h.entry_id=entries.id)
 			ORDER BY created_at ASC
 			FOR UPDATE SKIP LOCKED
 			LIMIT $3
@@ -424,6 +473,14 @@ func (s *Storage) SetEntriesStatus(userID int64, entryIDs []int64, status string
 		return fmt.Errorf(`store: unable to update entries statuses %v: %v`, entryIDs, err)
 	}
 
+	if status == model.EntryStatusRead {
+		if err := s.removeReadLaterEntriesOnRead(userID, entryIDs); err != nil {
+			return err
+		}
+	}
+
+	events.Publish(userID, events.TypeEntriesStatus, &events.EntriesStatusData{EntryIDs: entryIDs, Status: status})
+
 	return nil
 }
 
@@ -450,6 +507,15 @@ func (s *Storage) SetEntriesStatusAndCountVisible(userID int64, entryIDs []int64
 	if err := s.db.QueryRow(query, status, userID, pq.Array(entryIDs)).Scan(&visible); err != nil {
 		return 0, fmt.Errorf(`store: unable to update entries status %v: %v`, entryIDs, err)
 	}
+
+	if status == model.EntryStatusRead {
+		if err := s.removeReadLaterEntriesOnRead(userID, entryIDs); err != nil {
+			return 0, err
+		}
+	}
+
+	events.Publish(userID, events.TypeEntriesStatus, &events.EntriesStatusData{EntryIDs: entryIDs, Status: status})
+
 	return visible, nil
 }
 
@@ -460,35 +526,38 @@ func (s *Storage) SetEntriesStarredState(userID int64, entryIDs []int64, starred
 		return fmt.Errorf(`store: unable to update the starred state %v: %v`, entryIDs, err)
 	}
 
+	events.Publish(userID, events.TypeEntriesStarred, &events.EntriesStarredData{EntryIDs: entryIDs, Starred: starred})
+
 	return nil
 }
 
 // ToggleStarred toggles entry starred value.
 func (s *Storage) ToggleStarred(userID int64, entryID int64) error {
-	query := `UPDATE entries SET starred = NOT starred, changed_at=now() WHERE user_id=$1 AND id=$2`
-	result, err := s.db.Exec(query, userID, entryID)
-	if err != nil {
-		return fmt.Errorf(`store: unable to toggle starred flag for entry #%d: %v`, entryID, err)
-	}
+	query := `UPDATE entries SET starred = NOT starred, changed_at=now() WHERE user_id=$1 AND id=$2 RETURNING starred`
+	var starred bool
+	err := s.db.QueryRow(query, userID, entryID).Scan(&starred)
 
-	count, err := result.RowsAffected()
-	if err != nil {
+	switch {
+	case err == sql.ErrNoRows:
+		return errors.New(`store: nothing has been updated`)
+	case err != nil:
 		return fmt.Errorf(`store: unable to toggle starred flag for entry #%d: %v`, entryID, err)
 	}
 
-	if count == 0 {
-		return errors.New(`store: nothing has been updated`)
-	}
+	events.Publish(userID, events.TypeEntriesStarred, &events.EntriesStarredData{EntryIDs: []int64{entryID}, Starred: starred})
 
 	return nil
 }
 
-// FlushHistory deletes all read entries (non-starred, non-shared) and records tombstones to prevent re-ingestion.
+// FlushHistory deletes all read entries (non-starred, non-shared, unlabeled) and records tombstones to prevent re-ingestion.
 func (s *Storage) FlushHistory(userID int64) error {
 	query := `
 		WITH deleted AS (
 			DELETE FROM entries
 			WHERE user_id=$1 AND status=$2 AND starred is false AND share_code=''
+				AND NOT EXISTS (SELECT 1 FROM entry_labels el WHERE el.entry_id=entries.id)
+				AND NOT EXISTS (SELECT 1 FROM read_later_entries rl WHERE rl.entry_id=entries.id)
+				AND NOT EXISTS (SELECT 1 FROM highlights h WHERE h.entry_id=entries.id)
 			RETURNING feed_id, hash
 		)
 		INSERT INTO entry_tombstones (feed_id, hash)
@@ -502,18 +571,50 @@ func (s *Storage) FlushHistory(userID int64) error {
 	return nil
 }
 
+// markEntriesAsRead runs the query updating entries to the read status and returning their IDs,
+// then removes these entries from the read later queue when the user enabled it, and notifies the clients of the user.
+func (s *Storage) markEntriesAsRead(userID int64, query string, args ...any) ([]int64, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entryIDs []int64
+	for rows.Next() {
+		var entryID int64
+		if err := rows.Scan(&entryID); err != nil {
+			return nil, err
+		}
+		entryIDs = append(entryIDs, entryID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(entryIDs) > 0 {
+		if err := s.removeReadLaterEntriesOnRead(userID, entryIDs); err != nil {
+			return nil, err
+		}
+
+		events.Publish(userID, events.TypeEntriesStatus, &events.EntriesStatusData{EntryIDs: entryIDs, Status: model.EntryStatusRead})
+	}
+
+	return entryIDs, nil
+}
+
 // MarkAllAsRead updates all user entries to the read status.
 func (s *Storage) MarkAllAsRead(userID int64) error {
-	query := `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND status=$3`
-	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread)
+	query := `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND status=$3 RETURNING id`
+	entryIDs, err := s.markEntriesAsRead(userID, query, model.EntryStatusRead, userID, model.EntryStatusUnread)
 	if err != nil {
 		return fmt.Errorf(`store: unable to mark all entries as read: %v`, err)
 	}
 
-	count, _ := result.RowsAffected()
 	slog.Debug("Marked all entries as read",
 		slog.Int64("user_id", userID),
-		slog.Int64("nb_entries", count),
+		slog.Int("nb_entries", len(entryIDs)),
 	)
 
 	return nil
@@ -529,15 +630,16 @@ func (s *Storage) MarkAllAsReadBeforeDate(userID int64, before time.Time) error
 			changed_at=now()
 		WHERE
 			user_id=$2 AND status=$3 AND published_at < $4
+		RETURNING
+			id
 	`
-	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread, before)
+	entryIDs, err := s.markEntriesAsRead(userID, query, model.EntryStatusRead, userID, model.EntryStatusUnread, before)
 	if err != nil {
 		return fmt.Errorf(`store: unable to mark all entries as read before %s: %v`, before.Format(time.RFC3339), err)
 	}
-	count, _ := result.RowsAffected()
 	slog.Debug("Marked all entries as read before date",
 		slog.Int64("user_id", userID),
-		slog.Int64("nb_entries", count),
+		slog.Int("nb_entries", len(entryIDs)),
 		slog.String("before", before.Format(time.RFC3339)),
 	)
 	return nil
@@ -562,16 +664,17 @@ func (s *Storage) MarkGloballyVisibleFeedsAsRead(userID int64) error {
 			AND entries.status=$3
 			AND feeds.hide_globally IS FALSE
 			AND categories.hide_globally IS FALSE
+		RETURNING
+			entries.id
 	`
-	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread)
+	entryIDs, err := s.markEntriesAsRead(userID, query, model.EntryStatusRead, userID, model.EntryStatusUnread)
 	if err != nil {
 		return fmt.Errorf(`store: unable to mark globally visible feeds as read: %v`, err)
 	}
 
-	count, _ := result.RowsAffected()
 	slog.Debug("Marked globally visible feed entries as read",
 		slog.Int64("user_id", userID),
-		slog.Int64("nb_entries", count),
+		slog.Int("nb_entries", len(entryIDs)),
 	)
 
 	return nil
@@ -587,24 +690,25 @@ func (s *Storage) MarkFeedAsRead(userID, feedID int64, before time.Time) error {
 			changed_at=now()
 		WHERE
 			user_id=$2 AND feed_id=$3 AND status=$4 AND published_at < $5
+		RETURNING
+			id
 	`
-	result, err := s.db.Exec(query, model.EntryStatusRead, userID, feedID, model.EntryStatusUnread, before)
+	entryIDs, err := s.markEntriesAsRead(userID, query, model.EntryStatusRead, userID, feedID, model.EntryStatusUnread, before)
 	if err != nil {
 		return fmt.Errorf(`store: unable to mark feed entries as read: %v`, err)
 	}
 
-	count, _ := result.RowsAffected()
 	slog.Debug("Marked feed entries as read",
 		slog.Int64("user_id", userID),
 		slog.Int64("feed_id", feedID),
-		slog.Int64("nb_entries", count),
+		slog.Int("nb_entries", len(entryIDs)),
 		slog.String("before", before.Format(time.RFC3339)),
 	)
 
 	return nil
 }
 
-// MarkCategoryAsRead updates all category entries to the read status.
+// MarkCategoryAsRead updates all category entries to the read status, including the entries of the subcategories.
 func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time) error {
 	query := `
 		UPDATE
@@ -623,18 +727,18 @@ func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time)
 		AND
 			published_at < $4
 		AND
-			feeds.category_id=$5
-	`
-	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread, before, categoryID)
+			` + categorySubtreeCondition("feeds.category_id", 5) + `
+		RETURNING
+			entries.id`
+	entryIDs, err := s.markEntriesAsRead(userID, query, model.EntryStatusRead, userID, model.EntryStatusUnread, before, categoryID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to mark category entries as read: %v`, err)
 	}
 
-	count, _ := result.RowsAffected()
 	slog.Debug("Marked category entries as read",
 		slog.Int64("user_id", userID),
 		slog.Int64("category_id", categoryID),
-		slog.Int64("nb_entries", count),
+		slog.Int("nb_entries", len(entryIDs)),
 		slog.String("before", before.Format(time.RFC3339)),
 	)
 
@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+// CreateEntryArchive saves the archive of an entry with its media, and replaces the previous archive.
+func (s *Storage) CreateEntryArchive(archive *model.EntryArchive, mediaList model.ArchivedMediaList) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO entry_archives
+			(entry_id, user_id, url, title, content)
+		VALUES
+			($1, $2, $3, $4, $5)
+		ON CONFLICT (entry_id) DO UPDATE SET
+			url=excluded.url,
+			title=excluded.title,
+			content=excluded.content,
+			created_at=now()
+		RETURNING
+			created_at
+	`
+	err = tx.QueryRow(
+		query,
+		archive.EntryID,
+		archive.UserID,
+		archive.URL,
+		archive.Title,
+		archive.Content,
+	).Scan(&archive.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create archive for entry #%d: %v`, archive.EntryID, err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM entry_archive_media WHERE entry_id=$1`, archive.EntryID); err != nil {
+		return fmt.Errorf(`store: unable to remove archived media of entry #%d: %v`, archive.EntryID, err)
+	}
+
+	for _, media := range mediaList {
+		// The update is required to return the ID of an existing row.
+		query = `
+			INSERT INTO archive_media
+				(hash, mime_type, content)
+			VALUES
+				($1, $2, $3)
+			ON CONFLICT (hash) DO UPDATE SET
+				mime_type=excluded.mime_type
+			RETURNING
+				id
+		`
+		if err := tx.QueryRow(query, media.Hash, media.MimeType, media.Content).Scan(&media.ID); err != nil {
+			return fmt.Errorf(`store: unable to create archived media %q: %v`, media.URL, err)
+		}
+
+		query = `
+			INSERT INTO entry_archive_media
+				(entry_id, media_id, url, url_hash)
+			VALUES
+				($1, $2, $3, $4)
+			ON CONFLICT (entry_id, url_hash) DO NOTHING
+		`
+		if _, err := tx.Exec(query, archive.EntryID, media.ID, media.URL, crypto.SHA256(media.URL)); err != nil {
+			return fmt.Errorf(`store: unable to link archived media %q to entry #%d: %v`, media.URL, archive.EntryID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit archive of entry #%d: %v`, archive.EntryID, err)
+	}
+
+	archive.MediaCount = len(mediaList)
+	return nil
+}
+
+// EntryArchive returns the archive of an entry, or nil if the entry is not archived.
+func (s *Storage) EntryArchive(userID, entryID int64) (*model.EntryArchive, error) {
+	query := `
+		SELECT
+			a.entry_id,
+			a.user_id,
+			a.url,
+			a.title,
+			a.content,
+			a.created_at,
+			(SELECT count(*) FROM entry_archive_media m WHERE m.entry_id=a.entry_id)
+		FROM
+			entry_archives a
+		WHERE
+			a.user_id=$1 AND a.entry_id=$2
+	`
+	var archive model.EntryArchive
+	err := s.db.QueryRow(query, userID, entryID).Scan(
+		&archive.EntryID,
+		&archive.UserID,
+		&archive.URL,
+		&archive.Title,
+		&archive.Content,
+		&archive.CreatedAt,
+		&archive.MediaCount,
+	)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch archive of entry #%d: %v`, entryID, err)
+	}
+
+	return &archive, nil
+}
+
+// EntryArchivesSize returns the size in bytes of the archives of a user, including the media.
+func (s *Storage) EntryArchivesSize(userID int64) (int64, error) {
+	query := `
+		SELECT
+			coalesce(sum(octet_length(a.content)), 0) +
+			coalesce((
+				SELECT
+					sum(octet_length(m.content))
+				FROM
+					entry_archive_media em
+				JOIN
+					entry_archives ea ON ea.entry_id=em.entry_id
+				JOIN
+					archive_media m ON m.id=em.media_id
+				WHERE
+					ea.user_id=$1
+			), 0)
+		FROM
+			entry_archives a
+		WHERE
+			a.user_id=$1
+	`
+	var size int64
+	if err := s.db.QueryRow(query, userID).Scan(&size); err != nil {
+		return 0, fmt.Errorf(`store: unable to compute the size of the archives of user #%d: %v`, userID, err)
+	}
+
+	return size, nil
+}
+
+// EntryArchiveMedia returns the media stored with the archive of an entry.
+func (s *Storage) EntryArchiveMedia(userID, entryID int64) (model.ArchivedMediaList, error) {
+	query := `
+		SELECT
+			m.id,
+			em.url,
+			m.hash,
+			m.mime_type,
+			m.content
+		FROM
+			entry_archive_media em
+		JOIN
+			entry_archives a ON a.entry_id=em.entry_id
+		JOIN
+			archive_media m ON m.id=em.media_id
+		WHERE
+			a.user_id=$1 AND a.entry_id=$2
+		ORDER BY
+			em.url
+	`
+	rows, err := s.db.Query(query, userID, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch archived media of entry #%d: %v`, entryID, err)
+	}
+	defer rows.Close()
+
+	mediaList := make(model.ArchivedMediaList, 0)
+	for rows.Next() {
+		var media model.ArchivedMedia
+		if err := rows.Scan(&media.ID, &media.URL, &media.Hash, &media.MimeType, &media.Content); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch archived media row: %v`, err)
+		}
+		mediaList = append(mediaList, &media)
+	}
+
+	return mediaList, nil
+}
+
+// ArchivedMediaByURL returns an archived copy of the media, or nil if the URL is not archived.
+func (s *Storage) ArchivedMediaByURL(mediaURL string) (*model.ArchivedMedia, error) {
+	query := `
+		SELECT
+			m.id,
+			em.url,
+			m.hash,
+			m.mime_type,
+			m.content
+		FROM
+			entry_archive_media em
+		JOIN
+			archive_media m ON m.id=em.media_id
+		WHERE
+			em.url_hash=$1
+		LIMIT 1
+	`
+	var media model.ArchivedMedia
+	err := s.db.QueryRow(query, crypto.SHA256(mediaURL)).Scan(&media.ID, &media.URL, &media.Hash, &media.MimeType, &media.Content)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch archived media %q: %v`, mediaURL, err)
+	}
+
+	return &media, nil
+}
+
+// EntriesToArchive returns the starred entries, and the entries of the feeds with archiving enabled,
+// that have not been archived yet. The users whose archives, including the media, are larger than
+// the maximum size in bytes are skipped, unless the maximum size is 0.
+func (s *Storage) EntriesToArchive(limit int, maxSizePerUser int64) (model.Entries, error) {
+	query := `
+		SELECT
+			e.id,
+			e.user_id
+		FROM
+			entries e
+		JOIN
+			feeds f ON f.id=e.feed_id
+		WHERE
+			(e.starred is true OR f.archive_entries is true) AND
+			NOT EXISTS (SELECT 1 FROM entry_archives a WHERE a.entry_id=e.id) AND
+			($2::bigint = 0 OR e.user_id NOT IN (
+				SELECT
+					a.user_id
+				FROM
+					entry_archives a
+				LEFT JOIN (
+					SELECT
+						em.entry_id,
+						sum(octet_length(m.content)) AS size
+					FROM
+						entry_archive_media em
+					JOIN
+						archive_media m ON m.id=em.media_id
+					GROUP BY
+						em.entry_id
+				) media ON media.entry_id=a.entry_id
+				GROUP BY
+					a.user_id
+				HAVING
+					sum(octet_length(a.content) + coalesce(media.size, 0)) >= $2::bigint
+			))
+		ORDER BY
+			e.id ASC
+		LIMIT $1
+	`
+	rows, err := s.db.Query(query, limit, maxSizePerUser)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entries to archive: %v`, err)
+	}
+	defer rows.Close()
+
+	type entryKey struct {
+		entryID int64
+		userID  int64
+	}
+
+	var keys []entryKey
+	for rows.Next() {
+		var key entryKey
+		if err := rows.Scan(&key.entryID, &key.userID); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry to archive: %v`, err)
+		}
+		keys = append(keys, key)
+	}
+
+	entries := make(model.Entries, 0, len(keys))
+	for _, key := range keys {
+		entry, err := s.NewEntryQueryBuilder(key.userID).WithEntryIDs(key.entryID).GetEntry()
+		if err != nil {
+			return nil, err
+		}
+
+		if entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// CleanupOrphanArchivedMedia removes the media that are no longer referenced by any archive.
+func (s *Storage) CleanupOrphanArchivedMedia() (int64, error) {
+	result, err := s.db.Exec(`
+		DELETE FROM archive_media
+		WHERE NOT EXISTS (
+			SELECT 1 FROM entry_archive_media WHERE entry_archive_media.media_id = archive_media.id
+		)
+	`)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to clean orphan archived media: %v`, err)
+	}
+
+	n, _ := result.RowsAffected()
+	return n, nil
+}
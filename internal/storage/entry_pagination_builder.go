@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/lib/pq"
 	"miniflux.app/v2/internal/model"
@@ -24,11 +25,13 @@ type entryPaginationBuilder struct {
 	direction  string
 }
 
-// WithSearchQuery adds full-text search query to the condition.
+// WithSearchQuery adds a search query to the condition, see the searchquery package for the syntax.
 func (e *entryPaginationBuilder) WithSearchQuery(query string) *entryPaginationBuilder {
 	if query != "" {
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ websearch_to_tsquery($%d)", len(e.args)+1))
-		e.args = append(e.args, query)
+		if condition, _, args := searchQueryCondition(query, e.args); condition != "" {
+			e.conditions = append(e.conditions, condition)
+			e.args = args
+		}
 	}
 
 	return e
@@ -51,10 +54,10 @@ func (e *entryPaginationBuilder) WithFeedID(feedID int64) *entryPaginationBuilde
 	return e
 }
 
-// WithCategoryID adds category_id to the condition.
+// WithCategoryID adds category_id to the condition, the subcategories are included.
 func (e *entryPaginationBuilder) WithCategoryID(categoryID int64) *entryPaginationBuilder {
 	if categoryID != 0 {
-		e.conditions = append(e.conditions, "f.category_id = $"+strconv.Itoa(len(e.args)+1))
+		e.conditions = append(e.conditions, categorySubtreeCondition("f.category_id", len(e.args)+1))
 		e.args = append(e.args, categoryID)
 	}
 
@@ -99,6 +102,25 @@ func (e *entryPaginationBuilder) WithTags(tags []string) *entryPaginationBuilder
 	return e
 }
 
+// WithSavedSearch adds the query and the constraints of a saved search to the condition.
+func (e *entryPaginationBuilder) WithSavedSearch(savedSearch *model.SavedSearch) *entryPaginationBuilder {
+	e.WithSearchQuery(savedSearch.Query)
+	e.WithStatus(savedSearch.Status)
+	e.WithFeedID(savedSearch.FeedID)
+	e.WithCategoryID(savedSearch.CategoryID)
+
+	if savedSearch.Starred {
+		e.WithStarred()
+	}
+
+	if savedSearch.MaxAgeDays > 0 {
+		e.conditions = append(e.conditions, "e.published_at > $"+strconv.Itoa(len(e.args)+1))
+		e.args = append(e.args, time.Now().AddDate(0, 0, -savedSearch.MaxAgeDays))
+	}
+
+	return e
+}
+
 // WithGloballyVisible adds global visibility to the condition.
 func (e *entryPaginationBuilder) WithGloballyVisible() *entryPaginationBuilder {
 	e.conditions = append(e.conditions, "not c.hide_globally")
@@ -42,22 +42,35 @@ func (e *EntryQueryBuilder) WithoutContent() *EntryQueryBuilder {
 	return e
 }
 
-// WithSearchQuery adds full-text search query to the condition.
+// WithSearchQuery adds a search query to the condition, see the searchquery package for the syntax.
+// Results are ranked by relevance when the query contains full-text terms.
 func (e *EntryQueryBuilder) WithSearchQuery(query string) *EntryQueryBuilder {
-	if query != "" {
-		nArgs := len(e.args) + 1
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ websearch_to_tsquery($%d)", nArgs))
-		e.args = append(e.args, query)
+	rankQuery := e.withSearchCondition(query)
+	if rankQuery != "" {
+		e.args = append(e.args, rankQuery)
 
 		// 0.0000001 = 0.1 / (seconds_in_a_day)
 
 		e.sortExpressions = append(e.sortExpressions,
-			fmt.Sprintf("ts_rank(document_vectors, websearch_to_tsquery($%d)) - extract (epoch from now() - published_at)::float * 0.0000001 DESC", nArgs),
+			fmt.Sprintf("ts_rank(document_vectors, websearch_to_tsquery($%d)) - extract (epoch from now() - published_at)::float * 0.0000001 DESC", len(e.args)),
 		)
 	}
 	return e
 }
 
+func (e *EntryQueryBuilder) withSearchCondition(query string) (rankQuery string) {
+	if query == "" {
+		return ""
+	}
+
+	condition, rankQuery, args := searchQueryCondition(query, e.args)
+	if condition != "" {
+		e.conditions = append(e.conditions, condition)
+		e.args = args
+	}
+	return rankQuery
+}
+
 // WithStarred adds starred filter.
 func (e *EntryQueryBuilder) WithStarred(starred bool) *EntryQueryBuilder {
 	if starred {
@@ -68,6 +81,18 @@ func (e *EntryQueryBuilder) WithStarred(starred bool) *EntryQueryBuilder {
 	return e
 }
 
+// WithReadLater adds a filter on the entries of the read later queue.
+func (e *EntryQueryBuilder) WithReadLater() *EntryQueryBuilder {
+	e.conditions = append(e.conditions, "EXISTS (SELECT 1 FROM read_later_entries rl WHERE rl.entry_id = e.id)")
+	return e
+}
+
+// WithReadLaterSorting sorts the entries in the order of the read later queue.
+func (e *EntryQueryBuilder) WithReadLaterSorting() *EntryQueryBuilder {
+	e.sortExpressions = append(e.sortExpressions, "(SELECT rl.position FROM read_later_entries rl WHERE rl.entry_id = e.id) ASC")
+	return e
+}
+
 // BeforeChangedDate adds a condition < changed_at
 func (e *EntryQueryBuilder) BeforeChangedDate(date time.Time) *EntryQueryBuilder {
 	e.conditions = append(e.conditions, "e.changed_at < $"+strconv.Itoa(len(e.args)+1))
@@ -135,10 +160,10 @@ func (e *EntryQueryBuilder) WithFeedID(feedID int64) *EntryQueryBuilder {
 	return e
 }
 
-// WithCategoryID filter by category ID.
+// WithCategoryID filter by category ID, the entries of the subcategories are included.
 func (e *EntryQueryBuilder) WithCategoryID(categoryID int64) *EntryQueryBuilder {
 	if categoryID > 0 {
-		e.conditions = append(e.conditions, "f.category_id = $"+strconv.Itoa(len(e.args)+1))
+		e.conditions = append(e.conditions, categorySubtreeCondition("f.category_id", len(e.args)+1))
 		e.args = append(e.args, categoryID)
 	}
 	return e
@@ -165,6 +190,35 @@ func (e *EntryQueryBuilder) WithTags(tags ...string) *EntryQueryBuilder {
 	return e
 }
 
+// WithLabelID filter by a user-defined label.
+func (e *EntryQueryBuilder) WithLabelID(labelID int64) *EntryQueryBuilder {
+	if labelID > 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM entry_labels el WHERE el.entry_id = e.id AND el.label_id = $%d)", len(e.args)+1))
+		e.args = append(e.args, labelID)
+	}
+	return e
+}
+
+// WithSavedSearch filter by the query and the constraints of a saved search.
+// Unlike WithSearchQuery, the results are not ranked by relevance.
+func (e *EntryQueryBuilder) WithSavedSearch(savedSearch *model.SavedSearch) *EntryQueryBuilder {
+	e.withSearchCondition(savedSearch.Query)
+
+	if savedSearch.Status != "" {
+		e.WithStatuses(savedSearch.Status)
+	}
+
+	if savedSearch.Starred {
+		e.WithStarred(true)
+	}
+
+	if savedSearch.MaxAgeDays > 0 {
+		e.AfterPublishedDate(time.Now().AddDate(0, 0, -savedSearch.MaxAgeDays))
+	}
+
+	return e.WithFeedID(savedSearch.FeedID).WithCategoryID(savedSearch.CategoryID)
+}
+
 // WithoutStatus set the entry status that should not be returned.
 func (e *EntryQueryBuilder) WithoutStatus(status string) *EntryQueryBuilder {
 	if status != "" {
@@ -317,10 +371,13 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 			e.status,
 			e.starred,
 			e.reading_time,
+			e.priority,
 			e.created_at,
 			e.changed_at,
 			e.tags,
 			e.language,
+			e.revision_count,
+			EXISTS (SELECT 1 FROM read_later_entries rl WHERE rl.entry_id=e.id) AS read_later,
 			f.title as feed_title,
 			f.feed_url,
 			f.site_url,
@@ -338,6 +395,7 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 			f.hide_globally,
 			f.no_media_player,
 			f.webhook_url,
+			f.archive_entries,
 			fi.icon_id,
 			i.external_id AS icon_external_id,
 			u.timezone
@@ -389,10 +447,13 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 			&entry.Status,
 			&entry.Starred,
 			&entry.ReadingTime,
+			&entry.Priority,
 			&entry.CreatedAt,
 			&entry.ChangedAt,
 			pq.Array(&entry.Tags),
 			&entry.Language,
+			&entry.RevisionCount,
+			&entry.ReadLater,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
 			&entry.Feed.SiteURL,
@@ -410,6 +471,7 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 			&entry.Feed.HideGlobally,
 			&entry.Feed.NoMediaPlayer,
 			&entry.Feed.WebhookURL,
+			&entry.Feed.ArchiveEntries,
 			&iconID,
 			&externalIconID,
 			&tz,
@@ -448,6 +510,19 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 		entryIDs = append(entryIDs, entry.ID)
 	}
 
+	if len(entryIDs) > 0 {
+		labels, err := e.store.LabelsByEntryIDs(entryIDs)
+		if err != nil {
+			return nil, 0, fmt.Errorf("store: unable to fetch labels: %w", err)
+		}
+
+		for entryID, entryLabels := range labels {
+			if entry, exists := entryMap[entryID]; exists {
+				entry.Labels = entryLabels
+			}
+		}
+	}
+
 	if e.fetchEnclosures && len(entryIDs) > 0 {
 		enclosures, err := e.store.EnclosuresByEntryIDs(entryIDs)
 		if err != nil {
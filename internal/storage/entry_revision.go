@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// EntryRevisions returns the previous versions of an entry, the most recent first.
+func (s *Storage) EntryRevisions(userID, entryID int64) (model.EntryRevisions, error) {
+	query := `
+		SELECT
+			r.id,
+			r.entry_id,
+			r.title,
+			r.content,
+			r.created_at
+		FROM
+			entry_revisions r
+		INNER JOIN
+			entries e ON e.id=r.entry_id
+		WHERE
+			e.user_id=$1 AND r.entry_id=$2
+		ORDER BY
+			r.id DESC
+	`
+	rows, err := s.db.Query(query, userID, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch revisions of entry #%d: %v`, entryID, err)
+	}
+	defer rows.Close()
+
+	revisions := make(model.EntryRevisions, 0)
+	for rows.Next() {
+		var revision model.EntryRevision
+		if err := rows.Scan(
+			&revision.ID,
+			&revision.EntryID,
+			&revision.Title,
+			&revision.Content,
+			&revision.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry revision row: %v`, err)
+		}
+
+		revisions = append(revisions, &revision)
+	}
+
+	return revisions, nil
+}
+
+// createEntryRevision copies the stored title and content of the entry
+// when they are different from the new version, it returns true if a revision was created.
+func (s *Storage) createEntryRevision(tx *sql.Tx, entry *model.Entry) (bool, error) {
+	query := `
+		INSERT INTO entry_revisions
+			(entry_id, title, content)
+		SELECT
+			id, title, content
+		FROM
+			entries
+		WHERE
+			user_id=$1 AND feed_id=$2 AND hash=$3 AND (title <> $4 OR content <> $5)
+	`
+	result, err := tx.Exec(query, entry.UserID, entry.FeedID, entry.Hash, entry.Title, entry.Content)
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to create revision of entry %q: %v`, entry.URL, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to create revision of entry %q: %v`, entry.URL, err)
+	}
+
+	return count > 0, nil
+}
+
+// trimEntryRevisions removes the oldest revisions of the entry beyond the history size.
+func (s *Storage) trimEntryRevisions(tx *sql.Tx, entryID int64, historySize int) error {
+	query := `
+		DELETE FROM entry_revisions
+		WHERE
+			entry_id=$1 AND
+			id NOT IN (
+				SELECT id FROM entry_revisions WHERE entry_id=$1 ORDER BY id DESC LIMIT $2
+			)
+	`
+	if _, err := tx.Exec(query, entryID, historySize); err != nil {
+		return fmt.Errorf(`store: unable to trim revisions of entry #%d: %v`, entryID, err)
+	}
+
+	return nil
+}
@@ -11,7 +11,10 @@ import (
 	"time"
 
 	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/events"
 	"miniflux.app/v2/internal/model"
+
+	"github.com/lib/pq"
 )
 
 type byStateAndName struct{ f model.Feeds }
@@ -256,10 +259,11 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 			description,
 			proxy_url,
 			ignore_entry_updates,
-			language
+			language,
+			block_filter_expression
 		)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33)
 		RETURNING
 			id
 	`
@@ -297,6 +301,7 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.ProxyURL,
 		feed.IgnoreEntryUpdates,
 		feed.Language,
+		feed.BlockFilterExpression,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
@@ -337,6 +342,7 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 }
 
 // UpdateFeed updates an existing feed.
+// The clients of the user are notified when the errors of the feed are cleared.
 func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 	query := `
 		UPDATE
@@ -381,11 +387,26 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 			pushover_priority=$37,
 			proxy_url=$38,
 			ignore_entry_updates=$39,
-			language=$40
+			language=$40,
+			block_filter_expression=$41,
+			pending_feed_url=$42,
+			pending_feed_url_count=$43,
+			archive_entries=$44,
+			overridden_settings=$45
+		FROM
+			(SELECT id, parsing_error_count FROM feeds WHERE id=$46 AND user_id=$47) AS previous
 		WHERE
-			id=$41 AND user_id=$42
+			feeds.id=previous.id
+		RETURNING
+			previous.parsing_error_count
 	`
-	_, err = s.db.Exec(query,
+	overriddenSettings := feed.OverriddenSettings
+	if overriddenSettings == nil {
+		overriddenSettings = []string{}
+	}
+
+	var previousErrorCount int
+	err = s.db.QueryRow(query,
 		feed.FeedURL,
 		feed.SiteURL,
 		feed.Title,
@@ -426,13 +447,25 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.ProxyURL,
 		feed.IgnoreEntryUpdates,
 		feed.Language,
+		feed.BlockFilterExpression,
+		feed.PendingFeedURL,
+		feed.PendingFeedURLCount,
+		feed.ArchiveEntries,
+		pq.Array(overriddenSettings),
 		feed.ID,
 		feed.UserID,
-	)
-	if err != nil {
+	).Scan(&previousErrorCount)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil
+	case err != nil:
 		return fmt.Errorf(`store: unable to update feed #%d (%s): %v`, feed.ID, feed.FeedURL, err)
 	}
 
+	if previousErrorCount > 0 && feed.ParsingErrorCount == 0 {
+		events.Publish(feed.UserID, events.TypeFeedError, &events.FeedErrorData{FeedID: feed.ID})
+	}
+
 	return nil
 }
 
@@ -461,6 +494,12 @@ func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 		return fmt.Errorf(`store: unable to update feed error #%d (%s): %v`, feed.ID, feed.FeedURL, err)
 	}
 
+	events.Publish(feed.UserID, events.TypeFeedError, &events.FeedErrorData{
+		FeedID:            feed.ID,
+		ParsingErrorCount: feed.ParsingErrorCount,
+		ParsingErrorMsg:   feed.ParsingErrorMsg,
+	})
+
 	return nil
 }
 
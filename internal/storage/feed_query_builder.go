@@ -159,6 +159,7 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.keeplist_rules,
 			f.block_filter_entry_rules,
 			f.keep_filter_entry_rules,
+			f.block_filter_expression,
 			f.crawler,
 			f.user_agent,
 			f.cookie,
@@ -173,6 +174,8 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.category_id,
 			c.title as category_title,
 			c.hide_globally as category_hidden,
+			c.block_filter_expression as category_block_filter_expression,
+			c.feed_defaults as category_feed_defaults,
 			fi.icon_id,
 			i.external_id,
 			u.timezone,
@@ -185,7 +188,11 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.pushover_enabled,
 			f.pushover_priority,
 			f.proxy_url,
-			f.ignore_entry_updates
+			f.ignore_entry_updates,
+			f.pending_feed_url,
+			f.pending_feed_url_count,
+			f.archive_entries,
+			f.overridden_settings
 		FROM
 			feeds f
 		LEFT JOIN
@@ -242,6 +249,7 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			&feed.KeeplistRules,
 			&feed.BlockFilterEntryRules,
 			&feed.KeepFilterEntryRules,
+			&feed.BlockFilterExpression,
 			&feed.Crawler,
 			&feed.UserAgent,
 			&feed.Cookie,
@@ -256,6 +264,8 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&feed.Category.HideGlobally,
+			&feed.Category.BlockFilterExpression,
+			&feed.Category.FeedDefaults,
 			&iconID,
 			&externalIconID,
 			&tz,
@@ -269,6 +279,10 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			&feed.PushoverPriority,
 			&feed.ProxyURL,
 			&feed.IgnoreEntryUpdates,
+			&feed.PendingFeedURL,
+			&feed.PendingFeedURLCount,
+			&feed.ArchiveEntries,
+			pq.Array(&feed.OverriddenSettings),
 		)
 		if err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch feeds row: %w`, err)
@@ -295,6 +309,7 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 		feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
 		feed.NextCheckAt = timezone.Convert(tz, feed.NextCheckAt)
 		feed.Category.UserID = feed.UserID
+		feed.InheritedSettings = feed.WithCategoryDefaults().InheritedSettings
 		feeds = append(feeds, &feed)
 	}
 
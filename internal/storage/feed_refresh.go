@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// CreateFeedRefresh records a refresh attempt and removes the oldest attempts beyond the history size.
+func (s *Storage) CreateFeedRefresh(refresh *model.FeedRefresh, historySize int) error {
+	if historySize <= 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO feed_refresh_history
+			(feed_id, status_code, duration_ms, body_size, not_modified, shared, new_entries, updated_entries, error_message)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING
+			id, checked_at
+	`
+	err := s.db.QueryRow(
+		query,
+		refresh.FeedID,
+		refresh.StatusCode,
+		refresh.Duration,
+		refresh.BodySize,
+		refresh.NotModified,
+		refresh.Shared,
+		refresh.NewEntries,
+		refresh.UpdatedEntries,
+		refresh.ErrorMessage,
+	).Scan(&refresh.ID, &refresh.CheckedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create feed refresh for feed #%d: %v`, refresh.FeedID, err)
+	}
+
+	query = `
+		DELETE FROM feed_refresh_history
+		WHERE
+			feed_id=$1 AND
+			id NOT IN (
+				SELECT id FROM feed_refresh_history WHERE feed_id=$1 ORDER BY checked_at DESC, id DESC LIMIT $2
+			)
+	`
+	if _, err := s.db.Exec(query, refresh.FeedID, historySize); err != nil {
+		return fmt.Errorf(`store: unable to trim refresh history of feed #%d: %v`, refresh.FeedID, err)
+	}
+
+	return nil
+}
+
+// FeedRefreshHistory returns the latest refresh attempts of a feed, most recent first.
+func (s *Storage) FeedRefreshHistory(userID, feedID int64, limit int) (model.FeedRefreshHistory, error) {
+	query := `
+		SELECT
+			h.id,
+			h.feed_id,
+			h.checked_at,
+			h.status_code,
+			h.duration_ms,
+			h.body_size,
+			h.not_modified,
+			h.shared,
+			h.new_entries,
+			h.updated_entries,
+			h.error_message
+		FROM
+			feed_refresh_history h
+		JOIN
+			feeds f ON f.id=h.feed_id
+		WHERE
+			f.user_id=$1 AND h.feed_id=$2
+		ORDER BY
+			h.checked_at DESC, h.id DESC
+		LIMIT $3
+	`
+	rows, err := s.db.Query(query, userID, feedID, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch refresh history of feed #%d: %v`, feedID, err)
+	}
+	defer rows.Close()
+
+	history := make(model.FeedRefreshHistory, 0)
+	for rows.Next() {
+		var refresh model.FeedRefresh
+		err := rows.Scan(
+			&refresh.ID,
+			&refresh.FeedID,
+			&refresh.CheckedAt,
+			&refresh.StatusCode,
+			&refresh.Duration,
+			&refresh.BodySize,
+			&refresh.NotModified,
+			&refresh.Shared,
+			&refresh.NewEntries,
+			&refresh.UpdatedEntries,
+			&refresh.ErrorMessage,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch feed refresh row: %v`, err)
+		}
+
+		history = append(history, &refresh)
+	}
+
+	return history, nil
+}
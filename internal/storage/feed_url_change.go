@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// CreateFeedURLChange records an automatic change of the feed URL.
+func (s *Storage) CreateFeedURLChange(change *model.FeedURLChange) error {
+	query := `
+		INSERT INTO feed_url_changes
+			(feed_id, old_url, new_url, reason)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		change.FeedID,
+		change.OldURL,
+		change.NewURL,
+		change.Reason,
+	).Scan(&change.ID, &change.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create feed URL change for feed #%d: %v`, change.FeedID, err)
+	}
+
+	return nil
+}
+
+// FeedURLChanges returns the automatic URL changes of a feed, most recent first.
+func (s *Storage) FeedURLChanges(userID, feedID int64) (model.FeedURLChanges, error) {
+	query := `
+		SELECT
+			c.id,
+			c.feed_id,
+			c.old_url,
+			c.new_url,
+			c.reason,
+			c.created_at
+		FROM
+			feed_url_changes c
+		JOIN
+			feeds f ON f.id=c.feed_id
+		WHERE
+			f.user_id=$1 AND c.feed_id=$2
+		ORDER BY
+			c.created_at DESC, c.id DESC
+	`
+	rows, err := s.db.Query(query, userID, feedID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch URL changes of feed #%d: %v`, feedID, err)
+	}
+	defer rows.Close()
+
+	changes := make(model.FeedURLChanges, 0)
+	for rows.Next() {
+		var change model.FeedURLChange
+		err := rows.Scan(
+			&change.ID,
+			&change.FeedID,
+			&change.OldURL,
+			&change.NewURL,
+			&change.Reason,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch feed URL change row: %v`, err)
+		}
+
+		changes = append(changes, &change)
+	}
+
+	return changes, nil
+}
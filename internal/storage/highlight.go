@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// highlightSearchCondition matches the highlighted text, the note, or the title of the entry, ignoring the case.
+const highlightSearchCondition = `
+	($2 = '' OR
+	strpos(lower(h.text), lower($2)) > 0 OR
+	strpos(lower(h.note), lower($2)) > 0 OR
+	strpos(lower(e.title), lower($2)) > 0)
+`
+
+// Highlights returns the highlights of the user matching the search, the most recent first.
+func (s *Storage) Highlights(userID int64, search string, limit, offset int) (model.Highlights, error) {
+	query := `
+		SELECT
+			h.id,
+			h.user_id,
+			h.entry_id,
+			h.text,
+			h.prefix,
+			h.suffix,
+			h.start_offset,
+			h.end_offset,
+			h.note,
+			h.created_at,
+			h.updated_at,
+			e.feed_id,
+			e.title,
+			e.url
+		FROM
+			highlights h
+		JOIN
+			entries e ON e.id=h.entry_id
+		WHERE
+			h.user_id=$1 AND ` + highlightSearchCondition + `
+		ORDER BY
+			h.created_at DESC, h.id DESC
+		LIMIT $3
+		OFFSET $4
+	`
+	rows, err := s.db.Query(query, userID, search, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch highlights: %v`, err)
+	}
+	defer rows.Close()
+
+	highlights := make(model.Highlights, 0)
+	for rows.Next() {
+		var highlight model.Highlight
+		if err := rows.Scan(
+			&highlight.ID,
+			&highlight.UserID,
+			&highlight.EntryID,
+			&highlight.Text,
+			&highlight.Prefix,
+			&highlight.Suffix,
+			&highlight.StartOffset,
+			&highlight.EndOffset,
+			&highlight.Note,
+			&highlight.CreatedAt,
+			&highlight.UpdatedAt,
+			&highlight.FeedID,
+			&highlight.EntryTitle,
+			&highlight.EntryURL,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch highlight row: %v`, err)
+		}
+
+		highlights = append(highlights, &highlight)
+	}
+
+	return highlights, nil
+}
+
+// CountHighlights returns the number of highlights of the user matching the search.
+func (s *Storage) CountHighlights(userID int64, search string) (int, error) {
+	query := `
+		SELECT
+			count(*)
+		FROM
+			highlights h
+		JOIN
+			entries e ON e.id=h.entry_id
+		WHERE
+			h.user_id=$1 AND ` + highlightSearchCondition
+	var count int
+	if err := s.db.QueryRow(query, userID, search).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`store: unable to count highlights: %v`, err)
+	}
+
+	return count, nil
+}
+
+// EntryHighlights returns the highlights of an entry, in the order of the text.
+func (s *Storage) EntryHighlights(userID, entryID int64) (model.Highlights, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			entry_id,
+			text,
+			prefix,
+			suffix,
+			start_offset,
+			end_offset,
+			note,
+			created_at,
+			updated_at
+		FROM
+			highlights
+		WHERE
+			user_id=$1 AND entry_id=$2
+		ORDER BY
+			start_offset ASC, id ASC
+	`
+	rows, err := s.db.Query(query, userID, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch highlights of entry #%d: %v`, entryID, err)
+	}
+	defer rows.Close()
+
+	highlights := make(model.Highlights, 0)
+	for rows.Next() {
+		highlight, err := scanHighlight(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		highlights = append(highlights, highlight)
+	}
+
+	return highlights, nil
+}
+
+// HighlightByID returns a highlight of the user.
+func (s *Storage) HighlightByID(userID, highlightID int64) (*model.Highlight, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			entry_id,
+			text,
+			prefix,
+			suffix,
+			start_offset,
+			end_offset,
+			note,
+			created_at,
+			updated_at
+		FROM
+			highlights
+		WHERE
+			user_id=$1 AND id=$2
+	`
+	highlight, err := scanHighlight(s.db.QueryRow(query, userID, highlightID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return highlight, err
+}
+
+// CreateHighlight stores a new highlight.
+func (s *Storage) CreateHighlight(highlight *model.Highlight) error {
+	query := `
+		INSERT INTO highlights
+			(user_id, entry_id, text, prefix, suffix, start_offset, end_offset, note)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING
+			id, created_at, updated_at
+	`
+	err := s.db.QueryRow(
+		query,
+		highlight.UserID,
+		highlight.EntryID,
+		highlight.Text,
+		highlight.Prefix,
+		highlight.Suffix,
+		highlight.StartOffset,
+		highlight.EndOffset,
+		highlight.Note,
+	).Scan(&highlight.ID, &highlight.CreatedAt, &highlight.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create highlight on entry #%d: %v`, highlight.EntryID, err)
+	}
+
+	return nil
+}
+
+// UpdateHighlight updates the note of a highlight.
+func (s *Storage) UpdateHighlight(highlight *model.Highlight) error {
+	query := `UPDATE highlights SET note=$1, updated_at=now() WHERE user_id=$2 AND id=$3 RETURNING updated_at`
+	if err := s.db.QueryRow(query, highlight.Note, highlight.UserID, highlight.ID).Scan(&highlight.UpdatedAt); err != nil {
+		return fmt.Errorf(`store: unable to update highlight #%d: %v`, highlight.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveHighlight deletes a highlight.
+func (s *Storage) RemoveHighlight(userID, highlightID int64) error {
+	query := `DELETE FROM highlights WHERE user_id=$1 AND id=$2`
+	if _, err := s.db.Exec(query, userID, highlightID); err != nil {
+		return fmt.Errorf(`store: unable to remove highlight #%d: %v`, highlightID, err)
+	}
+
+	return nil
+}
+
+type highlightScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanHighlight(scanner highlightScanner) (*model.Highlight, error) {
+	var highlight model.Highlight
+	err := scanner.Scan(
+		&highlight.ID,
+		&highlight.UserID,
+		&highlight.EntryID,
+		&highlight.Text,
+		&highlight.Prefix,
+		&highlight.Suffix,
+		&highlight.StartOffset,
+		&highlight.EndOffset,
+		&highlight.Note,
+		&highlight.CreatedAt,
+		&highlight.UpdatedAt,
+	)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, err
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch highlight row: %v`, err)
+	}
+
+	return &highlight, nil
+}
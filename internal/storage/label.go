@@ -0,0 +1,358 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/lib/pq"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// ErrLabelNotFound is returned when a label does not exist or belongs to another user.
+var ErrLabelNotFound = errors.New("store: label not found")
+
+// LabelTitleExists checks if the given label title exists for the user.
+func (s *Storage) LabelTitleExists(userID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM labels WHERE user_id=$1 AND lower(title)=lower($2) LIMIT 1`
+	s.db.QueryRow(query, userID, title).Scan(&result)
+	return result
+}
+
+// AnotherLabelExists checks if another label exists with the same title.
+func (s *Storage) AnotherLabelExists(userID, labelID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM labels WHERE user_id=$1 AND id != $2 AND lower(title)=lower($3) LIMIT 1`
+	s.db.QueryRow(query, userID, labelID, title).Scan(&result)
+	return result
+}
+
+// LabelIDsExist checks if all the given label IDs belong to the user.
+func (s *Storage) LabelIDsExist(userID int64, labelIDs []int64) (bool, error) {
+	if len(labelIDs) == 0 {
+		return true, nil
+	}
+
+	var count int
+	query := `SELECT count(*) FROM labels WHERE user_id=$1 AND id=ANY($2)`
+	if err := s.db.QueryRow(query, userID, pq.Array(labelIDs)).Scan(&count); err != nil {
+		return false, fmt.Errorf(`store: unable to check if labels exist: %v`, err)
+	}
+
+	uniqueLabelIDs := slices.Clone(labelIDs)
+	slices.Sort(uniqueLabelIDs)
+	return count == len(slices.Compact(uniqueLabelIDs)), nil
+}
+
+// Label returns a label from the database.
+func (s *Storage) Label(userID, labelID int64) (*model.Label, error) {
+	var label model.Label
+
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, labelID).Scan(&label.ID, &label.UserID, &label.Title)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch label: %v`, err)
+	default:
+		return &label, nil
+	}
+}
+
+// LabelByTitle finds a label by its title, ignoring the case.
+func (s *Storage) LabelByTitle(userID int64, title string) (*model.Label, error) {
+	var label model.Label
+
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 AND lower(title)=lower($2)`
+	err := s.db.QueryRow(query, userID, title).Scan(&label.ID, &label.UserID, &label.Title)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch label: %v`, err)
+	default:
+		return &label, nil
+	}
+}
+
+// Labels returns all labels that belongs to the given user.
+func (s *Storage) Labels(userID int64) (model.Labels, error) {
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 ORDER BY lower(title) ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labels := make(model.Labels, 0)
+	for rows.Next() {
+		var label model.Label
+		if err := rows.Scan(&label.ID, &label.UserID, &label.Title); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch label row: %v`, err)
+		}
+
+		labels = append(labels, &label)
+	}
+
+	return labels, nil
+}
+
+// LabelsWithEntryCount returns all labels with the number of labeled entries.
+func (s *Storage) LabelsWithEntryCount(userID int64) (model.Labels, error) {
+	query := `
+		SELECT
+			l.id,
+			l.user_id,
+			l.title,
+			(SELECT count(*) FROM entry_labels el WHERE el.label_id=l.id)
+		FROM
+			labels l
+		WHERE
+			l.user_id=$1
+		ORDER BY
+			lower(l.title) ASC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labels := make(model.Labels, 0)
+	for rows.Next() {
+		var label model.Label
+		if err := rows.Scan(&label.ID, &label.UserID, &label.Title, &label.EntryCount); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch label row: %v`, err)
+		}
+
+		labels = append(labels, &label)
+	}
+
+	return labels, nil
+}
+
+// CreateLabel creates a new label.
+func (s *Storage) CreateLabel(userID int64, request *model.LabelCreationRequest) (*model.Label, error) {
+	var label model.Label
+
+	query := `
+		INSERT INTO labels
+			(user_id, title)
+		VALUES
+			($1, $2)
+		RETURNING
+			id,
+			user_id,
+			title
+	`
+	err := s.db.QueryRow(query, userID, request.Title).Scan(&label.ID, &label.UserID, &label.Title)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create label %q for user ID %d: %v`, request.Title, userID, err)
+	}
+
+	return &label, nil
+}
+
+// UpdateLabel renames an existing label.
+func (s *Storage) UpdateLabel(label *model.Label) error {
+	query := `UPDATE labels SET title=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, label.Title, label.ID, label.UserID); err != nil {
+		return fmt.Errorf(`store: unable to update label: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveLabel deletes a label and detaches it from all entries.
+func (s *Storage) RemoveLabel(userID, labelID int64) error {
+	result, err := s.db.Exec(`DELETE FROM labels WHERE id=$1 AND user_id=$2`, labelID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this label: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this label: %v`, err)
+	}
+
+	if count == 0 {
+		return ErrLabelNotFound
+	}
+
+	return nil
+}
+
+// MergeLabels moves every entry of the source label to the destination label,
+// then deletes the source label.
+func (s *Storage) MergeLabels(userID, sourceLabelID, destinationLabelID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	var count int
+	query := `SELECT count(*) FROM labels WHERE user_id=$1 AND id IN ($2, $3)`
+	if err := tx.QueryRow(query, userID, sourceLabelID, destinationLabelID).Scan(&count); err != nil {
+		return fmt.Errorf(`store: unable to fetch labels: %v`, err)
+	}
+	if count != 2 {
+		return ErrLabelNotFound
+	}
+
+	query = `
+		INSERT INTO entry_labels (entry_id, label_id)
+			SELECT entry_id, $2 FROM entry_labels WHERE label_id=$1
+		ON CONFLICT (entry_id, label_id) DO NOTHING
+	`
+	if _, err := tx.Exec(query, sourceLabelID, destinationLabelID); err != nil {
+		return fmt.Errorf(`store: unable to merge labels: %v`, err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM labels WHERE id=$1 AND user_id=$2`, sourceLabelID, userID); err != nil {
+		return fmt.Errorf(`store: unable to remove merged label: %v`, err)
+	}
+
+	return tx.Commit()
+}
+
+// SetEntryLabels replaces the labels attached to an entry, an empty list removes all the labels.
+// Label IDs that do not belong to the user are ignored.
+func (s *Storage) SetEntryLabels(userID, entryID int64, labelIDs []int64) error {
+	// A nil slice is sent as NULL, and nothing would be detached.
+	if labelIDs == nil {
+		labelIDs = []int64{}
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		DELETE FROM entry_labels
+		USING entries
+		WHERE
+			entries.id=entry_labels.entry_id AND
+			entries.user_id=$1 AND
+			entry_labels.entry_id=$2 AND
+			entry_labels.label_id <> ALL($3)
+	`
+	if _, err := tx.Exec(query, userID, entryID, pq.Array(labelIDs)); err != nil {
+		return fmt.Errorf(`store: unable to detach labels from entry #%d: %v`, entryID, err)
+	}
+
+	if err := addLabelsToEntries(tx, userID, labelIDs, []int64{entryID}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AddLabelToEntries attaches a label to the given entries.
+func (s *Storage) AddLabelToEntries(userID, labelID int64, entryIDs []int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	if err := addLabelsToEntries(tx, userID, []int64{labelID}, entryIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RemoveLabelFromEntries detaches a label from the given entries.
+func (s *Storage) RemoveLabelFromEntries(userID, labelID int64, entryIDs []int64) error {
+	query := `
+		DELETE FROM entry_labels
+		USING labels
+		WHERE
+			labels.id=entry_labels.label_id AND
+			labels.user_id=$1 AND
+			entry_labels.label_id=$2 AND
+			entry_labels.entry_id=ANY($3)
+	`
+	if _, err := s.db.Exec(query, userID, labelID, pq.Array(entryIDs)); err != nil {
+		return fmt.Errorf(`store: unable to detach label #%d from entries: %v`, labelID, err)
+	}
+
+	return nil
+}
+
+// LabelsByEntryIDs returns the labels of the given entries, grouped by entry ID.
+func (s *Storage) LabelsByEntryIDs(entryIDs []int64) (map[int64]model.Labels, error) {
+	if len(entryIDs) == 0 {
+		return make(map[int64]model.Labels), nil
+	}
+
+	query := `
+		SELECT
+			el.entry_id,
+			l.id,
+			l.user_id,
+			l.title
+		FROM
+			entry_labels el
+		INNER JOIN
+			labels l ON l.id=el.label_id
+		WHERE
+			el.entry_id=ANY($1)
+		ORDER BY
+			lower(l.title) ASC
+	`
+	rows, err := s.db.Query(query, pq.Array(entryIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labelsByEntryID := make(map[int64]model.Labels, len(entryIDs))
+	for rows.Next() {
+		var entryID int64
+		var label model.Label
+		if err := rows.Scan(&entryID, &label.ID, &label.UserID, &label.Title); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry label row: %v`, err)
+		}
+
+		labelsByEntryID[entryID] = append(labelsByEntryID[entryID], &label)
+	}
+
+	return labelsByEntryID, nil
+}
+
+func addLabelsToEntries(tx *sql.Tx, userID int64, labelIDs, entryIDs []int64) error {
+	if len(labelIDs) == 0 || len(entryIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO entry_labels (entry_id, label_id)
+			SELECT e.id, l.id
+			FROM entries e
+			CROSS JOIN labels l
+			WHERE
+				e.user_id=$1 AND
+				e.id=ANY($2) AND
+				l.user_id=$1 AND
+				l.id=ANY($3)
+		ON CONFLICT (entry_id, label_id) DO NOTHING
+	`
+	if _, err := tx.Exec(query, userID, pq.Array(entryIDs), pq.Array(labelIDs)); err != nil {
+		return fmt.Errorf(`store: unable to attach labels to entries: %v`, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"miniflux.app/v2/internal/model"
+)
+
+// LoginLockedUntil returns the end of the longest active lockout of the given keys, or nil when none is locked.
+func (s *Storage) LoginLockedUntil(keys ...string) (*time.Time, error) {
+	query := `
+		SELECT
+			max(locked_until)
+		FROM
+			login_attempts
+		WHERE
+			key=ANY($1) AND locked_until > now()
+	`
+
+	var lockedUntil *time.Time
+	if err := s.db.QueryRow(query, pq.Array(keys)).Scan(&lockedUntil); err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch login lockout: %v`, err)
+	}
+
+	return lockedUntil, nil
+}
+
+// IncrementLoginFailures counts a failed login for the key and returns the number of consecutive failures.
+// The counter starts over when the previous failure is older than the given interval.
+func (s *Storage) IncrementLoginFailures(key string, resetInterval time.Duration) (int, error) {
+	query := `
+		INSERT INTO login_attempts
+			(key, failed_attempts, last_failed_at)
+		VALUES
+			($1, 1, now())
+		ON CONFLICT (key) DO UPDATE SET
+			failed_attempts = CASE
+				WHEN login_attempts.last_failed_at < now() - $2::interval THEN 1
+				ELSE login_attempts.failed_attempts + 1
+			END,
+			last_failed_at = now()
+		RETURNING
+			failed_attempts
+	`
+
+	var failedAttempts int
+	err := s.db.QueryRow(query, key, fmt.Sprintf("%d seconds", int(resetInterval.Seconds()))).Scan(&failedAttempts)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to record failed login for %q: %v`, key, err)
+	}
+
+	return failedAttempts, nil
+}
+
+// LockLogin prevents the logins of the key until the given time.
+func (s *Storage) LockLogin(key string, until time.Time) error {
+	query := `UPDATE login_attempts SET locked_until=$2 WHERE key=$1`
+	if _, err := s.db.Exec(query, key, until); err != nil {
+		return fmt.Errorf(`store: unable to lock login for %q: %v`, key, err)
+	}
+
+	return nil
+}
+
+// ResetLoginAttempts forgets the failed logins of the key and removes its lockout.
+func (s *Storage) ResetLoginAttempts(key string) error {
+	query := `DELETE FROM login_attempts WHERE key=$1`
+	if _, err := s.db.Exec(query, key); err != nil {
+		return fmt.Errorf(`store: unable to reset login attempts for %q: %v`, key, err)
+	}
+
+	return nil
+}
+
+// LockedLogins returns the keys currently locked out, the most recent failure first.
+func (s *Storage) LockedLogins() (model.LoginAttempts, error) {
+	query := `
+		SELECT
+			key,
+			failed_attempts,
+			locked_until,
+			last_failed_at
+		FROM
+			login_attempts
+		WHERE
+			locked_until > now()
+		ORDER BY
+			last_failed_at DESC
+	`
+
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch locked logins: %v`, err)
+	}
+	defer rows.Close()
+
+	attempts := make(model.LoginAttempts, 0)
+	for rows.Next() {
+		var attempt model.LoginAttempt
+		if err := rows.Scan(
+			&attempt.Key,
+			&attempt.FailedAttempts,
+			&attempt.LockedUntil,
+			&attempt.LastFailedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch locked login row: %v`, err)
+		}
+
+		attempts = append(attempts, &attempt)
+	}
+
+	return attempts, nil
+}
+
+// CountLockedLogins returns the number of keys currently locked out.
+func (s *Storage) CountLockedLogins() (int, error) {
+	query := `SELECT count(*) FROM login_attempts WHERE locked_until > now()`
+	var count int
+	if err := s.db.QueryRow(query).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`store: unable to count locked logins: %v`, err)
+	}
+
+	return count, nil
+}
+
+// CleanOldLoginAttempts removes the expired failed logins older than the given interval.
+func (s *Storage) CleanOldLoginAttempts(interval time.Duration) (int64, error) {
+	query := `
+		DELETE FROM
+			login_attempts
+		WHERE
+			last_failed_at < now() - $1::interval AND
+			(locked_until IS NULL OR locked_until < now())
+	`
+
+	result, err := s.db.Exec(query, fmt.Sprintf("%d seconds", int(interval.Seconds())))
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to clean old login attempts: %v`, err)
+	}
+
+	n, _ := result.RowsAffected()
+	return n, nil
+}
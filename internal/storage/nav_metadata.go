@@ -7,17 +7,39 @@ import (
 	"log/slog"
 
 	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
 )
 
 type NavMetadata struct {
 	CountUnread     int
 	CountErrorFeeds int
 	HasSaveEntry    bool
+	SavedSearches   model.SavedSearches
 }
 
-// GetNavMetadata returns the navigation metadata for the given user in a
-// single SQL query.
+// GetNavMetadata returns the navigation metadata for the given user, including
+// the saved searches with their number of unread entries.
 func (s *Storage) GetNavMetadata(userID int64) (NavMetadata, error) {
+	navMetadata, err := s.GetNavCounters(userID)
+	if err != nil {
+		return NavMetadata{}, err
+	}
+
+	navMetadata.SavedSearches, err = s.SavedSearchesWithUnreadCount(userID)
+	if err != nil {
+		slog.Error("Unable to fetch the saved searches of the navigation",
+			slog.Int64("user_id", userID),
+			slog.Any("error", err),
+		)
+		return NavMetadata{}, err
+	}
+
+	return navMetadata, nil
+}
+
+// GetNavCounters returns the navigation counters for the given user in a
+// single SQL query, without the saved searches.
+func (s *Storage) GetNavCounters(userID int64) (NavMetadata, error) {
 	query := `
 		SELECT
 			(SELECT count(*)
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+// ErrPublishedFeedNotFound is returned when a published feed does not exist or belongs to another user.
+var ErrPublishedFeedNotFound = errors.New("store: published feed not found")
+
+const publishedFeedColumns = `
+	id,
+	user_id,
+	token,
+	kind,
+	coalesce(category_id, 0),
+	coalesce(label_id, 0),
+	tag,
+	title,
+	created_at
+`
+
+// PublishedFeeds returns all feeds published by the given user.
+func (s *Storage) PublishedFeeds(userID int64) (model.PublishedFeeds, error) {
+	query := `SELECT ` + publishedFeedColumns + ` FROM published_feeds WHERE user_id=$1 ORDER BY created_at ASC, id ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch published feeds: %v`, err)
+	}
+	defer rows.Close()
+
+	publishedFeeds := make(model.PublishedFeeds, 0)
+	for rows.Next() {
+		publishedFeed, err := scanPublishedFeed(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch published feed row: %v`, err)
+		}
+
+		publishedFeeds = append(publishedFeeds, publishedFeed)
+	}
+
+	return publishedFeeds, nil
+}
+
+// PublishedFeedByToken returns the published feed matching the given token.
+func (s *Storage) PublishedFeedByToken(token string) (*model.PublishedFeed, error) {
+	query := `SELECT ` + publishedFeedColumns + ` FROM published_feeds WHERE token=$1`
+	publishedFeed, err := scanPublishedFeed(s.db.QueryRow(query, token))
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch published feed: %v`, err)
+	}
+
+	return publishedFeed, nil
+}
+
+// CreatePublishedFeed publishes a new feed with a random token.
+// Only the category, the label or the tag selected by the kind of feed is stored.
+func (s *Storage) CreatePublishedFeed(userID int64, request *model.PublishedFeedCreationRequest) (*model.PublishedFeed, error) {
+	var categoryID, labelID int64
+	var tag string
+	switch request.Kind {
+	case model.PublishedFeedKindCategory:
+		categoryID = request.CategoryID
+	case model.PublishedFeedKindLabel:
+		labelID = request.LabelID
+	case model.PublishedFeedKindTag:
+		tag = request.Tag
+	}
+
+	query := `
+		INSERT INTO published_feeds
+			(user_id, token, kind, category_id, label_id, tag, title)
+		VALUES
+			($1, $2, $3, NULLIF($4, 0), NULLIF($5, 0), $6, $7)
+		RETURNING
+	` + publishedFeedColumns
+
+	publishedFeed, err := scanPublishedFeed(s.db.QueryRow(
+		query,
+		userID,
+		crypto.GenerateRandomStringHex(32),
+		request.Kind,
+		categoryID,
+		labelID,
+		tag,
+		request.Title,
+	))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create published feed for user ID %d: %v`, userID, err)
+	}
+
+	return publishedFeed, nil
+}
+
+// RemovePublishedFeed deletes a published feed, its token stops working immediately.
+func (s *Storage) RemovePublishedFeed(userID, publishedFeedID int64) error {
+	result, err := s.db.Exec(`DELETE FROM published_feeds WHERE id=$1 AND user_id=$2`, publishedFeedID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this published feed: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this published feed: %v`, err)
+	}
+
+	if count == 0 {
+		return ErrPublishedFeedNotFound
+	}
+
+	return nil
+}
+
+type publishedFeedScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPublishedFeed(scanner publishedFeedScanner) (*model.PublishedFeed, error) {
+	var publishedFeed model.PublishedFeed
+	err := scanner.Scan(
+		&publishedFeed.ID,
+		&publishedFeed.UserID,
+		&publishedFeed.Token,
+		&publishedFeed.Kind,
+		&publishedFeed.CategoryID,
+		&publishedFeed.LabelID,
+		&publishedFeed.Tag,
+		&publishedFeed.Title,
+		&publishedFeed.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &publishedFeed, nil
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/lib/pq"
+	"miniflux.app/v2/internal/model"
+)
+
+// ErrReadLaterEntryNotFound is returned when an entry is not in the read later queue of the user.
+var ErrReadLaterEntryNotFound = errors.New("store: entry not found in the read later queue")
+
+// AddToReadLater appends the entries at the end of the read later queue, in the given order.
+// The entries already in the queue keep their position.
+func (s *Storage) AddToReadLater(userID int64, entryIDs []int64) error {
+	query := `
+		INSERT INTO read_later_entries
+			(entry_id, user_id, position)
+		SELECT
+			e.id,
+			e.user_id,
+			(SELECT coalesce(max(position), 0) FROM read_later_entries WHERE user_id=$1) + row_number() OVER (ORDER BY array_position($2::bigint[], e.id))
+		FROM
+			entries e
+		WHERE
+			e.user_id=$1 AND
+			e.id=ANY($2) AND
+			NOT EXISTS (SELECT 1 FROM read_later_entries rl WHERE rl.entry_id=e.id)
+		ON CONFLICT (entry_id) DO NOTHING
+	`
+	if _, err := s.db.Exec(query, userID, pq.Array(entryIDs)); err != nil {
+		return fmt.Errorf(`store: unable to add entries %v to the read later queue: %v`, entryIDs, err)
+	}
+
+	return nil
+}
+
+// RemoveFromReadLater removes the entries from the read later queue.
+func (s *Storage) RemoveFromReadLater(userID int64, entryIDs []int64) error {
+	query := `DELETE FROM read_later_entries WHERE user_id=$1 AND entry_id=ANY($2)`
+	if _, err := s.db.Exec(query, userID, pq.Array(entryIDs)); err != nil {
+		return fmt.Errorf(`store: unable to remove entries %v from the read later queue: %v`, entryIDs, err)
+	}
+
+	return nil
+}
+
+// ToggleReadLater adds the entry to the read later queue, or removes it if it is already queued.
+// It returns true if the entry is in the queue.
+func (s *Storage) ToggleReadLater(userID, entryID int64) (bool, error) {
+	result, err := s.db.Exec(`DELETE FROM read_later_entries WHERE user_id=$1 AND entry_id=$2`, userID, entryID)
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to remove entry #%d from the read later queue: %v`, entryID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to remove entry #%d from the read later queue: %v`, entryID, err)
+	}
+
+	if count > 0 {
+		return false, nil
+	}
+
+	if err := s.AddToReadLater(userID, []int64{entryID}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// MoveReadLaterEntry moves the entry to the given position of the read later queue, starting at 1.
+// The positions beyond the end of the queue move the entry to the end.
+func (s *Storage) MoveReadLaterEntry(userID, entryID int64, position int) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(`SELECT entry_id FROM read_later_entries WHERE user_id=$1 ORDER BY position ASC, entry_id ASC FOR UPDATE`, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to fetch the read later queue: %v`, err)
+	}
+
+	var entryIDs []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf(`store: unable to fetch the read later queue: %v`, err)
+		}
+		entryIDs = append(entryIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return fmt.Errorf(`store: unable to fetch the read later queue: %v`, err)
+	}
+	rows.Close()
+
+	entryIDs, found := moveEntryID(entryIDs, entryID, position)
+	if !found {
+		return ErrReadLaterEntryNotFound
+	}
+
+	query := `
+		UPDATE read_later_entries rl
+		SET position=v.position
+		FROM unnest($2::bigint[]) WITH ORDINALITY AS v(entry_id, position)
+		WHERE rl.user_id=$1 AND rl.entry_id=v.entry_id
+	`
+	if _, err := tx.Exec(query, userID, pq.Array(entryIDs)); err != nil {
+		return fmt.Errorf(`store: unable to move entry #%d in the read later queue: %v`, entryID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// ReadLaterSiblingEntries returns the previous and next entries of the read later queue.
+func (s *Storage) ReadLaterSiblingEntries(userID, entryID int64) (*model.Entry, *model.Entry, error) {
+	query := `
+		SELECT
+			prev_id, prev_title, next_id, next_title
+		FROM (
+			SELECT
+				rl.entry_id,
+				lag(e.id) OVER queue AS prev_id,
+				lag(e.title) OVER queue AS prev_title,
+				lead(e.id) OVER queue AS next_id,
+				lead(e.title) OVER queue AS next_title
+			FROM
+				read_later_entries rl
+			JOIN
+				entries e ON e.id=rl.entry_id
+			WHERE
+				rl.user_id=$1
+			WINDOW queue AS (ORDER BY rl.position ASC, rl.entry_id ASC)
+		) AS q
+		WHERE
+			entry_id=$2
+	`
+
+	var prevID, nextID sql.NullInt64
+	var prevTitle, nextTitle sql.NullString
+	err := s.db.QueryRow(query, userID, entryID).Scan(&prevID, &prevTitle, &nextID, &nextTitle)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil, nil
+	case err != nil:
+		return nil, nil, fmt.Errorf(`store: unable to fetch the siblings of entry #%d in the read later queue: %v`, entryID, err)
+	}
+
+	var prevEntry, nextEntry *model.Entry
+	if prevID.Valid {
+		prevEntry = &model.Entry{ID: prevID.Int64, Title: prevTitle.String}
+	}
+
+	if nextID.Valid {
+		nextEntry = &model.Entry{ID: nextID.Int64, Title: nextTitle.String}
+	}
+
+	return prevEntry, nextEntry, nil
+}
+
+// removeReadLaterEntriesOnRead removes the entries marked as read from the read later queue, when the user enabled it.
+func (s *Storage) removeReadLaterEntriesOnRead(userID int64, entryIDs []int64) error {
+	query := `
+		DELETE FROM read_later_entries
+		WHERE
+			user_id=$1 AND
+			entry_id=ANY($2) AND
+			EXISTS (SELECT 1 FROM users WHERE id=$1 AND remove_read_later_on_read is true)
+	`
+	if _, err := s.db.Exec(query, userID, pq.Array(entryIDs)); err != nil {
+		return fmt.Errorf(`store: unable to remove read entries %v from the read later queue: %v`, entryIDs, err)
+	}
+
+	return nil
+}
+
+// moveEntryID moves the entry ID to the position in the list, starting at 1.
+// It returns false if the entry ID is not in the list.
+func moveEntryID(entryIDs []int64, entryID int64, position int) ([]int64, bool) {
+	index := slices.Index(entryIDs, entryID)
+	if index < 0 {
+		return entryIDs, false
+	}
+
+	entryIDs = slices.Delete(entryIDs, index, index+1)
+	position = min(max(position, 1), len(entryIDs)+1)
+	return slices.Insert(entryIDs, position-1, entryID), true
+}
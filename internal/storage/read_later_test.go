@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMoveEntryID(t *testing.T) {
+	scenarios := []struct {
+		entryID  int64
+		position int
+		expected []int64
+	}{
+		{3, 1, []int64{3, 1, 2, 4}},
+		{1, 3, []int64{2, 3, 1, 4}},
+		{2, 2, []int64{1, 2, 3, 4}},
+		{1, 4, []int64{2, 3, 4, 1}},
+		{2, 100, []int64{1, 3, 4, 2}},
+		{4, 0, []int64{4, 1, 2, 3}},
+		{4, -5, []int64{4, 1, 2, 3}},
+	}
+
+	for _, scenario := range scenarios {
+		entryIDs, found := moveEntryID([]int64{1, 2, 3, 4}, scenario.entryID, scenario.position)
+		if !found {
+			t.Errorf(`Entry #%d should be found`, scenario.entryID)
+		}
+
+		if !slices.Equal(entryIDs, scenario.expected) {
+			t.Errorf(`Moving entry #%d to position %d gave %v instead of %v`, scenario.entryID, scenario.position, entryIDs, scenario.expected)
+		}
+	}
+}
+
+func TestMoveEntryIDNotFound(t *testing.T) {
+	if _, found := moveEntryID([]int64{1, 2, 3}, 4, 1); found {
+		t.Error(`Entry #4 should not be found`)
+	}
+}
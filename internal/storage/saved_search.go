@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// ErrSavedSearchNotFound is returned when a saved search does not exist or belongs to another user.
+var ErrSavedSearchNotFound = errors.New("store: saved search not found")
+
+const savedSearchColumns = `
+	id,
+	user_id,
+	title,
+	query,
+	status,
+	starred,
+	coalesce(category_id, 0),
+	coalesce(feed_id, 0),
+	max_age_days
+`
+
+// SavedSearchTitleExists checks if the given saved search title exists for the user.
+func (s *Storage) SavedSearchTitleExists(userID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM saved_searches WHERE user_id=$1 AND lower(title)=lower($2) LIMIT 1`
+	s.db.QueryRow(query, userID, title).Scan(&result)
+	return result
+}
+
+// AnotherSavedSearchExists checks if another saved search exists with the same title.
+func (s *Storage) AnotherSavedSearchExists(userID, savedSearchID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM saved_searches WHERE user_id=$1 AND id != $2 AND lower(title)=lower($3) LIMIT 1`
+	s.db.QueryRow(query, userID, savedSearchID, title).Scan(&result)
+	return result
+}
+
+// SavedSearch returns a saved search from the database.
+func (s *Storage) SavedSearch(userID, savedSearchID int64) (*model.SavedSearch, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id=$1 AND id=$2`
+	savedSearch, err := scanSavedSearch(s.db.QueryRow(query, userID, savedSearchID))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch saved search: %v`, err)
+	default:
+		return savedSearch, nil
+	}
+}
+
+// SavedSearchByTitle finds a saved search by its title, ignoring the case.
+func (s *Storage) SavedSearchByTitle(userID int64, title string) (*model.SavedSearch, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id=$1 AND lower(title)=lower($2)`
+	savedSearch, err := scanSavedSearch(s.db.QueryRow(query, userID, title))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch saved search: %v`, err)
+	default:
+		return savedSearch, nil
+	}
+}
+
+// SavedSearches returns all saved searches that belongs to the given user.
+func (s *Storage) SavedSearches(userID int64) (model.SavedSearches, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id=$1 ORDER BY lower(title) ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch saved searches: %v`, err)
+	}
+	defer rows.Close()
+
+	savedSearches := make(model.SavedSearches, 0)
+	for rows.Next() {
+		savedSearch, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch saved search row: %v`, err)
+		}
+
+		savedSearches = append(savedSearches, savedSearch)
+	}
+
+	return savedSearches, nil
+}
+
+// SavedSearchesWithUnreadCount returns all saved searches with the number of unread matching entries.
+func (s *Storage) SavedSearchesWithUnreadCount(userID int64) (model.SavedSearches, error) {
+	savedSearches, err := s.SavedSearches(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, savedSearch := range savedSearches {
+		count, err := s.NewEntryQueryBuilder(userID).
+			WithSavedSearch(savedSearch).
+			WithStatuses(model.EntryStatusUnread).
+			CountEntries()
+		if err != nil {
+			return nil, err
+		}
+
+		savedSearch.TotalUnread = &count
+	}
+
+	return savedSearches, nil
+}
+
+// CreateSavedSearch creates a new saved search.
+func (s *Storage) CreateSavedSearch(userID int64, request *model.SavedSearchCreationRequest) (*model.SavedSearch, error) {
+	query := `
+		INSERT INTO saved_searches
+			(user_id, title, query, status, starred, category_id, feed_id, max_age_days)
+		VALUES
+			($1, $2, $3, $4, $5, NULLIF($6, 0), NULLIF($7, 0), $8)
+		RETURNING
+	` + savedSearchColumns
+
+	savedSearch, err := scanSavedSearch(s.db.QueryRow(
+		query,
+		userID,
+		request.Title,
+		request.Query,
+		request.Status,
+		request.Starred,
+		request.CategoryID,
+		request.FeedID,
+		request.MaxAgeDays,
+	))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create saved search %q for user ID %d: %v`, request.Title, userID, err)
+	}
+
+	return savedSearch, nil
+}
+
+// UpdateSavedSearch updates an existing saved search.
+func (s *Storage) UpdateSavedSearch(savedSearch *model.SavedSearch) error {
+	query := `
+		UPDATE saved_searches
+		SET
+			title=$1,
+			query=$2,
+			status=$3,
+			starred=$4,
+			category_id=NULLIF($5, 0),
+			feed_id=NULLIF($6, 0),
+			max_age_days=$7
+		WHERE
+			id=$8 AND user_id=$9
+	`
+	_, err := s.db.Exec(
+		query,
+		savedSearch.Title,
+		savedSearch.Query,
+		savedSearch.Status,
+		savedSearch.Starred,
+		savedSearch.CategoryID,
+		savedSearch.FeedID,
+		savedSearch.MaxAgeDays,
+		savedSearch.ID,
+		savedSearch.UserID,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update saved search: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveSavedSearch deletes a saved search.
+func (s *Storage) RemoveSavedSearch(userID, savedSearchID int64) error {
+	result, err := s.db.Exec(`DELETE FROM saved_searches WHERE id=$1 AND user_id=$2`, savedSearchID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this saved search: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this saved search: %v`, err)
+	}
+
+	if count == 0 {
+		return ErrSavedSearchNotFound
+	}
+
+	return nil
+}
+
+type savedSearchScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSavedSearch(scanner savedSearchScanner) (*model.SavedSearch, error) {
+	var savedSearch model.SavedSearch
+	err := scanner.Scan(
+		&savedSearch.ID,
+		&savedSearch.UserID,
+		&savedSearch.Title,
+		&savedSearch.Query,
+		&savedSearch.Status,
+		&savedSearch.Starred,
+		&savedSearch.CategoryID,
+		&savedSearch.FeedID,
+		&savedSearch.MaxAgeDays,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &savedSearch, nil
+}
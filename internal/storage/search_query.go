@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/searchquery"
+)
+
+// searchQueryCondition compiles a search string to a SQL condition on entries.
+// User values are always passed as arguments, appended to the given ones.
+// The returned rank query is the full-text part of the search, if any.
+//
+// Strings that cannot be parsed are searched as a plain full-text query,
+// callers are expected to validate the input beforehand to report errors.
+func searchQueryCondition(input string, args []any) (condition string, rankQuery string, newArgs []any) {
+	query, err := searchquery.Parse(input)
+	if err != nil {
+		args = append(args, input)
+		return fmt.Sprintf("e.document_vectors @@ websearch_to_tsquery($%d)", len(args)), input, args
+	}
+
+	if query.IsEmpty() {
+		return "", "", args
+	}
+
+	clauses := make([]string, 0, len(query.Clauses))
+	for _, clause := range query.Clauses {
+		terms := make([]string, 0, len(clause))
+		for _, term := range clause {
+			var termCondition string
+			termCondition, args = searchTermCondition(term, args)
+			if term.Negated {
+				termCondition = "NOT coalesce(" + termCondition + ", false)"
+			}
+			terms = append(terms, termCondition)
+		}
+
+		if len(terms) == 1 {
+			clauses = append(clauses, terms[0])
+		} else {
+			clauses = append(clauses, "("+strings.Join(terms, " OR ")+")")
+		}
+	}
+
+	return strings.Join(clauses, " AND "), query.FullTextQuery(), args
+}
+
+func searchTermCondition(term searchquery.Term, args []any) (string, []any) {
+	switch term.Field {
+	case searchquery.FieldTitle:
+		args = append(args, term.Value)
+		return fmt.Sprintf("strpos(lower(e.title), lower($%d)) > 0", len(args)), args
+	case searchquery.FieldAuthor:
+		args = append(args, term.Value)
+		return fmt.Sprintf("strpos(lower(e.author), lower($%d)) > 0", len(args)), args
+	case searchquery.FieldFeed:
+		args = append(args, term.Value)
+		return fmt.Sprintf("strpos(lower(f.title), lower($%d)) > 0", len(args)), args
+	case searchquery.FieldCategory:
+		// The categories table is not joined by every entry query.
+		args = append(args, term.Value)
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM categories sc WHERE sc.id = f.category_id AND strpos(lower(sc.title), lower($%d)) > 0)", len(args)), args
+	case searchquery.FieldTag:
+		args = append(args, term.Value)
+		return fmt.Sprintf("lower($%d) = ANY(LOWER(e.tags::text)::text[])", len(args)), args
+	case searchquery.FieldIs:
+		switch term.Value {
+		case searchquery.IsStarred:
+			return "e.starred is true", args
+		case searchquery.IsRead:
+			args = append(args, model.EntryStatusRead)
+		default:
+			args = append(args, model.EntryStatusUnread)
+		}
+		return fmt.Sprintf("e.status = $%d", len(args)), args
+	case searchquery.FieldBefore:
+		args = append(args, term.Date)
+		return fmt.Sprintf("e.published_at < $%d", len(args)), args
+	case searchquery.FieldAfter:
+		args = append(args, term.Date)
+		return fmt.Sprintf("e.published_at >= $%d", len(args)), args
+	}
+
+	args = append(args, term.Value)
+	if term.Phrase {
+		return fmt.Sprintf("e.document_vectors @@ phraseto_tsquery($%d)", len(args)), args
+	}
+	return fmt.Sprintf("e.document_vectors @@ plainto_tsquery($%d)", len(args)), args
+}
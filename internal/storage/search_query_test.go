@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSearchQueryCondition(t *testing.T) {
+	scenarios := []struct {
+		input             string
+		expectedCondition string
+		expectedRankQuery string
+		expectedArgs      []any
+	}{
+		{
+			"",
+			"",
+			"",
+			[]any{int64(1)},
+		},
+		{
+			"golang",
+			"e.document_vectors @@ plainto_tsquery($2)",
+			"golang",
+			[]any{int64(1), "golang"},
+		},
+		{
+			`"static typing" -rust`,
+			"e.document_vectors @@ phraseto_tsquery($2) AND NOT coalesce(e.document_vectors @@ plainto_tsquery($3), false)",
+			`"static typing"`,
+			[]any{int64(1), "static typing", "rust"},
+		},
+		{
+			"title:golang OR author:rob is:starred",
+			"(strpos(lower(e.title), lower($2)) > 0 OR strpos(lower(e.author), lower($3)) > 0) AND e.starred is true",
+			"",
+			[]any{int64(1), "golang", "rob"},
+		},
+		{
+			"feed:blog category:tech tag:go",
+			"strpos(lower(f.title), lower($2)) > 0 AND EXISTS (SELECT 1 FROM categories sc WHERE sc.id = f.category_id AND strpos(lower(sc.title), lower($3)) > 0) AND lower($4) = ANY(LOWER(e.tags::text)::text[])",
+			"",
+			[]any{int64(1), "blog", "tech", "go"},
+		},
+		{
+			"-is:read after:2024-01-02 before:2024-02-01",
+			"NOT coalesce(e.status = $2, false) AND e.published_at >= $3 AND e.published_at < $4",
+			"",
+			[]any{int64(1), "read", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"'; DROP TABLE entries; --",
+			"e.document_vectors @@ plainto_tsquery($2) AND e.document_vectors @@ plainto_tsquery($3) AND e.document_vectors @@ plainto_tsquery($4) AND e.document_vectors @@ plainto_tsquery($5)",
+			"'; DROP TABLE entries;",
+			[]any{int64(1), "';", "DROP", "TABLE", "entries;"},
+		},
+		{
+			"before:yesterday",
+			"e.document_vectors @@ websearch_to_tsquery($2)",
+			"before:yesterday",
+			[]any{int64(1), "before:yesterday"},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		condition, rankQuery, args := searchQueryCondition(scenario.input, []any{int64(1)})
+		if condition != scenario.expectedCondition {
+			t.Errorf(`Unexpected condition for %q, got %q instead of %q`, scenario.input, condition, scenario.expectedCondition)
+		}
+
+		if rankQuery != scenario.expectedRankQuery {
+			t.Errorf(`Unexpected rank query for %q, got %q instead of %q`, scenario.input, rankQuery, scenario.expectedRankQuery)
+		}
+
+		if !reflect.DeepEqual(args, scenario.expectedArgs) {
+			t.Errorf(`Unexpected arguments for %q, got %v instead of %v`, scenario.input, args, scenario.expectedArgs)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// UserTOTP returns the TOTP secret of a user, enabled or not.
+func (s *Storage) UserTOTP(userID int64) (*model.UserTOTP, error) {
+	query := `
+		SELECT
+			user_id,
+			secret,
+			enabled,
+			last_used_step,
+			created_at
+		FROM
+			user_totp
+		WHERE
+			user_id=$1
+	`
+	var userTOTP model.UserTOTP
+	err := s.db.QueryRow(query, userID).Scan(
+		&userTOTP.UserID,
+		&userTOTP.Secret,
+		&userTOTP.Enabled,
+		&userTOTP.LastUsedStep,
+		&userTOTP.CreatedAt,
+	)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch TOTP secret of user #%d: %v`, userID, err)
+	}
+
+	return &userTOTP, nil
+}
+
+// HasTwoFactorAuthentication returns true if the user has enabled the TOTP two-factor authentication.
+func (s *Storage) HasTwoFactorAuthentication(userID int64) (bool, error) {
+	var enabled bool
+	query := `SELECT true FROM user_totp WHERE user_id=$1 AND enabled='t'`
+	err := s.db.QueryRow(query, userID).Scan(&enabled)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf(`store: unable to check two-factor authentication of user #%d: %v`, userID, err)
+	}
+
+	return enabled, nil
+}
+
+// SetPendingTOTPSecret stores a new TOTP secret waiting for the confirmation of the user.
+// The secret of a user who already enabled the two-factor authentication is not replaced.
+func (s *Storage) SetPendingTOTPSecret(userID int64, secret string) error {
+	query := `
+		INSERT INTO user_totp
+			(user_id, secret)
+		VALUES
+			($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			secret=EXCLUDED.secret,
+			last_used_step=0,
+			created_at=now()
+		WHERE
+			user_totp.enabled='f'
+	`
+	if _, err := s.db.Exec(query, userID, secret); err != nil {
+		return fmt.Errorf(`store: unable to store TOTP secret of user #%d: %v`, userID, err)
+	}
+
+	return nil
+}
+
+// EnableTOTP enables the pending TOTP secret of the user and replaces the recovery codes.
+func (s *Storage) EnableTOTP(userID, step int64, recoveryCodeHashes []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE user_totp SET enabled='t', last_used_step=$2 WHERE user_id=$1`
+	if _, err := tx.Exec(query, userID, step); err != nil {
+		return fmt.Errorf(`store: unable to enable TOTP of user #%d: %v`, userID, err)
+	}
+
+	if err := replaceTOTPRecoveryCodes(tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// UseTOTPStep records the time step of a code used by the user, it returns false if a code
+// of the same or a later time step was already used, to reject the replay of a code.
+func (s *Storage) UseTOTPStep(userID, step int64) (bool, error) {
+	query := `UPDATE user_totp SET last_used_step=$2 WHERE user_id=$1 AND last_used_step < $2`
+	result, err := s.db.Exec(query, userID, step)
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update TOTP of user #%d: %v`, userID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update TOTP of user #%d: %v`, userID, err)
+	}
+
+	return count > 0, nil
+}
+
+// ReplaceTOTPRecoveryCodes removes the recovery codes of the user and stores the new ones.
+func (s *Storage) ReplaceTOTPRecoveryCodes(userID int64, recoveryCodeHashes []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	if err := replaceTOTPRecoveryCodes(tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// UseTOTPRecoveryCode removes the recovery code of the user, it returns false if the code does not exist.
+func (s *Storage) UseTOTPRecoveryCode(userID int64, recoveryCodeHash string) (bool, error) {
+	query := `DELETE FROM user_totp_recovery_codes WHERE user_id=$1 AND code_hash=$2`
+	result, err := s.db.Exec(query, userID, recoveryCodeHash)
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to use recovery code of user #%d: %v`, userID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to use recovery code of user #%d: %v`, userID, err)
+	}
+
+	return count > 0, nil
+}
+
+// CountTOTPRecoveryCodes returns the number of recovery codes the user can still use.
+func (s *Storage) CountTOTPRecoveryCodes(userID int64) (int, error) {
+	var count int
+	query := `SELECT count(*) FROM user_totp_recovery_codes WHERE user_id=$1`
+	if err := s.db.QueryRow(query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`store: unable to count recovery codes of user #%d: %v`, userID, err)
+	}
+
+	return count, nil
+}
+
+// RemoveTOTP disables the two-factor authentication of the user.
+func (s *Storage) RemoveTOTP(userID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM user_totp_recovery_codes WHERE user_id=$1`, userID); err != nil {
+		return fmt.Errorf(`store: unable to remove recovery codes of user #%d: %v`, userID, err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM user_totp WHERE user_id=$1`, userID); err != nil {
+		return fmt.Errorf(`store: unable to remove TOTP secret of user #%d: %v`, userID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+func replaceTOTPRecoveryCodes(tx *sql.Tx, userID int64, recoveryCodeHashes []string) error {
+	if _, err := tx.Exec(`DELETE FROM user_totp_recovery_codes WHERE user_id=$1`, userID); err != nil {
+		return fmt.Errorf(`store: unable to remove recovery codes of user #%d: %v`, userID, err)
+	}
+
+	for _, recoveryCodeHash := range recoveryCodeHashes {
+		query := `INSERT INTO user_totp_recovery_codes (user_id, code_hash) VALUES ($1, $2)`
+		if _, err := tx.Exec(query, userID, recoveryCodeHash); err != nil {
+			return fmt.Errorf(`store: unable to store recovery code of user #%d: %v`, userID, err)
+		}
+	}
+
+	return nil
+}
@@ -98,8 +98,10 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 			media_playback_rate,
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
+			block_filter_expression,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			remove_read_later_on_read
 	`
 
 	tx, err := s.db.Begin()
@@ -143,8 +145,10 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 		&user.MediaPlaybackRate,
 		&user.BlockFilterEntryRules,
 		&user.KeepFilterEntryRules,
+		&user.BlockFilterExpression,
 		&user.AlwaysOpenExternalLinks,
 		&user.OpenExternalLinksInNewTab,
+		&user.RemoveReadLaterOnRead,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -211,9 +215,11 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				block_filter_entry_rules=$27,
 				keep_filter_entry_rules=$28,
 				always_open_external_links=$29,
-				open_external_links_in_new_tab=$30
+				open_external_links_in_new_tab=$30,
+				block_filter_expression=$31,
+				remove_read_later_on_read=$32
 			WHERE
-				id=$31
+				id=$33
 		`
 
 		_, err = s.db.Exec(
@@ -248,6 +254,8 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.KeepFilterEntryRules,
 			user.AlwaysOpenExternalLinks,
 			user.OpenExternalLinksInNewTab,
+			user.BlockFilterExpression,
+			user.RemoveReadLaterOnRead,
 			user.ID,
 		)
 		if err != nil {
@@ -284,9 +292,11 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				block_filter_entry_rules=$26,
 				keep_filter_entry_rules=$27,
 				always_open_external_links=$28,
-				open_external_links_in_new_tab=$29
+				open_external_links_in_new_tab=$29,
+				block_filter_expression=$30,
+				remove_read_later_on_read=$31
 			WHERE
-				id=$30
+				id=$32
 		`
 
 		_, err := s.db.Exec(
@@ -320,6 +330,8 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.KeepFilterEntryRules,
 			user.AlwaysOpenExternalLinks,
 			user.OpenExternalLinksInNewTab,
+			user.BlockFilterExpression,
+			user.RemoveReadLaterOnRead,
 			user.ID,
 		)
 
@@ -374,8 +386,10 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 			media_playback_rate,
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
+			block_filter_expression,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			remove_read_later_on_read
 		FROM
 			users
 		WHERE
@@ -417,8 +431,10 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 			media_playback_rate,
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
+			block_filter_expression,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			remove_read_later_on_read
 		FROM
 			users
 		WHERE
@@ -460,8 +476,10 @@ func (s *Storage) UserByField(field, value string) (*model.User, error) {
 			media_playback_rate,
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
+			block_filter_expression,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			remove_read_later_on_read
 		FROM
 			users
 		WHERE
@@ -478,51 +496,6 @@ func (s *Storage) AnotherUserWithFieldExists(userID int64, field, value string)
 	return result
 }
 
-// UserByAPIKey returns the user associated with the given API key.
-func (s *Storage) UserByAPIKey(token string) (*model.User, error) {
-	query := `
-		SELECT
-			u.id,
-			u.username,
-			u.is_admin,
-			u.theme,
-			u.language,
-			u.timezone,
-			u.entry_direction,
-			u.entries_per_page,
-			u.keyboard_shortcuts,
-			u.show_reading_time,
-			u.entry_swipe,
-			u.gesture_nav,
-			u.last_login_at,
-			u.stylesheet,
-			u.custom_js,
-			u.external_font_hosts,
-			u.google_id,
-			u.openid_connect_id,
-			u.display_mode,
-			u.entry_order,
-			u.default_reading_speed,
-			u.cjk_reading_speed,
-			u.default_home_page,
-			u.categories_sorting_order,
-			u.mark_read_on_view,
-			u.mark_read_on_media_player_completion,
-			media_playback_rate,
-			u.block_filter_entry_rules,
-			u.keep_filter_entry_rules,
-			u.always_open_external_links,
-			u.open_external_links_in_new_tab
-		FROM
-			users u
-		INNER JOIN
-			api_keys ON api_keys.user_id=u.id
-		WHERE
-			api_keys.token = $1
-	`
-	return s.fetchUser(query, token)
-}
-
 func (s *Storage) fetchUser(query string, args ...any) (*model.User, error) {
 	var user model.User
 	err := s.db.QueryRow(query, args...).Scan(
@@ -555,8 +528,10 @@ func (s *Storage) fetchUser(query string, args ...any) (*model.User, error) {
 		&user.MediaPlaybackRate,
 		&user.BlockFilterEntryRules,
 		&user.KeepFilterEntryRules,
+		&user.BlockFilterExpression,
 		&user.AlwaysOpenExternalLinks,
 		&user.OpenExternalLinksInNewTab,
+		&user.RemoveReadLaterOnRead,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -609,8 +584,10 @@ func (s *Storage) Users() (model.Users, error) {
 			media_playback_rate,
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
+			block_filter_expression,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			remove_read_later_on_read
 		FROM
 			users
 		ORDER BY username ASC
@@ -654,8 +631,10 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.MediaPlaybackRate,
 			&user.BlockFilterEntryRules,
 			&user.KeepFilterEntryRules,
+			&user.BlockFilterExpression,
 			&user.AlwaysOpenExternalLinks,
 			&user.OpenExternalLinksInNewTab,
+			&user.RemoveReadLaterOnRead,
 		)
 
 		if err != nil {
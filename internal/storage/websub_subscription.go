@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+const webSubSubscriptionColumns = `
+	w.feed_id,
+	f.user_id,
+	w.hub_url,
+	w.topic_url,
+	w.token,
+	w.secret,
+	w.state,
+	w.lease_expires_at,
+	w.requested_at,
+	w.last_push_at,
+	w.created_at
+`
+
+// SaveWebSubHub records the WebSub hub announced by the feed.
+// The subscription is requested again when the hub or the topic changes.
+func (s *Storage) SaveWebSubHub(feedID int64, hubURL, topicURL string) error {
+	query := `
+		INSERT INTO websub_subscriptions
+			(feed_id, hub_url, topic_url, token, secret)
+		VALUES
+			($1, $2, $3, $4, $5)
+		ON CONFLICT (feed_id) DO UPDATE SET
+			hub_url=EXCLUDED.hub_url,
+			topic_url=EXCLUDED.topic_url,
+			state='pending',
+			lease_expires_at=NULL,
+			requested_at=NULL
+		WHERE
+			websub_subscriptions.hub_url <> EXCLUDED.hub_url OR websub_subscriptions.topic_url <> EXCLUDED.topic_url
+	`
+	_, err := s.db.Exec(
+		query,
+		feedID,
+		hubURL,
+		topicURL,
+		crypto.GenerateRandomStringHex(32),
+		crypto.GenerateRandomStringHex(32),
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to save WebSub hub of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// RemoveWebSubSubscription forgets the WebSub subscription of the feed, the hub stops pushing when the callback is rejected.
+func (s *Storage) RemoveWebSubSubscription(feedID int64) error {
+	if _, err := s.db.Exec(`DELETE FROM websub_subscriptions WHERE feed_id=$1`, feedID); err != nil {
+		return fmt.Errorf(`store: unable to remove WebSub subscription of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// WebSubSubscriptionByToken returns the WebSub subscription matching the token of the callback URL.
+func (s *Storage) WebSubSubscriptionByToken(token string) (*model.WebSubSubscription, error) {
+	query := `
+		SELECT ` + webSubSubscriptionColumns + `
+		FROM
+			websub_subscriptions w
+		INNER JOIN
+			feeds f ON f.id=w.feed_id
+		WHERE
+			w.token=$1
+	`
+	subscription, err := scanWebSubSubscription(s.db.QueryRow(query, token))
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch WebSub subscription: %v`, err)
+	}
+
+	return subscription, nil
+}
+
+// WebSubSubscriptionsToRequest returns the subscriptions never requested, the subscriptions expiring before renewBefore,
+// and the subscriptions without lease requested before retryBefore. The subscriptions of disabled feeds are ignored.
+func (s *Storage) WebSubSubscriptionsToRequest(renewBefore, retryBefore time.Time, limit int) (model.WebSubSubscriptions, error) {
+	query := `
+		SELECT ` + webSubSubscriptionColumns + `
+		FROM
+			websub_subscriptions w
+		INNER JOIN
+			feeds f ON f.id=w.feed_id
+		WHERE
+			f.disabled IS false AND (
+				w.requested_at IS NULL OR
+				w.lease_expires_at < $1 OR
+				(w.lease_expires_at IS NULL AND w.requested_at < $2)
+			)
+		ORDER BY
+			w.requested_at ASC NULLS FIRST
+		LIMIT $3
+	`
+	rows, err := s.db.Query(query, renewBefore, retryBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch WebSub subscriptions: %v`, err)
+	}
+	defer rows.Close()
+
+	subscriptions := make(model.WebSubSubscriptions, 0)
+	for rows.Next() {
+		subscription, err := scanWebSubSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch WebSub subscription row: %v`, err)
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// MarkWebSubSubscriptionRequested records that the subscription was sent to the hub.
+func (s *Storage) MarkWebSubSubscriptionRequested(feedID int64) error {
+	if _, err := s.db.Exec(`UPDATE websub_subscriptions SET requested_at=now() WHERE feed_id=$1`, feedID); err != nil {
+		return fmt.Errorf(`store: unable to update WebSub subscription of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// ConfirmWebSubSubscription records the subscription verified by the hub, with the expiration date of its lease.
+func (s *Storage) ConfirmWebSubSubscription(feedID int64, leaseExpiresAt *time.Time) error {
+	query := `UPDATE websub_subscriptions SET state=$2, lease_expires_at=$3 WHERE feed_id=$1`
+	if _, err := s.db.Exec(query, feedID, model.WebSubStateSubscribed, leaseExpiresAt); err != nil {
+		return fmt.Errorf(`store: unable to confirm WebSub subscription of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// DenyWebSubSubscription records the subscription refused by the hub, it is requested again later.
+func (s *Storage) DenyWebSubSubscription(feedID int64) error {
+	query := `UPDATE websub_subscriptions SET state=$2, lease_expires_at=NULL WHERE feed_id=$1`
+	if _, err := s.db.Exec(query, feedID, model.WebSubStateDenied); err != nil {
+		return fmt.Errorf(`store: unable to deny WebSub subscription of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// RecordWebSubPush records the content pushed by the hub, and postpones the next check of the feed to nextCheckAt.
+func (s *Storage) RecordWebSubPush(feedID int64, nextCheckAt time.Time) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`UPDATE websub_subscriptions SET last_push_at=now() WHERE feed_id=$1`, feedID); err != nil {
+		return fmt.Errorf(`store: unable to record WebSub push of feed #%d: %v`, feedID, err)
+	}
+
+	if _, err := tx.Exec(`UPDATE feeds SET next_check_at=GREATEST(next_check_at, $2) WHERE id=$1`, feedID, nextCheckAt); err != nil {
+		return fmt.Errorf(`store: unable to postpone the next check of feed #%d: %v`, feedID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+type webSubSubscriptionScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWebSubSubscription(scanner webSubSubscriptionScanner) (*model.WebSubSubscription, error) {
+	var subscription model.WebSubSubscription
+	err := scanner.Scan(
+		&subscription.FeedID,
+		&subscription.UserID,
+		&subscription.HubURL,
+		&subscription.TopicURL,
+		&subscription.Token,
+		&subscription.Secret,
+		&subscription.State,
+		&subscription.LeaseExpiresAt,
+		&subscription.RequestedAt,
+		&subscription.LastPushAt,
+		&subscription.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package syndication // import "miniflux.app/v2/internal/syndication"
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"miniflux.app/v2/internal/version"
+)
+
+// Specs: https://datatracker.ietf.org/doc/html/rfc4287
+type atomFeed struct {
+	XMLName   xml.Name      `xml:"http://www.w3.org/2005/Atom feed"`
+	ID        string        `xml:"id"`
+	Title     atomText      `xml:"title"`
+	Updated   string        `xml:"updated"`
+	Links     []atomLink    `xml:"link"`
+	Generator atomGenerator `xml:"generator"`
+	Entries   []atomEntry   `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      atomText       `xml:"title"`
+	Links      []atomLink     `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomPerson    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomText       `xml:"content"`
+	Source     *atomSource    `xml:"source,omitempty"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Data string `xml:",chardata"`
+}
+
+type atomLink struct {
+	Rel    string `xml:"rel,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	Href   string `xml:"href,attr"`
+	Length int64  `xml:"length,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomGenerator struct {
+	URI     string `xml:"uri,attr"`
+	Version string `xml:"version,attr"`
+	Name    string `xml:",chardata"`
+}
+
+type atomSource struct {
+	Title string     `xml:"title"`
+	Links []atomLink `xml:"link"`
+}
+
+// WriteAtom writes the feed as an Atom 1.0 document.
+func WriteAtom(w io.Writer, feed *Feed) error {
+	document := &atomFeed{
+		ID:      feed.FeedURL,
+		Title:   atomText{Type: "text", Data: feed.Title},
+		Updated: feed.updated().UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Type: "application/atom+xml", Href: feed.FeedURL},
+			{Rel: "alternate", Type: "text/html", Href: feed.SiteURL},
+		},
+		Generator: atomGenerator{URI: generatorURI, Version: version.Version, Name: generatorName},
+		Entries:   make([]atomEntry, 0, len(feed.Entries)),
+	}
+
+	for _, entry := range feed.Entries {
+		atomEntry := atomEntry{
+			ID:        entryID(entry),
+			Title:     atomText{Type: "text", Data: entry.Title},
+			Published: entry.Date.UTC().Format(time.RFC3339),
+			Updated:   entryModificationDate(entry).UTC().Format(time.RFC3339),
+			Content:   atomText{Type: "html", Data: entry.Content},
+		}
+
+		if entry.URL != "" {
+			atomEntry.Links = append(atomEntry.Links, atomLink{Rel: "alternate", Type: "text/html", Href: entry.URL})
+		}
+
+		if entry.CommentsURL != "" {
+			atomEntry.Links = append(atomEntry.Links, atomLink{Rel: "replies", Type: "text/html", Href: entry.CommentsURL})
+		}
+
+		for _, enclosure := range entry.Enclosures {
+			atomEntry.Links = append(atomEntry.Links, atomLink{Rel: "enclosure", Type: enclosure.MimeType, Href: enclosure.URL, Length: enclosure.Size})
+		}
+
+		if entry.Author != "" {
+			atomEntry.Author = &atomPerson{Name: entry.Author}
+		}
+
+		for _, tag := range entry.Tags {
+			atomEntry.Categories = append(atomEntry.Categories, atomCategory{Term: tag})
+		}
+
+		if entry.Feed != nil {
+			atomEntry.Source = &atomSource{
+				Title: entry.Feed.Title,
+				Links: []atomLink{{Rel: "alternate", Type: "text/html", Href: entry.Feed.SiteURL}},
+			}
+		}
+
+		document.Entries = append(document.Entries, atomEntry)
+	}
+
+	return writeXML(w, document)
+}
+
+func writeXML(w io.Writer, document any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("syndication: unable to write XML document: %w", err)
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("syndication: unable to write XML document: %w", err)
+	}
+
+	return nil
+}
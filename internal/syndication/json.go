@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package syndication // import "miniflux.app/v2/internal/syndication"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Specs: https://www.jsonfeed.org/version/1.1/
+type jsonFeed struct {
+	Version     string     `json:"version"`
+	Title       string     `json:"title"`
+	HomePageURL string     `json:"home_page_url,omitempty"`
+	FeedURL     string     `json:"feed_url,omitempty"`
+	Items       []jsonItem `json:"items"`
+}
+
+type jsonItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url,omitempty"`
+	Title         string           `json:"title"`
+	ContentHTML   string           `json:"content_html"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified"`
+	Authors       []jsonAuthor     `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+	Language      string           `json:"language,omitempty"`
+	Attachments   []jsonAttachment `json:"attachments,omitempty"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonAttachment struct {
+	URL         string `json:"url"`
+	MimeType    string `json:"mime_type"`
+	SizeInBytes int64  `json:"size_in_bytes,omitempty"`
+}
+
+// WriteJSONFeed writes the feed as a JSON Feed 1.1 document.
+func WriteJSONFeed(w io.Writer, feed *Feed) error {
+	document := &jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       feed.Title,
+		HomePageURL: feed.SiteURL,
+		FeedURL:     feed.FeedURL,
+		Items:       make([]jsonItem, 0, len(feed.Entries)),
+	}
+
+	for _, entry := range feed.Entries {
+		item := jsonItem{
+			ID:            entryID(entry),
+			URL:           entry.URL,
+			Title:         entry.Title,
+			ContentHTML:   entry.Content,
+			DatePublished: entry.Date.UTC().Format(time.RFC3339),
+			DateModified:  entryModificationDate(entry).UTC().Format(time.RFC3339),
+			Tags:          entry.Tags,
+			Language:      entry.Language,
+		}
+
+		if entry.Author != "" {
+			item.Authors = []jsonAuthor{{Name: entry.Author}}
+		}
+
+		for _, enclosure := range entry.Enclosures {
+			item.Attachments = append(item.Attachments, jsonAttachment{
+				URL:         enclosure.URL,
+				MimeType:    enclosure.MimeType,
+				SizeInBytes: enclosure.Size,
+			})
+		}
+
+		document.Items = append(document.Items, item)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("syndication: unable to write JSON feed: %w", err)
+	}
+
+	return nil
+}
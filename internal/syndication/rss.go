@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package syndication // import "miniflux.app/v2/internal/syndication"
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"miniflux.app/v2/internal/version"
+)
+
+// Specs: https://www.rssboard.org/rss-specification
+type rssDocument struct {
+	XMLName         xml.Name   `xml:"rss"`
+	Version         string     `xml:"version,attr"`
+	AtomNamespace   string     `xml:"xmlns:atom,attr"`
+	DublinNamespace string     `xml:"xmlns:dc,attr"`
+	Channel         rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	AtomLink      rssLink   `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Generator     string    `xml:"generator"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link,omitempty"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Creator     string        `xml:"dc:creator,omitempty"`
+	Categories  []string      `xml:"category"`
+	Description string        `xml:"description"`
+	Comments    string        `xml:"comments,omitempty"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+	Source      *rssSource    `xml:"source,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length string `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssSource struct {
+	URL   string `xml:"url,attr"`
+	Title string `xml:",chardata"`
+}
+
+// WriteRSS writes the feed as a RSS 2.0 document.
+func WriteRSS(w io.Writer, feed *Feed) error {
+	document := &rssDocument{
+		Version:         "2.0",
+		AtomNamespace:   "http://www.w3.org/2005/Atom",
+		DublinNamespace: "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:         feed.Title,
+			Link:          feed.SiteURL,
+			Description:   feed.Title,
+			AtomLink:      rssLink{Href: feed.FeedURL, Rel: "self", Type: "application/rss+xml"},
+			LastBuildDate: feed.updated().UTC().Format(time.RFC1123Z),
+			Generator:     generatorName + " " + version.Version,
+			Items:         make([]rssItem, 0, len(feed.Entries)),
+		},
+	}
+
+	for _, entry := range feed.Entries {
+		item := rssItem{
+			Title:       entry.Title,
+			Link:        entry.URL,
+			GUID:        rssGUID{IsPermaLink: "false", Value: entryID(entry)},
+			PubDate:     entry.Date.UTC().Format(time.RFC1123Z),
+			Creator:     entry.Author,
+			Categories:  entry.Tags,
+			Description: entry.Content,
+			Comments:    entry.CommentsURL,
+		}
+
+		// RSS 2.0 allows only one enclosure per item.
+		if len(entry.Enclosures) > 0 {
+			enclosure := entry.Enclosures[0]
+			item.Enclosure = &rssEnclosure{
+				URL:    enclosure.URL,
+				Length: strconv.FormatInt(enclosure.Size, 10),
+				Type:   enclosure.MimeType,
+			}
+		}
+
+		if entry.Feed != nil && entry.Feed.FeedURL != "" {
+			item.Source = &rssSource{URL: entry.Feed.FeedURL, Title: entry.Feed.Title}
+		}
+
+		document.Channel.Items = append(document.Channel.Items, item)
+	}
+
+	return writeXML(w, document)
+}
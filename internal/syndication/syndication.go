@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package syndication // import "miniflux.app/v2/internal/syndication"
+
+import (
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+const (
+	AtomMimeType     = "application/atom+xml; charset=utf-8"
+	RSSMimeType      = "application/rss+xml; charset=utf-8"
+	JSONFeedMimeType = "application/feed+json; charset=utf-8"
+
+	generatorName = "Miniflux"
+	generatorURI  = "https://miniflux.app/"
+)
+
+// Feed describes the feed generated from a list of entries.
+type Feed struct {
+	// Title is the title of the feed.
+	Title string
+
+	// SiteURL is the address of the HTML page corresponding to the feed.
+	SiteURL string
+
+	// FeedURL is the address of the feed itself.
+	FeedURL string
+
+	// Entries are written in the given order.
+	Entries model.Entries
+}
+
+// updated returns the most recent modification date of the entries.
+func (f *Feed) updated() time.Time {
+	var updated time.Time
+	for _, entry := range f.Entries {
+		if entryUpdated := entryModificationDate(entry); entryUpdated.After(updated) {
+			updated = entryUpdated
+		}
+	}
+
+	if updated.IsZero() {
+		return time.Now()
+	}
+
+	return updated
+}
+
+// entryID returns a stable identifier for the entry, independent of the feed it is published in.
+func entryID(entry *model.Entry) string {
+	return "urn:miniflux:entry:" + entry.Hash
+}
+
+func entryModificationDate(entry *model.Entry) time.Time {
+	if entry.ChangedAt.After(entry.Date) {
+		return entry.ChangedAt
+	}
+
+	return entry.Date
+}
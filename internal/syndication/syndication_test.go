@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package syndication // import "miniflux.app/v2/internal/syndication"
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/parser"
+)
+
+func newTestFeed() *Feed {
+	return &Feed{
+		Title:   "Starred <entries>",
+		SiteURL: "https://miniflux.example.org/",
+		FeedURL: "https://miniflux.example.org/publish/token/feed",
+		Entries: model.Entries{
+			{
+				Hash:        "hash1",
+				Title:       "First & foremost",
+				URL:         "https://example.org/first",
+				CommentsURL: "https://example.org/first#comments",
+				Date:        time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+				ChangedAt:   time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+				Content:     `<p>Some <b>bold</b> text</p>`,
+				Author:      "Jane Doe",
+				Tags:        []string{"go", "web"},
+				Enclosures: model.EnclosureList{
+					{URL: "https://example.org/first.mp3", MimeType: "audio/mpeg", Size: 1234},
+				},
+				Feed: &model.Feed{Title: "Example", SiteURL: "https://example.org/", FeedURL: "https://example.org/feed.xml"},
+			},
+			{
+				Hash:    "hash2",
+				Title:   "Second",
+				URL:     "https://example.org/second",
+				Date:    time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC),
+				Content: `<p>Second entry</p>`,
+			},
+		},
+	}
+}
+
+func parseWrittenFeed(t *testing.T, write func(io.Writer, *Feed) error) *model.Feed {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	if err := write(&buffer, newTestFeed()); err != nil {
+		t.Fatalf(`Unable to write the feed: %v`, err)
+	}
+
+	feed, err := parser.ParseFeed("https://miniflux.example.org/", bytes.NewReader(buffer.Bytes()))
+	if err != nil {
+		t.Fatalf(`Unable to parse the written feed: %v`, err)
+	}
+
+	return feed
+}
+
+func checkParsedFeed(t *testing.T, feed *model.Feed) {
+	t.Helper()
+
+	if feed.Title != "Starred <entries>" {
+		t.Errorf(`Unexpected feed title: %q`, feed.Title)
+	}
+
+	if feed.SiteURL != "https://miniflux.example.org/" {
+		t.Errorf(`Unexpected site URL: %q`, feed.SiteURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Unexpected number of entries: %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "First & foremost" {
+		t.Errorf(`Unexpected entry title: %q`, entry.Title)
+	}
+
+	if entry.URL != "https://example.org/first" {
+		t.Errorf(`Unexpected entry URL: %q`, entry.URL)
+	}
+
+	if !strings.Contains(entry.Content, "<b>bold</b>") {
+		t.Errorf(`Unexpected entry content: %q`, entry.Content)
+	}
+
+	if entry.Author != "Jane Doe" {
+		t.Errorf(`Unexpected entry author: %q`, entry.Author)
+	}
+
+	if !entry.Date.Equal(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected entry date: %v`, entry.Date)
+	}
+
+	if !slices.Equal(entry.Tags, []string{"go", "web"}) {
+		t.Errorf(`Unexpected entry tags: %v`, entry.Tags)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].URL != "https://example.org/first.mp3" || entry.Enclosures[0].Size != 1234 {
+		t.Errorf(`Unexpected entry enclosures: %v`, entry.Enclosures)
+	}
+}
+
+func TestWriteAtom(t *testing.T) {
+	checkParsedFeed(t, parseWrittenFeed(t, WriteAtom))
+}
+
+func TestWriteRSS(t *testing.T) {
+	checkParsedFeed(t, parseWrittenFeed(t, WriteRSS))
+}
+
+func TestWriteJSONFeed(t *testing.T) {
+	checkParsedFeed(t, parseWrittenFeed(t, WriteJSONFeed))
+}
+
+func TestWriteRSSWithSeveralEnclosures(t *testing.T) {
+	feed := newTestFeed()
+	feed.Entries[0].Enclosures = append(feed.Entries[0].Enclosures, &model.Enclosure{URL: "https://example.org/first.ogg", MimeType: "audio/ogg"})
+
+	var buffer bytes.Buffer
+	if err := WriteRSS(&buffer, feed); err != nil {
+		t.Fatalf(`Unable to write the feed: %v`, err)
+	}
+
+	if count := strings.Count(buffer.String(), "<enclosure "); count != 1 {
+		t.Errorf(`Expected only one enclosure per item, got %d`, count)
+	}
+}
+
+func TestFeedUpdatedDate(t *testing.T) {
+	if updated := newTestFeed().updated(); !updated.Equal(time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected updated date: %v`, updated)
+	}
+
+	if updated := (&Feed{}).updated(); updated.IsZero() {
+		t.Error(`The updated date of an empty feed should not be zero`)
+	}
+}
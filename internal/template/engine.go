@@ -35,36 +35,50 @@ func NewEngine(basePath string) *Engine {
 func (e *Engine) ParseTemplates() {
 	funcMap := e.funcMap.Map()
 	templates := map[string][]string{ // this isn't a global variable so that it can be garbage-collected.
-		"about.html":               {"layout.html", "settings_menu.html"},
-		"add_subscription.html":    {"feed_menu.html", "layout.html", "settings_menu.html"},
-		"api_keys.html":            {"layout.html", "settings_menu.html"},
-		"starred_entries.html":     {"item_meta.html", "layout.html", "pagination.html"},
-		"categories.html":          {"layout.html"},
-		"category_entries.html":    {"item_meta.html", "layout.html", "pagination.html"},
-		"category_feeds.html":      {"feed_list.html", "layout.html"},
-		"choose_subscription.html": {"feed_menu.html", "layout.html"},
-		"create_api_key.html":      {"layout.html", "settings_menu.html"},
-		"create_category.html":     {"layout.html"},
-		"create_user.html":         {"layout.html", "settings_menu.html"},
-		"edit_category.html":       {"layout.html", "settings_menu.html"},
-		"edit_feed.html":           {"layout.html"},
-		"edit_user.html":           {"layout.html", "settings_menu.html"},
-		"entry.html":               {"layout.html"},
-		"feed_entries.html":        {"item_meta.html", "layout.html", "pagination.html"},
-		"feeds.html":               {"feed_list.html", "feed_menu.html", "item_meta.html", "layout.html", "pagination.html"},
-		"history_entries.html":     {"item_meta.html", "layout.html", "pagination.html"},
-		"import.html":              {"feed_menu.html", "layout.html"},
-		"integrations.html":        {"layout.html", "settings_menu.html"},
-		"login.html":               {"layout.html"},
-		"offline.html":             {},
-		"search.html":              {"item_meta.html", "layout.html", "pagination.html"},
-		"sessions.html":            {"layout.html", "settings_menu.html"},
-		"settings.html":            {"layout.html", "settings_menu.html"},
-		"shared_entries.html":      {"layout.html", "pagination.html"},
-		"tag_entries.html":         {"item_meta.html", "layout.html", "pagination.html"},
-		"unread_entries.html":      {"item_meta.html", "layout.html", "pagination.html"},
-		"users.html":               {"layout.html", "settings_menu.html"},
-		"webauthn_rename.html":     {"layout.html"},
+		"about.html":                 {"layout.html", "settings_menu.html"},
+		"add_subscription.html":      {"feed_menu.html", "layout.html", "settings_menu.html"},
+		"api_keys.html":              {"layout.html", "settings_menu.html"},
+		"read_later_entries.html":    {"item_meta.html", "layout.html", "pagination.html"},
+		"starred_entries.html":       {"item_meta.html", "layout.html", "pagination.html"},
+		"audit_log.html":             {"layout.html", "pagination.html", "settings_menu.html"},
+		"categories.html":            {"layout.html"},
+		"category_entries.html":      {"item_meta.html", "layout.html", "pagination.html"},
+		"category_feeds.html":        {"feed_list.html", "layout.html"},
+		"choose_subscription.html":   {"feed_menu.html", "layout.html"},
+		"create_api_key.html":        {"layout.html", "settings_menu.html"},
+		"create_category.html":       {"layout.html"},
+		"create_published_feed.html": {"layout.html", "settings_menu.html"},
+		"create_user.html":           {"layout.html", "settings_menu.html"},
+		"edit_category.html":         {"layout.html", "settings_menu.html"},
+		"edit_feed.html":             {"layout.html"},
+		"edit_highlight.html":        {"layout.html"},
+		"edit_user.html":             {"layout.html", "settings_menu.html"},
+		"entry.html":                 {"layout.html"},
+		"entry_archive.html":         {"layout.html"},
+		"entry_revisions.html":       {"layout.html"},
+		"feed_entries.html":          {"item_meta.html", "layout.html", "pagination.html"},
+		"feeds.html":                 {"feed_list.html", "feed_menu.html", "item_meta.html", "layout.html", "pagination.html"},
+		"highlights.html":            {"layout.html", "pagination.html"},
+		"history_entries.html":       {"item_meta.html", "layout.html", "pagination.html"},
+		"import.html":                {"feed_menu.html", "layout.html"},
+		"integrations.html":          {"layout.html", "settings_menu.html"},
+		"login.html":                 {"layout.html"},
+		"login_lockouts.html":        {"layout.html", "settings_menu.html"},
+		"login_totp.html":            {"layout.html"},
+		"offline.html":               {},
+		"published_feeds.html":       {"layout.html", "settings_menu.html"},
+		"saved_search_entries.html":  {"item_meta.html", "layout.html", "pagination.html"},
+		"search.html":                {"item_meta.html", "layout.html", "pagination.html"},
+		"sessions.html":              {"layout.html", "settings_menu.html"},
+		"settings.html":              {"layout.html", "settings_menu.html"},
+		"shared_entries.html":        {"layout.html", "pagination.html"},
+		"totp.html":                  {"layout.html", "settings_menu.html"},
+		"totp_recovery_codes.html":   {"layout.html", "settings_menu.html"},
+		"totp_setup.html":            {"layout.html", "settings_menu.html"},
+		"tag_entries.html":           {"item_meta.html", "layout.html", "pagination.html"},
+		"unread_entries.html":        {"item_meta.html", "layout.html", "pagination.html"},
+		"users.html":                 {"layout.html", "settings_menu.html"},
+		"webauthn_rename.html":       {"layout.html"},
 	}
 
 	for name, dependencies := range templates {
@@ -48,6 +48,7 @@ func (f *funcMap) Map() template.FuncMap {
 		"isEmail":          isEmail,
 		"baseURL":          config.Opts.BaseURL,
 		"apiEnabled":       config.Opts.HasAPI,
+		"offlineArchive":   config.Opts.OfflineArchive,
 		"rootURL":          config.Opts.RootURL,
 		"disableLocalAuth": config.Opts.DisableLocalAuth,
 		"oidcProviderName": config.Opts.OAuth2OIDCProviderName,
@@ -102,6 +103,9 @@ func (f *funcMap) Map() template.FuncMap {
 		"deRef":     func(i *int) int { return *i },
 		"duration":  duration,
 		"urlEncode": url.PathEscape,
+		"add": func(a, b int) int {
+			return a + b
+		},
 		"subtract": func(a, b int) int {
 			return a - b
 		},
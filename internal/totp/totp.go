@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package totp implements the time-based one-time passwords of RFC 6238 used for the two-factor authentication.
+package totp // import "miniflux.app/v2/internal/totp"
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+)
+
+const (
+	period = 30
+	digits = 6
+
+	// skew is the number of periods accepted before and after the current one, to tolerate the clock drift of the devices.
+	skew = 1
+
+	secretSize        = 20
+	recoveryCodeCount = 10
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random secret encoded in base32, as expected by the authenticator applications.
+func GenerateSecret() string {
+	return encoding.EncodeToString(crypto.GenerateRandomBytes(secretSize))
+}
+
+// ProvisioningURI returns the otpauth URI used by the authenticator applications to register the secret, usually scanned as a QR code.
+func ProvisioningURI(issuer, accountName, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", issuer)
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprint(digits))
+	values.Set("period", fmt.Sprint(period))
+
+	return "otpauth://totp/" + url.PathEscape(issuer+":"+accountName) + "?" + values.Encode()
+}
+
+// GenerateCode returns the code of the secret for the given time.
+func GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hotp(key, uint64(t.Unix()/period)), nil
+}
+
+// Validate checks the code against the secret for the given time, and returns the time step of the code.
+// The codes of the time steps up to lastStep are rejected, so a code cannot be used twice.
+func Validate(secret, code string, t time.Time, lastStep int64) (int64, bool) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != digits {
+		return 0, false
+	}
+
+	currentStep := t.Unix() / period
+	for step := currentStep - skew; step <= currentStep+skew; step++ {
+		if step <= lastStep {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(hotp(key, uint64(step))), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// GenerateRecoveryCodes returns new random recovery codes, each one can be used once instead of a code.
+func GenerateRecoveryCodes() []string {
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		text := rand.Text()
+		codes[i] = text[:5] + "-" + text[5:10]
+	}
+	return codes
+}
+
+// HashRecoveryCode returns the hash stored for the recovery code. The codes are random, a fast hash is enough.
+func HashRecoveryCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	return crypto.SHA256(code)
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("totp: invalid secret: %w", err)
+	}
+	return key, nil
+}
+
+// hotp implements the HMAC-based one-time password algorithm of RFC 4226.
+func hotp(key []byte, counter uint64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", digits, value%1000000)
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package totp // import "miniflux.app/v2/internal/totp"
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the secret of the test vectors of RFC 4226 and RFC 6238.
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestHOTPWithRFCTestVectors(t *testing.T) {
+	expected := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+
+	for counter, code := range expected {
+		if result := hotp([]byte("12345678901234567890"), uint64(counter)); result != code {
+			t.Errorf(`Unexpected code for counter %d: got %q instead of %q`, counter, result, code)
+		}
+	}
+}
+
+func TestGenerateCodeWithRFCTestVectors(t *testing.T) {
+	// The RFC 6238 test vectors have 8 digits, the codes are their last 6 digits.
+	scenarios := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for timestamp, expected := range scenarios {
+		code, err := GenerateCode(rfcSecret, time.Unix(timestamp, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if code != expected {
+			t.Errorf(`Unexpected code at %d: got %q instead of %q`, timestamp, code, expected)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := GenerateSecret()
+	now := time.Now()
+
+	code, err := GenerateCode(secret, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	step, valid := Validate(secret, code, now, 0)
+	if !valid {
+		t.Fatal(`The current code should be valid`)
+	}
+
+	if _, valid := Validate(secret, code, now, step); valid {
+		t.Error(`A code should not be accepted twice`)
+	}
+
+	if _, valid := Validate(secret, code, now.Add(period*time.Second), 0); !valid {
+		t.Error(`The code of the previous period should be accepted`)
+	}
+
+	if _, valid := Validate(secret, code, now.Add(3*period*time.Second), 0); valid {
+		t.Error(`An old code should be rejected`)
+	}
+
+	if _, valid := Validate(secret, "12345", now, 0); valid {
+		t.Error(`A code with the wrong length should be rejected`)
+	}
+
+	if _, valid := Validate("not base32!", code, now, 0); valid {
+		t.Error(`An invalid secret should be rejected`)
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("Miniflux", "john doe", "JBSWY3DPEHPK3PXP")
+	expected := "otpauth://totp/Miniflux:john%20doe?algorithm=SHA1&digits=6&issuer=Miniflux&period=30&secret=JBSWY3DPEHPK3PXP"
+
+	if uri != expected {
+		t.Errorf(`Unexpected URI: got %q instead of %q`, uri, expected)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes := GenerateRecoveryCodes()
+	if len(codes) != recoveryCodeCount {
+		t.Fatalf(`Unexpected number of recovery codes: %d`, len(codes))
+	}
+
+	hashes := make(map[string]bool)
+	for _, code := range codes {
+		hashes[HashRecoveryCode(code)] = true
+	}
+	if len(hashes) != len(codes) {
+		t.Error(`The recovery codes should be different`)
+	}
+
+	code := codes[0]
+	if HashRecoveryCode(" "+strings.ToLower(strings.ReplaceAll(code, "-", ""))+" ") != HashRecoveryCode(code) {
+		t.Error(`The recovery codes should be case insensitive and ignore the separators`)
+	}
+}
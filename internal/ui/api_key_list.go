@@ -31,6 +31,7 @@ func (h *handler) showAPIKeysPage(w http.ResponseWriter, r *http.Request) {
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 
 	response.HTML(w, r, view.Render("api_keys"))
 }
@@ -4,18 +4,29 @@
 package ui // import "miniflux.app/v2/internal/ui"
 
 import (
+	"fmt"
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
 )
 
 func (h *handler) deleteAPIKey(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	keyID := request.RouteInt64Param(r, "keyID")
-	if err := h.store.DeleteAPIKey(request.UserID(r), keyID); err != nil {
+	if err := h.store.DeleteAPIKey(user.ID, keyID); err != nil {
 		response.HTMLServerError(w, r, err)
 		return
 	}
 
+	audit.Record(h.store, r, user, model.AuditLogActionAPIKeyDeleted, fmt.Sprintf("api_key:%d", keyID))
+
 	response.HTMLRedirect(w, r, h.routePath("/keys"))
 }
@@ -4,8 +4,10 @@
 package ui // import "miniflux.app/v2/internal/ui"
 
 import (
+	"fmt"
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/model"
@@ -22,27 +24,33 @@ func (h *handler) saveAPIKey(w http.ResponseWriter, r *http.Request) {
 	}
 
 	apiKeyForm := form.NewAPIKeyForm(r)
-	apiKeyCreationRequest := &model.APIKeyCreationRequest{
-		Description: apiKeyForm.Description,
+	apiKeyCreationRequest, validationErr := apiKeyForm.CreationRequest(user.Timezone)
+	if validationErr == nil {
+		validationErr = validator.ValidateAPIKeyCreation(h.store, user.ID, apiKeyCreationRequest)
 	}
 
-	if validationErr := validator.ValidateAPIKeyCreation(h.store, user.ID, apiKeyCreationRequest); validationErr != nil {
+	if validationErr != nil {
 		view := view.New(h.tpl, r)
 		view.Set("form", apiKeyForm)
+		view.Set("scopes", model.APIKeyScopes)
 		view.Set("menu", "settings")
 		view.Set("user", user)
 		navMetadata, _ := h.store.GetNavMetadata(user.ID)
 		view.Set("countUnread", navMetadata.CountUnread)
 		view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+		view.Set("savedSearches", navMetadata.SavedSearches)
 		view.Set("errorMessage", validationErr.Translate(user.Language))
 		response.HTML(w, r, view.Render("create_api_key"))
 		return
 	}
 
-	if _, err = h.store.CreateAPIKey(user.ID, apiKeyCreationRequest.Description); err != nil {
+	apiKey, err := h.store.CreateAPIKey(user.ID, apiKeyCreationRequest)
+	if err != nil {
 		response.HTMLServerError(w, r, err)
 		return
 	}
 
+	audit.Record(h.store, r, user, model.AuditLogActionAPIKeyCreated, fmt.Sprintf("api_key:%d", apiKey.ID))
+
 	response.HTMLRedirect(w, r, h.routePath("/keys"))
 }
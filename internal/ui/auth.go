@@ -13,6 +13,7 @@ import (
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/oauth2"
 	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/totp"
 )
 
 const sessionCookieName = "MinifluxSessionID"
@@ -32,6 +33,25 @@ func authenticateWebSession(w http.ResponseWriter, r *http.Request, store *stora
 	return nil
 }
 
+// verifyTwoFactorCode checks the TOTP code or one of the recovery codes of the user.
+// The codes are consumed, they cannot be used twice.
+func verifyTwoFactorCode(store *storage.Storage, userID int64, code string) (bool, error) {
+	userTOTP, err := store.UserTOTP(userID)
+	if err != nil {
+		return false, err
+	}
+
+	if userTOTP == nil || !userTOTP.Enabled {
+		return false, nil
+	}
+
+	if step, valid := totp.Validate(userTOTP.Secret, code, time.Now(), userTOTP.LastUsedStep); valid {
+		return store.UseTOTPStep(userID, step)
+	}
+
+	return store.UseTOTPRecoveryCode(userID, totp.HashRecoveryCode(code))
+}
+
 // setSessionCookie writes the session cookie to the response with the
 // security attributes used by miniflux (HttpOnly, SameSite=Lax, Secure
 // when HTTPS).
@@ -8,6 +8,7 @@ import (
 
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/view"
 )
 
@@ -18,12 +19,21 @@ func (h *handler) showCreateCategoryPage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	parentCategories, err := h.store.Categories(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	view := view.New(h.tpl, r)
+	view.Set("form", &form.CategoryForm{})
+	view.Set("parentCategories", parentCategories)
 	view.Set("menu", "categories")
 	view.Set("user", user)
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 
 	response.HTML(w, r, view.Render("create_category"))
 }
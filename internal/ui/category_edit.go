@@ -5,9 +5,12 @@ package ui // import "miniflux.app/v2/internal/ui"
 
 import (
 	"net/http"
+	"slices"
 
+	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/view"
 )
@@ -31,11 +34,21 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	categoryForm := form.CategoryForm{
-		Title:        category.Title,
-		HideGlobally: category.HideGlobally,
+		Title:                 category.Title,
+		HideGlobally:          category.HideGlobally,
+		ParentID:              category.ParentID,
+		BlockFilterExpression: category.BlockFilterExpression,
+		FeedDefaults:          category.FeedDefaults,
+	}
+
+	parentCategories, err := h.categoryParentChoices(user.ID, category.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
 	}
 
 	view := view.New(h.tpl, r)
+	view.Set("parentCategories", parentCategories)
 	view.Set("form", categoryForm)
 	view.Set("category", category)
 	view.Set("menu", "categories")
@@ -43,6 +56,27 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
+	view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyURLConfigured())
 
 	response.HTML(w, r, view.Render("edit_category"))
 }
+
+// categoryParentChoices returns the categories that can become the parent of the given category,
+// the category itself and its subcategories are excluded.
+func (h *handler) categoryParentChoices(userID, categoryID int64) (model.Categories, error) {
+	categories, err := h.store.Categories(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	subtreeIDs, err := h.store.CategorySubtreeIDs(userID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(categories, func(category model.Category) bool {
+		return slices.Contains(subtreeIDs, category.ID)
+	}), nil
+}
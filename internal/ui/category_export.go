@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"bytes"
+	"net/http"
+
+	"miniflux.app/v2/internal/epub"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/archiver"
+)
+
+func (h *handler) exportCategoryEPUB(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+
+	category, err := h.store.Category(userID, categoryID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID).
+		WithCategoryID(categoryID).
+		WithSorting("published_at", "ASC").
+		WithLimitAndMaximum(archiver.MaxBookEntries, archiver.MaxBookEntries)
+
+	if request.QueryStringParam(r, "status", "") == model.EntryStatusUnread {
+		builder.WithStatuses(model.EntryStatusUnread)
+	}
+
+	if request.QueryBoolParam(r, "starred", false) {
+		builder.WithStarred(true)
+	}
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	var buffer bytes.Buffer
+	if err := archiver.ExportBook(&buffer, h.store, category.Title, entries); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	b := response.NewBuilder(w, r)
+	b.WithHeader("Content-Type", epub.MimeType)
+	b.WithAttachment(category.Title + ".epub")
+	b.WithBodyAsBytes(buffer.Bytes())
+	b.WithoutCompression()
+	b.Write()
+}
@@ -45,6 +45,7 @@ func (h *handler) showCategoryFeedsPage(w http.ResponseWriter, r *http.Request)
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 
 	response.HTML(w, r, view.Render("category_feeds"))
 }
@@ -32,6 +32,7 @@ func (h *handler) showCategoryListPage(w http.ResponseWriter, r *http.Request) {
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 
 	response.HTML(w, r, view.Render("categories"))
 }
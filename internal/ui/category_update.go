@@ -6,6 +6,7 @@ package ui // import "miniflux.app/v2/internal/ui"
 import (
 	"net/http"
 
+	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/model"
@@ -35,7 +36,14 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 
 	categoryForm := form.NewCategoryForm(r)
 
+	parentCategories, err := h.categoryParentChoices(user.ID, category.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	view := view.New(h.tpl, r)
+	view.Set("parentCategories", parentCategories)
 	view.Set("form", categoryForm)
 	view.Set("category", category)
 	view.Set("menu", "categories")
@@ -43,10 +51,16 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
+	view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyURLConfigured())
 
 	categoryRequest := &model.CategoryModificationRequest{
-		Title:        new(categoryForm.Title),
-		HideGlobally: new(categoryForm.HideGlobally),
+		Title:                 new(categoryForm.Title),
+		HideGlobally:          new(categoryForm.HideGlobally),
+		ParentID:              new(categoryForm.ParentID),
+		BlockFilterExpression: new(categoryForm.BlockFilterExpression),
+		FeedDefaults:          new(categoryForm.FeedDefaults),
 	}
 
 	if validationErr := validator.ValidateCategoryModification(h.store, user.ID, category.ID, categoryRequest); validationErr != nil {
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/epub"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/archiver"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showEntryArchivePage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	entry, archive, mediaList, ok := h.findEntryArchive(w, r)
+	if !ok {
+		return
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("entry", entry)
+	view.Set("archive", archive)
+	view.Set("archiveContent", archiver.RewriteImages(archive.Content, mediaList, func(media *model.ArchivedMedia) string {
+		return mediaproxy.ProxifyRelativeURL(media.URL)
+	}))
+	view.Set("menu", "starred")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+
+	response.HTML(w, r, view.Render("entry_archive"))
+}
+
+// createEntryArchive archives the entry when it has no archive yet, and shows the archive.
+func (h *handler) createEntryArchive(w http.ResponseWriter, r *http.Request) {
+	if !config.Opts.OfflineArchive() {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).
+		WithEntryIDs(entryID).
+		GetEntry()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	archive, err := h.store.EntryArchive(userID, entryID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if archive == nil {
+		_, err := archiver.ArchiveEntry(h.store, entry, config.Opts.OfflineArchiveMaxSizePerUser())
+		if errors.Is(err, archiver.ErrQuotaExceeded) {
+			sess := request.WebSession(r)
+			sess.SetErrorMessage(locale.NewPrinter(sess.Language()).Printf("error.offline_archive_quota_exceeded"))
+			response.HTMLRedirect(w, r, h.routePath("/feed/%d/entry/%d", entry.FeedID, entry.ID))
+			return
+		}
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/entry/archive/%d", entry.ID))
+}
+
+func (h *handler) exportEntryArchiveHTML(w http.ResponseWriter, r *http.Request) {
+	entry, archive, mediaList, ok := h.findEntryArchive(w, r)
+	if !ok {
+		return
+	}
+
+	document, err := archiver.ExportHTML(entry, archive, mediaList)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	builder := response.NewBuilder(w, r)
+	builder.WithHeader("Content-Type", "text/html; charset=utf-8")
+	builder.WithHeader("Content-Security-Policy", response.ContentSecurityPolicyForUntrustedContent)
+	builder.WithAttachment(fmt.Sprintf("entry-%d.html", entry.ID))
+	builder.WithBodyAsBytes(document)
+	builder.Write()
+}
+
+func (h *handler) exportEntryArchiveEPUB(w http.ResponseWriter, r *http.Request) {
+	entry, archive, mediaList, ok := h.findEntryArchive(w, r)
+	if !ok {
+		return
+	}
+
+	var buffer bytes.Buffer
+	if err := archiver.ExportEPUB(&buffer, entry, archive, mediaList); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	builder := response.NewBuilder(w, r)
+	builder.WithHeader("Content-Type", epub.MimeType)
+	builder.WithAttachment(fmt.Sprintf("entry-%d.epub", entry.ID))
+	builder.WithBodyAsBytes(buffer.Bytes())
+	builder.WithoutCompression()
+	builder.Write()
+}
+
+// findEntryArchive writes the error response and returns false when the entry or its archive cannot be found.
+func (h *handler) findEntryArchive(w http.ResponseWriter, r *http.Request) (*model.Entry, *model.EntryArchive, model.ArchivedMediaList, bool) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).
+		WithEntryIDs(entryID).
+		GetEntry()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return nil, nil, nil, false
+	}
+
+	if entry == nil {
+		response.HTMLNotFound(w, r)
+		return nil, nil, nil, false
+	}
+
+	archive, err := h.store.EntryArchive(userID, entryID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return nil, nil, nil, false
+	}
+
+	if archive == nil {
+		response.HTMLNotFound(w, r)
+		return nil, nil, nil, false
+	}
+
+	mediaList, err := h.store.EntryArchiveMedia(userID, entryID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return nil, nil, nil, false
+	}
+
+	return entry, archive, mediaList, true
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+
+	"miniflux.app/v2/internal/highlight"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/integration"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) createHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	var highlightCreationRequest model.HighlightCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&highlightCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if err := validator.ValidateHighlightCreation(&highlightCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).
+		WithEntryIDs(entryID).
+		GetEntry()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	newHighlight := &model.Highlight{
+		UserID:      userID,
+		EntryID:     entry.ID,
+		Text:        highlightCreationRequest.Text,
+		Prefix:      highlightCreationRequest.Prefix,
+		Suffix:      highlightCreationRequest.Suffix,
+		StartOffset: highlightCreationRequest.StartOffset,
+		Note:        highlightCreationRequest.Note,
+	}
+
+	if err := highlight.Anchor(entry.Content, newHighlight); err != nil {
+		if errors.Is(err, highlight.ErrTextNotFound) {
+			response.JSONBadRequest(w, r, err)
+			return
+		}
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateHighlight(newHighlight); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	userIntegrations, err := h.store.Integration(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	go integration.SendHighlight(entry, newHighlight, userIntegrations)
+
+	response.JSONCreated(w, r, newHighlight)
+}
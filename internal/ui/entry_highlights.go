@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"miniflux.app/v2/internal/highlight"
+	"miniflux.app/v2/internal/model"
+)
+
+// highlightEntryContent marks the highlighted passages in the entry content and returns the highlights of the entry.
+func (h *handler) highlightEntryContent(userID int64, entry *model.Entry) (model.Highlights, error) {
+	highlights, err := h.store.EntryHighlights(userID, entry.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Content = highlight.Render(entry.Content, highlights)
+	return highlights, nil
+}
@@ -57,8 +57,15 @@ func (h *handler) showReadEntryPage(w http.ResponseWriter, r *http.Request) {
 		prevEntryRoute = h.routePath("/history/entry/%d", prevEntry.ID)
 	}
 
+	highlights, err := h.highlightEntryContent(user.ID, entry)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	view := view.New(h.tpl, r)
 	view.Set("entry", entry)
+	view.Set("highlights", highlights)
 	view.Set("prevEntry", prevEntry)
 	view.Set("nextEntry", nextEntry)
 	view.Set("nextEntryRoute", nextEntryRoute)
@@ -68,6 +75,7 @@ func (h *handler) showReadEntryPage(w http.ResponseWriter, r *http.Request) {
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 	view.Set("hasSaveEntry", navMetadata.HasSaveEntry)
 
 	response.HTML(w, r, view.Render("entry"))
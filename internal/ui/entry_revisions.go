@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/v2/internal/diff"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+// entryChange describes the changes made by an update of the feed, compared to the previous version of the entry.
+type entryChange struct {
+	UpdatedAt time.Time
+	Title     diff.Segments
+	Content   diff.Segments
+}
+
+func (h *handler) showEntryRevisionsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	entryID := request.RouteInt64Param(r, "entryID")
+	entry, err := h.store.NewEntryQueryBuilder(user.ID).
+		WithEntryIDs(entryID).
+		GetEntry()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	revisions, err := h.store.EntryRevisions(user.ID, entryID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	// The revisions are sorted from the most recent, each one is compared to the version that replaced it.
+	newerTitle, newerContent := entry.Title, diff.HTMLText(entry.Content)
+	changes := make([]entryChange, 0, len(revisions))
+	for _, revision := range revisions {
+		content := diff.HTMLText(revision.Content)
+		changes = append(changes, entryChange{
+			UpdatedAt: revision.CreatedAt,
+			Title:     diff.Words(revision.Title, newerTitle),
+			Content:   diff.Words(content, newerContent),
+		})
+		newerTitle, newerContent = revision.Title, content
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("entry", entry)
+	view.Set("changes", changes)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+
+	response.HTML(w, r, view.Render("entry_revisions"))
+}
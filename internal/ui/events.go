@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/events"
+	"miniflux.app/v2/internal/http/request"
+)
+
+func (h *handler) streamEvents(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	events.Stream(w, r, userID, func() (*events.CountersData, error) {
+		navMetadata, err := h.store.GetNavCounters(userID)
+		if err != nil {
+			return nil, err
+		}
+		return &events.CountersData{Unread: navMetadata.CountUnread, ErrorFeeds: navMetadata.CountErrorFeeds}, nil
+	})
+}
@@ -38,6 +38,18 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	refreshHistory, err := h.store.FeedRefreshHistory(user.ID, feed.ID, config.Opts.FeedRefreshHistorySize())
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	feedURLChanges, err := h.store.FeedURLChanges(user.ID, feed.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	feedForm := form.FeedForm{
 		SiteURL:                     feed.SiteURL,
 		FeedURL:                     feed.FeedURL,
@@ -50,8 +62,10 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		KeeplistRules:               feed.KeeplistRules,
 		BlockFilterEntryRules:       feed.BlockFilterEntryRules,
 		KeepFilterEntryRules:        feed.KeepFilterEntryRules,
+		BlockFilterExpression:       feed.BlockFilterExpression,
 		Crawler:                     feed.Crawler,
 		IgnoreEntryUpdates:          feed.IgnoreEntryUpdates,
+		ArchiveEntries:              feed.ArchiveEntries,
 		UserAgent:                   feed.UserAgent,
 		Cookie:                      feed.Cookie,
 		CategoryID:                  feed.Category.ID,
@@ -73,17 +87,21 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		PushoverEnabled:             feed.PushoverEnabled,
 		PushoverPriority:            feed.PushoverPriority,
 		ProxyURL:                    feed.ProxyURL,
+		OverriddenSettings:          feed.OverriddenSettings,
 	}
 
 	view := view.New(h.tpl, r)
 	view.Set("form", feedForm)
 	view.Set("categories", categories)
 	view.Set("feed", feed)
+	view.Set("refreshHistory", refreshHistory)
+	view.Set("feedURLChanges", feedURLChanges)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
 	view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyURLConfigured())
 
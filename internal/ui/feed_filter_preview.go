@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+// previewFeedFilters renders the feed edit form with the filter decisions for the latest stored entries.
+// The submitted filters are evaluated without being saved.
+func (h *handler) previewFeedFilters(w http.ResponseWriter, r *http.Request) {
+	loggedUser, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	feedID := request.RouteInt64Param(r, "feedID")
+	feed, err := h.store.FeedByID(loggedUser.ID, feedID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	categories, err := h.store.Categories(loggedUser.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	refreshHistory, err := h.store.FeedRefreshHistory(loggedUser.ID, feed.ID, config.Opts.FeedRefreshHistorySize())
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	feedURLChanges, err := h.store.FeedURLChanges(loggedUser.ID, feed.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	feedForm := form.NewFeedForm(r)
+
+	view := view.New(h.tpl, r)
+	view.Set("form", feedForm)
+	view.Set("categories", categories)
+	view.Set("feed", feed)
+	view.Set("refreshHistory", refreshHistory)
+	view.Set("feedURLChanges", feedURLChanges)
+	view.Set("menu", "feeds")
+	view.Set("user", loggedUser)
+	navMetadata, _ := h.store.GetNavMetadata(loggedUser.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
+	view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyURLConfigured())
+
+	// Empty fields are kept as is: clearing a rule in the form must be reflected in the preview.
+	filterPreviewRequest := &model.FilterPreviewRequest{
+		BlocklistRules:        new(feedForm.BlocklistRules),
+		KeeplistRules:         new(feedForm.KeeplistRules),
+		BlockFilterEntryRules: new(feedForm.BlockFilterEntryRules),
+		KeepFilterEntryRules:  new(feedForm.KeepFilterEntryRules),
+		BlockFilterExpression: new(feedForm.BlockFilterExpression),
+	}
+
+	if validationErr := validator.ValidateFilterPreview(filterPreviewRequest); validationErr != nil {
+		view.Set("errorMessage", validationErr.Translate(loggedUser.Language))
+		response.HTML(w, r, view.Render("edit_feed"))
+		return
+	}
+
+	entries, err := h.store.NewEntryQueryBuilder(loggedUser.ID).
+		WithFeedID(feed.ID).
+		WithSorting("published_at", "desc").
+		WithLimit(model.DefaultFilterPreviewLimit).
+		GetEntries()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	filterPreviewRequest.Patch(feed)
+	view.Set("filterPreview", processor.PreviewFilters(loggedUser, feed, entries))
+
+	response.HTML(w, r, view.Render("edit_feed"))
+}
@@ -40,17 +40,32 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	refreshHistory, err := h.store.FeedRefreshHistory(loggedUser.ID, feed.ID, config.Opts.FeedRefreshHistorySize())
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	feedURLChanges, err := h.store.FeedURLChanges(loggedUser.ID, feed.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	feedForm := form.NewFeedForm(r)
 
 	view := view.New(h.tpl, r)
 	view.Set("form", feedForm)
 	view.Set("categories", categories)
 	view.Set("feed", feed)
+	view.Set("refreshHistory", refreshHistory)
+	view.Set("feedURLChanges", feedURLChanges)
 	view.Set("menu", "feeds")
 	view.Set("user", loggedUser)
 	navMetadata, _ := h.store.GetNavMetadata(loggedUser.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
 
 	feedModificationRequest := &model.FeedModificationRequest{
@@ -65,6 +80,8 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		ProxyURL:              model.OptionalString(feedForm.ProxyURL),
 		BlockFilterEntryRules: model.OptionalString(feedForm.BlockFilterEntryRules),
 		KeepFilterEntryRules:  model.OptionalString(feedForm.KeepFilterEntryRules),
+		BlockFilterExpression: model.OptionalString(feedForm.BlockFilterExpression),
+		OverriddenSettings:    &feedForm.OverriddenSettings,
 	}
 
 	if validationErr := validator.ValidateFeedModification(h.store, loggedUser.ID, feed.ID, feedModificationRequest); validationErr != nil {
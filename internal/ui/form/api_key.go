@@ -5,17 +5,53 @@ package form // import "miniflux.app/v2/internal/ui/form"
 
 import (
 	"net/http"
+	"slices"
 	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/timezone"
 )
 
 // APIKeyForm represents the API Key form.
 type APIKeyForm struct {
 	Description string
+	Scopes      []string
+	ExpiresAt   string
+}
+
+// HasScope returns true if the scope is selected.
+func (a APIKeyForm) HasScope(scope string) bool {
+	return slices.Contains(a.Scopes, scope)
+}
+
+// CreationRequest returns the API key creation request,
+// the key expires at the beginning of the given day in the user timezone.
+func (a APIKeyForm) CreationRequest(userTimezone string) (*model.APIKeyCreationRequest, *locale.LocalizedError) {
+	request := &model.APIKeyCreationRequest{
+		Description: a.Description,
+		Scopes:      a.Scopes,
+	}
+
+	if a.ExpiresAt != "" {
+		expiresAt, err := time.ParseInLocation("2006-01-02", a.ExpiresAt, timezone.Now(userTimezone).Location())
+		if err != nil {
+			return nil, locale.NewLocalizedError("error.api_key_invalid_expiration")
+		}
+		request.ExpiresAt = &expiresAt
+	}
+
+	return request, nil
 }
 
 // NewAPIKeyForm returns a new APIKeyForm.
 func NewAPIKeyForm(r *http.Request) *APIKeyForm {
+	r.ParseForm()
+
 	return &APIKeyForm{
 		Description: strings.TrimSpace(r.FormValue("description")),
+		Scopes:      r.Form["scopes"],
+		ExpiresAt:   strings.TrimSpace(r.FormValue("expires_at")),
 	}
 }
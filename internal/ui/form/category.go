@@ -5,18 +5,55 @@ package form // import "miniflux.app/v2/internal/ui/form"
 
 import (
 	"net/http"
+	"strconv"
+
+	"miniflux.app/v2/internal/model"
 )
 
 // CategoryForm represents a feed form in the UI
 type CategoryForm struct {
-	Title        string
-	HideGlobally bool
+	Title                 string
+	HideGlobally          bool
+	ParentID              int64
+	BlockFilterExpression string
+	FeedDefaults          model.FeedDefaults
 }
 
 // NewCategoryForm returns a new CategoryForm.
 func NewCategoryForm(r *http.Request) *CategoryForm {
+	parentID, err := strconv.ParseInt(r.FormValue("parent_id"), 10, 64)
+	if err != nil {
+		parentID = 0
+	}
+
 	return &CategoryForm{
-		Title:        r.FormValue("title"),
-		HideGlobally: r.FormValue("hide_globally") == "1",
+		Title:                 r.FormValue("title"),
+		HideGlobally:          r.FormValue("hide_globally") == "1",
+		ParentID:              parentID,
+		BlockFilterExpression: r.FormValue("block_filter_expression"),
+		FeedDefaults: model.FeedDefaults{
+			ScraperRules:                r.FormValue("scraper_rules"),
+			RewriteRules:                r.FormValue("rewrite_rules"),
+			UrlRewriteRules:             r.FormValue("urlrewrite_rules"),
+			BlocklistRules:              r.FormValue("blocklist_rules"),
+			KeeplistRules:               r.FormValue("keeplist_rules"),
+			BlockFilterEntryRules:       r.FormValue("block_filter_entry_rules"),
+			KeepFilterEntryRules:        r.FormValue("keep_filter_entry_rules"),
+			UserAgent:                   r.FormValue("user_agent"),
+			Cookie:                      r.FormValue("cookie"),
+			ProxyURL:                    r.FormValue("proxy_url"),
+			AppriseServiceURLs:          r.FormValue("apprise_service_urls"),
+			WebhookURL:                  r.FormValue("webhook_url"),
+			NtfyTopic:                   r.FormValue("ntfy_topic"),
+			Crawler:                     r.FormValue("crawler") == "1",
+			IgnoreEntryUpdates:          r.FormValue("ignore_entry_updates") == "1",
+			IgnoreHTTPCache:             r.FormValue("ignore_http_cache") == "1",
+			AllowSelfSignedCertificates: r.FormValue("allow_self_signed_certificates") == "1",
+			FetchViaProxy:               r.FormValue("fetch_via_proxy") == "1",
+			DisableHTTP2:                r.FormValue("disable_http2") == "1",
+			NoMediaPlayer:               r.FormValue("no_media_player") == "1",
+			NtfyEnabled:                 r.FormValue("ntfy_enabled") == "1",
+			PushoverEnabled:             r.FormValue("pushover_enabled") == "1",
+		},
 	}
 }
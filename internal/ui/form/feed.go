@@ -5,6 +5,7 @@ package form // import "miniflux.app/v2/internal/ui/form"
 
 import (
 	"net/http"
+	"slices"
 	"strconv"
 
 	"miniflux.app/v2/internal/model"
@@ -23,6 +24,7 @@ type FeedForm struct {
 	KeeplistRules               string
 	BlockFilterEntryRules       string
 	KeepFilterEntryRules        string
+	BlockFilterExpression       string
 	UserAgent                   string
 	Cookie                      string
 	CategoryID                  int64
@@ -44,11 +46,19 @@ type FeedForm struct {
 
 	Crawler            bool
 	IgnoreEntryUpdates bool
+	ArchiveEntries     bool
 
 	DisableHTTP2     bool
 	PushoverEnabled  bool
 	PushoverPriority int
 	ProxyURL         string
+
+	OverriddenSettings []string
+}
+
+// OverridesSetting returns true if the feed setting is used even when the category defines a default.
+func (f FeedForm) OverridesSetting(name string) bool {
+	return slices.Contains(f.OverriddenSettings, name)
 }
 
 // Merge updates the fields of the given feed.
@@ -65,8 +75,10 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.KeeplistRules = f.KeeplistRules
 	feed.BlockFilterEntryRules = f.BlockFilterEntryRules
 	feed.KeepFilterEntryRules = f.KeepFilterEntryRules
+	feed.BlockFilterExpression = f.BlockFilterExpression
 	feed.Crawler = f.Crawler
 	feed.IgnoreEntryUpdates = f.IgnoreEntryUpdates
+	feed.ArchiveEntries = f.ArchiveEntries
 	feed.UserAgent = f.UserAgent
 	feed.Cookie = f.Cookie
 	feed.ParsingErrorCount = 0
@@ -88,6 +100,7 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.PushoverEnabled = f.PushoverEnabled
 	feed.PushoverPriority = f.PushoverPriority
 	feed.ProxyURL = f.ProxyURL
+	feed.OverriddenSettings = f.OverriddenSettings
 	return feed
 }
 
@@ -122,8 +135,10 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		KeeplistRules:               r.FormValue("keeplist_rules"),
 		BlockFilterEntryRules:       r.FormValue("block_filter_entry_rules"),
 		KeepFilterEntryRules:        r.FormValue("keep_filter_entry_rules"),
+		BlockFilterExpression:       r.FormValue("block_filter_expression"),
 		Crawler:                     r.FormValue("crawler") == "1",
 		IgnoreEntryUpdates:          r.FormValue("ignore_entry_updates") == "1",
+		ArchiveEntries:              r.FormValue("archive_entries") == "1",
 		CategoryID:                  int64(categoryID),
 		Username:                    r.FormValue("feed_username"),
 		Password:                    r.FormValue("feed_password"),
@@ -142,5 +157,6 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		PushoverEnabled:             r.FormValue("pushover_enabled") == "1",
 		PushoverPriority:            pushoverPriority,
 		ProxyURL:                    r.FormValue("proxy_url"),
+		OverriddenSettings:          r.Form["overridden_settings"],
 	}
 }
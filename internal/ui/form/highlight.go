@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// HighlightForm represents the note form of a highlight in the UI.
+type HighlightForm struct {
+	Note string
+}
+
+// Merge updates the fields of the given highlight.
+func (f *HighlightForm) Merge(highlight *model.Highlight) *model.Highlight {
+	highlight.Note = f.Note
+	return highlight
+}
+
+// NewHighlightForm returns a new HighlightForm.
+func NewHighlightForm(r *http.Request) *HighlightForm {
+	return &HighlightForm{
+		Note: strings.TrimSpace(r.FormValue("note")),
+	}
+}
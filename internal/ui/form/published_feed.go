@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// PublishedFeedForm represents the form used to publish a feed.
+type PublishedFeedForm struct {
+	Kind       string
+	CategoryID int64
+	LabelID    int64
+	Tag        string
+	Title      string
+}
+
+// NewPublishedFeedForm returns a new PublishedFeedForm.
+func NewPublishedFeedForm(r *http.Request) *PublishedFeedForm {
+	categoryID, _ := strconv.ParseInt(r.FormValue("category_id"), 10, 64)
+	labelID, _ := strconv.ParseInt(r.FormValue("label_id"), 10, 64)
+
+	return &PublishedFeedForm{
+		Kind:       r.FormValue("kind"),
+		CategoryID: categoryID,
+		LabelID:    labelID,
+		Tag:        strings.TrimSpace(r.FormValue("tag")),
+		Title:      strings.TrimSpace(r.FormValue("title")),
+	}
+}
+
+// CreationRequest returns the request to publish the feed described by the form.
+func (p *PublishedFeedForm) CreationRequest() *model.PublishedFeedCreationRequest {
+	return &model.PublishedFeedCreationRequest{
+		Kind:       p.Kind,
+		CategoryID: p.CategoryID,
+		LabelID:    p.LabelID,
+		Tag:        p.Tag,
+		Title:      p.Title,
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// SavedSearchForm represents the form used to save the current search.
+type SavedSearchForm struct {
+	Title      string
+	Query      string
+	UnreadOnly bool
+	Starred    bool
+	CategoryID int64
+	FeedID     int64
+	MaxAgeDays int
+}
+
+// NewSavedSearchForm returns a new SavedSearchForm.
+func NewSavedSearchForm(r *http.Request) *SavedSearchForm {
+	categoryID, _ := strconv.ParseInt(r.FormValue("category_id"), 10, 64)
+	feedID, _ := strconv.ParseInt(r.FormValue("feed_id"), 10, 64)
+	maxAgeDays, _ := strconv.Atoi(r.FormValue("max_age_days"))
+
+	return &SavedSearchForm{
+		Title:      strings.TrimSpace(r.FormValue("title")),
+		Query:      r.FormValue("q"),
+		UnreadOnly: r.FormValue("unread") == "1",
+		Starred:    r.FormValue("starred") == "1",
+		CategoryID: categoryID,
+		FeedID:     feedID,
+		MaxAgeDays: maxAgeDays,
+	}
+}
+
+// CreationRequest returns the request to create the saved search described by the form.
+func (s *SavedSearchForm) CreationRequest() *model.SavedSearchCreationRequest {
+	request := &model.SavedSearchCreationRequest{
+		Title:      s.Title,
+		Query:      s.Query,
+		Starred:    s.Starred,
+		CategoryID: s.CategoryID,
+		FeedID:     s.FeedID,
+		MaxAgeDays: s.MaxAgeDays,
+	}
+
+	if s.UnreadOnly {
+		request.Status = model.EntryStatusUnread
+	}
+
+	return request
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestSavedSearchFormCreationRequest(t *testing.T) {
+	values := url.Values{
+		"title":        {" Golang "},
+		"q":            {"golang"},
+		"unread":       {"1"},
+		"starred":      {"1"},
+		"category_id":  {"2"},
+		"feed_id":      {"3"},
+		"max_age_days": {"7"},
+	}
+	r, _ := http.NewRequest(http.MethodPost, "/saved-search/save", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	request := NewSavedSearchForm(r).CreationRequest()
+	expected := model.SavedSearchCreationRequest{
+		Title:      "Golang",
+		Query:      "golang",
+		Status:     model.EntryStatusUnread,
+		Starred:    true,
+		CategoryID: 2,
+		FeedID:     3,
+		MaxAgeDays: 7,
+	}
+	if *request != expected {
+		t.Errorf("Unexpected creation request: %+v", request)
+	}
+}
+
+func TestSavedSearchFormCreationRequestWithoutCriteria(t *testing.T) {
+	r, _ := http.NewRequest(http.MethodPost, "/saved-search/save", strings.NewReader("title=Golang&q=golang"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	request := NewSavedSearchForm(r).CreationRequest()
+	if request.Status != "" || request.Starred || request.CategoryID != 0 || request.FeedID != 0 || request.MaxAgeDays != 0 {
+		t.Errorf("Unexpected criteria: %+v", request)
+	}
+}
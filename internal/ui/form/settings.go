@@ -48,8 +48,10 @@ type SettingsForm struct {
 	MediaPlaybackRate         float64
 	BlockFilterEntryRules     string
 	KeepFilterEntryRules      string
+	BlockFilterExpression     string
 	AlwaysOpenExternalLinks   bool
 	OpenExternalLinksInNewTab bool
+	RemoveReadLaterOnRead     bool
 	KeyboardShortcuts         bool
 	EntrySwipe                bool
 	MarkReadOnView            bool
@@ -116,8 +118,10 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.MediaPlaybackRate = s.MediaPlaybackRate
 	user.BlockFilterEntryRules = s.BlockFilterEntryRules
 	user.KeepFilterEntryRules = s.KeepFilterEntryRules
+	user.BlockFilterExpression = s.BlockFilterExpression
 	user.AlwaysOpenExternalLinks = s.AlwaysOpenExternalLinks
 	user.OpenExternalLinksInNewTab = s.OpenExternalLinksInNewTab
+	user.RemoveReadLaterOnRead = s.RemoveReadLaterOnRead
 
 	MarkReadOnView, MarkReadOnMediaPlayerCompletion := extractMarkAsReadBehavior(s.MarkReadBehavior)
 	user.MarkReadOnView = MarkReadOnView
@@ -209,7 +213,9 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 		MediaPlaybackRate:         mediaPlaybackRate,
 		BlockFilterEntryRules:     r.FormValue("block_filter_entry_rules"),
 		KeepFilterEntryRules:      r.FormValue("keep_filter_entry_rules"),
+		BlockFilterExpression:     r.FormValue("block_filter_expression"),
 		AlwaysOpenExternalLinks:   r.FormValue("always_open_external_links") == "1",
 		OpenExternalLinksInNewTab: r.FormValue("open_external_links_in_new_tab") == "1",
+		RemoveReadLaterOnRead:     r.FormValue("remove_read_later_on_read") == "1",
 	}
 }
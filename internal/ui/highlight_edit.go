@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showEditHighlightPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	highlight, err := h.store.HighlightByID(user.ID, request.RouteInt64Param(r, "highlightID"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	entry, err := h.store.NewEntryQueryBuilder(user.ID).
+		WithEntryIDs(highlight.EntryID).
+		WithoutContent().
+		GetEntry()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("form", form.HighlightForm{Note: highlight.Note})
+	view.Set("highlight", highlight)
+	view.Set("entry", entry)
+	view.Set("menu", "highlights")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+
+	response.HTML(w, r, view.Render("edit_highlight"))
+}
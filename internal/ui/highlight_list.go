@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showHighlightsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	searchQuery := strings.TrimSpace(request.QueryStringParam(r, "q", ""))
+	offset := request.QueryIntParam(r, "offset", 0)
+
+	highlights, err := h.store.Highlights(user.ID, searchQuery, user.EntriesPerPage, offset)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	count, err := h.store.CountHighlights(user.ID, searchQuery)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	pagination := getPagination(h.routePath("/highlights"), count, offset, user.EntriesPerPage)
+	pagination.SearchQuery = searchQuery
+
+	view := view.New(h.tpl, r)
+	view.Set("highlights", highlights)
+	view.Set("total", count)
+	view.Set("searchQuery", searchQuery)
+	view.Set("pagination", pagination)
+	view.Set("menu", "highlights")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+
+	response.HTML(w, r, view.Render("highlights"))
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/integration"
+	"miniflux.app/v2/internal/ui/form"
+)
+
+func (h *handler) updateHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	highlight, err := h.store.HighlightByID(userID, request.RouteInt64Param(r, "highlightID"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	highlightForm := form.NewHighlightForm(r)
+	if err := h.store.UpdateHighlight(highlightForm.Merge(highlight)); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).
+		WithEntryIDs(highlight.EntryID).
+		WithoutContent().
+		GetEntry()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	userIntegrations, err := h.store.Integration(userID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if entry != nil {
+		go integration.SendHighlight(entry, highlight, userIntegrations)
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/highlights"))
+}
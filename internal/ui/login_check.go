@@ -8,10 +8,13 @@ import (
 	"log/slog"
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ratelimit"
 	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/view"
 	"miniflux.app/v2/internal/urllib"
@@ -49,6 +52,20 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	rateLimitKeys := ratelimit.LoginKeys(clientIP, authForm.Username)
+	if lockedUntil := ratelimit.LockedUntil(h.store, ratelimit.SourceWeb, rateLimitKeys...); lockedUntil != nil {
+		slog.Warn("Login blocked after too many failed attempts",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.String("username", authForm.Username),
+			slog.Time("locked_until", *lockedUntil),
+		)
+		view.Set("errorMessage", locale.NewLocalizedError("error.too_many_login_attempts").Translate(request.WebSession(r).Language()))
+		response.HTML(w, r, view.Render("login"))
+		return
+	}
+
 	if err := h.store.CheckPassword(authForm.Username, authForm.Password); err != nil {
 		slog.Warn("Incorrect username or password",
 			slog.Bool("authentication_failed", true),
@@ -57,6 +74,8 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 			slog.String("username", authForm.Username),
 			slog.Any("error", err),
 		)
+		audit.Record(h.store, r, nil, model.AuditLogActionLoginFailed, "user:"+authForm.Username)
+		ratelimit.RecordFailure(h.store, ratelimit.SourceWeb, rateLimitKeys...)
 		response.HTML(w, r, view.Render("login"))
 		return
 	}
@@ -71,6 +90,25 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	twoFactorEnabled, err := h.store.HasTwoFactorAuthentication(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if twoFactorEnabled {
+		slog.Info("User entered a valid password, waiting for the two-factor authentication code",
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Int64("user_id", user.ID),
+			slog.String("username", authForm.Username),
+		)
+
+		request.WebSession(r).StartTOTPLogin(user.ID, redirectURL)
+		response.HTMLRedirect(w, r, h.routePath("/login/totp"))
+		return
+	}
+
 	slog.Info("User authenticated successfully with username/password",
 		slog.Bool("authentication_successful", true),
 		slog.String("client_ip", clientIP),
@@ -78,6 +116,8 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 		slog.Int64("user_id", user.ID),
 		slog.String("username", authForm.Username),
 	)
+	audit.Record(h.store, r, user, model.AuditLogActionLogin, "password")
+	ratelimit.RecordSuccess(h.store, ratelimit.SourceWeb, ratelimit.UserKey(user.Username))
 
 	h.store.SetLastLogin(user.ID)
 	if err := authenticateWebSession(w, r, h.store, user); err != nil {
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/audit"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ratelimit"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showLoginLockoutsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		response.HTMLForbidden(w, r)
+		return
+	}
+
+	lockouts, err := h.store.LockedLogins()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("lockouts", lockouts)
+	view.Set("rateLimitEnabled", ratelimit.IsEnabled())
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+
+	response.HTML(w, r, view.Render("login_lockouts"))
+}
+
+func (h *handler) unlockLogin(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		response.HTMLForbidden(w, r)
+		return
+	}
+
+	key := r.FormValue("key")
+	if key == "" {
+		response.HTMLRedirect(w, r, h.routePath("/login-lockouts"))
+		return
+	}
+
+	if err := h.store.ResetLoginAttempts(key); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	audit.Record(h.store, r, user, model.AuditLogActionLoginUnlocked, key)
+
+	sess := request.WebSession(r)
+	sess.SetSuccessMessage(locale.NewPrinter(sess.Language()).Printf("alert.login_unlocked", key))
+	response.HTMLRedirect(w, r, h.routePath("/login-lockouts"))
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"log/slog"
+	"net/http"
+
+	"miniflux.app/v2/internal/audit"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ratelimit"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/urllib"
+)
+
+// maxTOTPLoginAttempts is the number of invalid codes accepted before the user has to enter the password again.
+const maxTOTPLoginAttempts = 5
+
+func (h *handler) showTOTPLoginPage(w http.ResponseWriter, r *http.Request) {
+	if request.WebSession(r).PendingTOTPLogin() == nil {
+		response.HTMLRedirect(w, r, h.routePath("/"))
+		return
+	}
+
+	view := view.New(h.tpl, r)
+	response.HTML(w, r, view.Render("login_totp"))
+}
+
+func (h *handler) checkTOTPLogin(w http.ResponseWriter, r *http.Request) {
+	clientIP := request.ClientIP(r)
+	session := request.WebSession(r)
+
+	pendingLogin := session.PendingTOTPLogin()
+	if pendingLogin == nil {
+		session.ClearTOTPLogin()
+		response.HTMLRedirect(w, r, h.routePath("/"))
+		return
+	}
+
+	user, err := h.store.UserByID(pendingLogin.UserID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		session.ClearTOTPLogin()
+		response.HTMLRedirect(w, r, h.routePath("/"))
+		return
+	}
+
+	rateLimitKeys := ratelimit.LoginKeys(clientIP, user.Username)
+	if lockedUntil := ratelimit.LockedUntil(h.store, ratelimit.SourceWeb, rateLimitKeys...); lockedUntil != nil {
+		slog.Warn("Two-factor authentication blocked after too many failed attempts",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Int64("user_id", user.ID),
+			slog.Time("locked_until", *lockedUntil),
+		)
+		session.ClearTOTPLogin()
+		session.SetErrorMessage(locale.NewLocalizedError("error.too_many_login_attempts").Translate(session.Language()))
+		response.HTMLRedirect(w, r, h.routePath("/"))
+		return
+	}
+
+	valid, err := verifyTwoFactorCode(h.store, user.ID, r.FormValue("code"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if !valid {
+		slog.Warn("Invalid two-factor authentication code",
+			slog.Bool("authentication_failed", true),
+			slog.String("client_ip", clientIP),
+			slog.String("user_agent", r.UserAgent()),
+			slog.Int64("user_id", user.ID),
+		)
+		audit.Record(h.store, r, user, model.AuditLogActionLoginFailed, "password+totp")
+		ratelimit.RecordFailure(h.store, ratelimit.SourceWeb, rateLimitKeys...)
+
+		errorMessage := locale.NewLocalizedError("error.invalid_two_factor_code").Translate(session.Language())
+		if session.RecordTOTPLoginFailure() >= maxTOTPLoginAttempts {
+			session.ClearTOTPLogin()
+			session.SetErrorMessage(errorMessage)
+			response.HTMLRedirect(w, r, h.routePath("/"))
+			return
+		}
+
+		view := view.New(h.tpl, r)
+		view.Set("errorMessage", errorMessage)
+		response.HTML(w, r, view.Render("login_totp"))
+		return
+	}
+
+	slog.Info("User authenticated successfully with username/password and two-factor authentication code",
+		slog.Bool("authentication_successful", true),
+		slog.String("client_ip", clientIP),
+		slog.String("user_agent", r.UserAgent()),
+		slog.Int64("user_id", user.ID),
+		slog.String("username", user.Username),
+	)
+	audit.Record(h.store, r, user, model.AuditLogActionLogin, "password+totp")
+	ratelimit.RecordSuccess(h.store, ratelimit.SourceWeb, ratelimit.UserKey(user.Username))
+
+	session.ClearTOTPLogin()
+	h.store.SetLastLogin(user.ID)
+	if err := authenticateWebSession(w, r, h.store, user); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if pendingLogin.RedirectURL != "" && urllib.IsRelativePath(pendingLogin.RedirectURL) {
+		response.HTMLRedirect(w, r, pendingLogin.RedirectURL)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.basePath+"/"+user.DefaultHomePage)
+}
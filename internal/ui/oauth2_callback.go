@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
@@ -105,6 +106,8 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		audit.Record(h.store, r, loggedUser, model.AuditLogActionOAuth2Linked, "oauth2:"+provider)
+
 		sess.SetSuccessMessage(printer.Print("alert.account_linked"))
 		response.HTMLRedirect(w, r, h.routePath("/settings"))
 		return
@@ -135,6 +138,8 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 			response.HTMLServerError(w, r, err)
 			return
 		}
+
+		audit.Record(h.store, r, user, model.AuditLogActionUserCreated, audit.UserTarget(user))
 	}
 
 	slog.Info("User authenticated successfully using OAuth2",
@@ -144,6 +149,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 		slog.Int64("user_id", user.ID),
 		slog.String("username", user.Username),
 	)
+	audit.Record(h.store, r, user, model.AuditLogActionLogin, "oauth2:"+provider)
 
 	h.store.SetLastLogin(user.ID)
 	if err := authenticateWebSession(w, r, h.store, user); err != nil {
@@ -7,10 +7,12 @@ import (
 	"log/slog"
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
 )
 
 func (h *handler) oauth2Unlink(w http.ResponseWriter, r *http.Request) {
@@ -65,6 +67,8 @@ func (h *handler) oauth2Unlink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	audit.Record(h.store, r, user, model.AuditLogActionOAuth2Unlinked, "oauth2:"+provider)
+
 	sess.SetSuccessMessage(printer.Print("alert.account_unlinked"))
 	response.HTMLRedirect(w, r, h.routePath("/settings"))
 }
@@ -4,10 +4,12 @@
 package ui // import "miniflux.app/v2/internal/ui"
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
 	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
@@ -19,6 +21,8 @@ import (
 	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/model"
 
 	"miniflux.app/v2/internal/reader/fetcher"
 	"miniflux.app/v2/internal/reader/rewrite"
@@ -81,6 +85,38 @@ func (h *handler) mediaProxy(w http.ResponseWriter, r *http.Request) {
 	}
 
 	mediaURL := string(decodedURL)
+	etag := crypto.HashFromBytes(decodedURL)
+
+	filename := path.Base(parsedMediaURL.Path)
+	if filename == "." || filename == "/" {
+		filename = ""
+	}
+
+	cache := mediaproxy.CacheInstance
+	cachedMedia := cache.Lookup(mediaURL)
+	if cachedMedia != nil && !cachedMedia.Expired() {
+		serveCachedMedia(w, r, cachedMedia, etag, filename)
+		return
+	}
+
+	// The images of the archived entries are served even when the origin is no longer available.
+	if config.Opts.OfflineArchive() {
+		archivedMedia, err := h.store.ArchivedMediaByURL(mediaURL)
+		if err != nil {
+			slog.Warn("MediaProxy: Unable to fetch archived media",
+				slog.String("media_url", mediaURL),
+				slog.Any("error", err),
+			)
+		} else if archivedMedia != nil {
+			serveArchivedMedia(w, r, archivedMedia, etag, filename)
+			return
+		}
+	}
+
+	// Only complete responses are stored in the cache: a partial request is forwarded to the origin,
+	// unless a cached copy has to be revalidated.
+	partialRequest := r.Header.Get("Range") != "" && r.Header.Get("Range") != "bytes=0-"
+	useCache := cache != nil && (cachedMedia != nil || !partialRequest)
 
 	slog.Debug("MediaProxy: Fetching remote resource",
 		slog.String("media_url", mediaURL),
@@ -96,11 +132,19 @@ func (h *handler) mediaProxy(w http.ResponseWriter, r *http.Request) {
 
 	forwardedRequestHeader := [...]string{"Range", "Accept", "Accept-Encoding", "User-Agent"}
 	for _, requestHeaderName := range forwardedRequestHeader {
+		if useCache && (requestHeaderName == "Range" || requestHeaderName == "Accept-Encoding") {
+			continue
+		}
+
 		if r.Header.Get(requestHeaderName) != "" {
 			requestBuilder = requestBuilder.WithHeader(requestHeaderName, r.Header.Get(requestHeaderName))
 		}
 	}
 
+	if cachedMedia != nil {
+		requestBuilder = requestBuilder.WithETag(cachedMedia.ETag).WithLastModified(cachedMedia.LastModified)
+	}
+
 	resp, err := requestBuilder.ExecuteRequest(mediaURL)
 	if err != nil {
 		if errors.Is(err, fetcher.ErrPrivateNetworkHost) || errors.Is(err, fetcher.ErrHostnameResolution) {
@@ -112,6 +156,15 @@ func (h *handler) mediaProxy(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if cachedMedia != nil {
+			slog.Warn("MediaProxy: Unable to revalidate cached media, serving stale copy",
+				slog.String("media_url", mediaURL),
+				slog.Any("error", err),
+			)
+			serveCachedMedia(w, r, cachedMedia, etag, filename)
+			return
+		}
+
 		slog.Error("MediaProxy: Unable to initialize HTTP client",
 			slog.String("media_url", mediaURL),
 			slog.Any("error", err),
@@ -121,6 +174,24 @@ func (h *handler) mediaProxy(w http.ResponseWriter, r *http.Request) {
 	}
 	defer resp.Body.Close()
 
+	if cachedMedia != nil {
+		switch resp.StatusCode {
+		case http.StatusOK:
+		case http.StatusNotModified:
+			cache.Refresh(mediaURL, resp.Header)
+			serveCachedMedia(w, r, cachedMedia, etag, filename)
+			return
+		default:
+			// The cached copy survives the removal of the media from the origin.
+			slog.Warn("MediaProxy: Unexpected response status code, serving stale copy",
+				slog.String("media_url", mediaURL),
+				slog.Int("status_code", resp.StatusCode),
+			)
+			serveCachedMedia(w, r, cachedMedia, etag, filename)
+			return
+		}
+	}
+
 	if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
 		slog.Warn("MediaProxy: "+http.StatusText(http.StatusRequestedRangeNotSatisfiable),
 			slog.String("media_url", mediaURL),
@@ -140,14 +211,45 @@ func (h *handler) mediaProxy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	etag := crypto.HashFromBytes(decodedURL)
+	var body io.Reader = resp.Body
+	var cacheWriter *mediaproxy.CacheWriter
+	lifetime, storable := mediaproxy.CacheLifetime(resp.Header)
+	storable = storable && useCache &&
+		resp.StatusCode == http.StatusOK &&
+		resp.Header.Get("Content-Encoding") == "" &&
+		resp.ContentLength <= config.Opts.MediaProxyCacheMaxSize()
+
+	if storable && partialRequest {
+		// The requested range is served from the new copy.
+		if newMedia, err := cache.Store(mediaURL, resp, lifetime); err == nil {
+			serveCachedMedia(w, r, newMedia, etag, filename)
+		} else {
+			slog.Warn("MediaProxy: Unable to store media, serving stale copy",
+				slog.String("media_url", mediaURL),
+				slog.Any("error", err),
+			)
+			serveCachedMedia(w, r, cachedMedia, etag, filename)
+		}
+		return
+	}
+
+	if storable {
+		if cacheWriter, err = cache.NewWriter(); err == nil {
+			body = io.TeeReader(resp.Body, cacheWriter)
+		} else {
+			slog.Warn("MediaProxy: Unable to store media",
+				slog.String("media_url", mediaURL),
+				slog.Any("error", err),
+			)
+		}
+	}
 
 	response.NewBuilder(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
 		b.WithStatus(resp.StatusCode)
 		b.WithHeader("Content-Security-Policy", response.ContentSecurityPolicyForUntrustedContent)
 		b.WithHeader("Content-Type", resp.Header.Get("Content-Type"))
 
-		if filename := path.Base(parsedMediaURL.Path); filename != "" && filename != "." && filename != "/" {
+		if filename != "" {
 			b.WithInline(filename)
 		}
 
@@ -157,7 +259,59 @@ func (h *handler) mediaProxy(w http.ResponseWriter, r *http.Request) {
 				b.WithHeader(responseHeaderName, resp.Header.Get(responseHeaderName))
 			}
 		}
-		b.WithBodyAsReader(resp.Body)
+		b.WithBodyAsReader(body)
+		b.WithoutCompression()
+		b.Write()
+	})
+
+	if cacheWriter != nil {
+		if _, err := cache.CommitResponse(cacheWriter, mediaURL, resp, lifetime); err != nil {
+			slog.Debug("MediaProxy: Media not stored in the cache",
+				slog.String("media_url", mediaURL),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// serveCachedMedia sends a media stored in the cache, Range requests are handled from the cached copy.
+func serveCachedMedia(w http.ResponseWriter, r *http.Request, media *mediaproxy.CachedMedia, etag, filename string) {
+	file, err := mediaproxy.CacheInstance.Open(media)
+	if err != nil {
+		slog.Error("MediaProxy: Unable to open cached media",
+			slog.String("media_url", media.URL),
+			slog.Any("error", err),
+		)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer file.Close()
+
+	response.NewBuilder(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
+		b.WithHeader("Content-Security-Policy", response.ContentSecurityPolicyForUntrustedContent)
+		b.WithHeader("Content-Type", media.ContentType)
+
+		if filename != "" {
+			b.WithInline(filename)
+		}
+
+		b.WithBodyAsReadSeeker(file, media.StoredAt)
+		b.WithoutCompression()
+		b.Write()
+	})
+}
+
+// serveArchivedMedia sends an image stored with an entry archive.
+func serveArchivedMedia(w http.ResponseWriter, r *http.Request, media *model.ArchivedMedia, etag, filename string) {
+	response.NewBuilder(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
+		b.WithHeader("Content-Security-Policy", response.ContentSecurityPolicyForUntrustedContent)
+		b.WithHeader("Content-Type", media.MimeType)
+
+		if filename != "" {
+			b.WithInline(filename)
+		}
+
+		b.WithBodyAsReadSeeker(bytes.NewReader(media.Content), time.Time{})
 		b.WithoutCompression()
 		b.Write()
 	})
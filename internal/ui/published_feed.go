@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/syndication"
+)
+
+// publishedFeedMaxEntries is the number of most recent entries written in a published feed.
+const publishedFeedMaxEntries = 100
+
+// showPublishedFeed writes the entries of a published feed. The route is public, the token is the only credential.
+func (h *handler) showPublishedFeed(w http.ResponseWriter, r *http.Request) {
+	var contentType string
+	var write func(io.Writer, *syndication.Feed) error
+
+	format := request.RouteStringParam(r, "format")
+	switch format {
+	case "atom.xml":
+		contentType, write = syndication.AtomMimeType, syndication.WriteAtom
+	case "rss.xml":
+		contentType, write = syndication.RSSMimeType, syndication.WriteRSS
+	case "feed.json":
+		contentType, write = syndication.JSONFeedMimeType, syndication.WriteJSONFeed
+	default:
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	token := request.RouteStringParam(r, "token")
+	publishedFeed, err := h.store.PublishedFeedByToken(token)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if publishedFeed == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	user, err := h.store.UserByID(publishedFeed.UserID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	title, err := h.publishedFeedTitle(user, publishedFeed)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(user.ID).
+		WithEnclosures().
+		WithSorting("published_at", "DESC").
+		WithSorting("id", "DESC").
+		WithLimit(publishedFeedMaxEntries)
+
+	switch publishedFeed.Kind {
+	case model.PublishedFeedKindStarred:
+		builder.WithStarred(true)
+	case model.PublishedFeedKindShared:
+		builder.WithShareCodeNotEmpty()
+	case model.PublishedFeedKindCategory:
+		builder.WithCategoryID(publishedFeed.CategoryID)
+	case model.PublishedFeedKindLabel:
+		builder.WithLabelID(publishedFeed.LabelID)
+	case model.PublishedFeedKindTag:
+		builder.WithTags(publishedFeed.Tag)
+	}
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	for _, entry := range entries {
+		entry.Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(entry.Content)
+	}
+
+	var buffer bytes.Buffer
+	if err := write(&buffer, &syndication.Feed{
+		Title:   title,
+		SiteURL: config.Opts.BaseURL() + "/",
+		FeedURL: config.Opts.BaseURL() + "/publish/" + token + "/" + format,
+		Entries: entries,
+	}); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	b := response.NewBuilder(w, r)
+	b.WithHeader("Content-Type", contentType)
+	b.WithHeader("X-Robots-Tag", "noindex")
+	b.WithBodyAsBytes(buffer.Bytes())
+	b.Write()
+}
+
+// publishedFeedTitle returns the title given by the user or a title describing the published entries.
+func (h *handler) publishedFeedTitle(user *model.User, publishedFeed *model.PublishedFeed) (string, error) {
+	if publishedFeed.Title != "" {
+		return publishedFeed.Title, nil
+	}
+
+	printer := locale.NewPrinter(user.Language)
+	switch publishedFeed.Kind {
+	case model.PublishedFeedKindStarred:
+		return printer.Print("page.starred.title"), nil
+	case model.PublishedFeedKindShared:
+		return printer.Print("page.shared_entries.title"), nil
+	case model.PublishedFeedKindCategory:
+		category, err := h.store.Category(user.ID, publishedFeed.CategoryID)
+		if err != nil || category == nil {
+			return "", err
+		}
+		return category.Title, nil
+	case model.PublishedFeedKindLabel:
+		label, err := h.store.Label(user.ID, publishedFeed.LabelID)
+		if err != nil || label == nil {
+			return "", err
+		}
+		return label.Title, nil
+	}
+
+	return "#" + publishedFeed.Tag, nil
+}
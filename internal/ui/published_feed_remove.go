@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+)
+
+func (h *handler) removePublishedFeed(w http.ResponseWriter, r *http.Request) {
+	publishedFeedID := request.RouteInt64Param(r, "publishedFeedID")
+	if err := h.store.RemovePublishedFeed(request.UserID(r), publishedFeedID); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/published-feeds"))
+}
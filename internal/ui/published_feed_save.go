@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) savePublishedFeed(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	publishedFeedForm := form.NewPublishedFeedForm(r)
+	publishedFeedCreationRequest := publishedFeedForm.CreationRequest()
+
+	if validationErr := validator.ValidatePublishedFeedCreation(h.store, user.ID, publishedFeedCreationRequest); validationErr != nil {
+		h.showPublishedFeedForm(w, r, user, publishedFeedForm, validationErr.Translate(user.Language))
+		return
+	}
+
+	if _, err = h.store.CreatePublishedFeed(user.ID, publishedFeedCreationRequest); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/published-feeds"))
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/storage"
+)
+
+func (h *handler) moveReadLaterEntry(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	position := request.FormInt64Value(r, "position")
+
+	err := h.store.MoveReadLaterEntry(request.UserID(r), entryID, int(position))
+	switch {
+	case errors.Is(err, storage.ErrReadLaterEntryNotFound):
+		response.HTMLNotFound(w, r)
+		return
+	case err != nil:
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	redirectURL := h.routePath("/read-later")
+	if offset := request.FormInt64Value(r, "offset"); offset > 0 {
+		redirectURL = h.routePath("/read-later?offset=%d", offset)
+	}
+
+	response.HTMLRedirect(w, r, redirectURL)
+}
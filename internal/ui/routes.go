@@ -36,7 +36,7 @@ func isPublicRoute(r *http.Request) bool {
 	path := r.URL.Path
 
 	switch path {
-	case "/", "/login", "/manifest.json",
+	case "/", "/login", "/login/totp", "/manifest.json",
 		"/healthcheck", "/offline",
 		"/webauthn/login/begin", "/webauthn/login/finish":
 		return true
@@ -44,6 +44,7 @@ func isPublicRoute(r *http.Request) bool {
 
 	return strings.HasPrefix(path, "/oauth2/") && (strings.HasSuffix(path, "/redirect") || strings.HasSuffix(path, "/callback")) ||
 		strings.HasPrefix(path, "/share/") ||
+		strings.HasPrefix(path, "/publish/") ||
 		strings.HasPrefix(path, "/proxy/")
 }
 
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) saveSavedSearch(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	savedSearchForm := form.NewSavedSearchForm(r)
+
+	savedSearchCreationRequest := savedSearchForm.CreationRequest()
+
+	if validationErr := validator.ValidateSavedSearchCreation(h.store, user.ID, savedSearchCreationRequest); validationErr != nil {
+		categories, err := h.store.Categories(user.ID)
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		feeds, err := h.store.Feeds(user.ID)
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		view := view.New(h.tpl, r)
+		view.Set("errorMessage", validationErr.Translate(user.Language))
+		view.Set("searchQuery", savedSearchForm.Query)
+		view.Set("searchUnreadOnly", savedSearchForm.UnreadOnly)
+		view.Set("form", savedSearchForm)
+		view.Set("categories", categories)
+		view.Set("feeds", feeds)
+		view.Set("total", 0)
+		view.Set("pagination", getPagination(h.routePath("/search"), 0, 0, user.EntriesPerPage))
+		view.Set("menu", "search")
+		view.Set("user", user)
+		navMetadata, _ := h.store.GetNavMetadata(user.ID)
+		view.Set("countUnread", navMetadata.CountUnread)
+		view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+		view.Set("savedSearches", navMetadata.SavedSearches)
+		view.Set("hasSaveEntry", navMetadata.HasSaveEntry)
+
+		response.HTML(w, r, view.Render("search"))
+		return
+	}
+
+	savedSearch, err := h.store.CreateSavedSearch(user.ID, savedSearchCreationRequest)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/saved-search/%d/entries", savedSearch.ID))
+}
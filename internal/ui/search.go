@@ -9,7 +9,9 @@ import (
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/form"
 	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
 )
 
 func (h *handler) showSearchPage(w http.ResponseWriter, r *http.Request) {
@@ -26,7 +28,9 @@ func (h *handler) showSearchPage(w http.ResponseWriter, r *http.Request) {
 	var entries model.Entries
 	var entriesCount int
 
-	if searchQuery != "" {
+	validationErr := validator.ValidateSearchQuery(searchQuery)
+
+	if searchQuery != "" && validationErr == nil {
 		builder := h.store.NewEntryQueryBuilder(user.ID).
 			WithSearchQuery(searchQuery).
 			WithoutContent().
@@ -44,13 +48,33 @@ func (h *handler) showSearchPage(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	var categories model.Categories
+	var feeds model.Feeds
+	if searchQuery != "" {
+		if categories, err = h.store.Categories(user.ID); err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		if feeds, err = h.store.Feeds(user.ID); err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+	}
+
 	view := view.New(h.tpl, r)
 	pagination := getPagination(h.routePath("/search"), entriesCount, offset, user.EntriesPerPage)
 	pagination.SearchQuery = searchQuery
 	pagination.UnreadOnly = unreadOnly
 
+	if validationErr != nil {
+		view.Set("errorMessage", validationErr.Translate(user.Language))
+	}
 	view.Set("searchQuery", searchQuery)
 	view.Set("searchUnreadOnly", unreadOnly)
+	view.Set("form", &form.SavedSearchForm{Title: searchQuery, Query: searchQuery, UnreadOnly: unreadOnly})
+	view.Set("categories", categories)
+	view.Set("feeds", feeds)
 	view.Set("entries", entries)
 	view.Set("total", entriesCount)
 	view.Set("pagination", pagination)
@@ -59,6 +83,7 @@ func (h *handler) showSearchPage(w http.ResponseWriter, r *http.Request) {
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 	view.Set("hasSaveEntry", navMetadata.HasSaveEntry)
 
 	response.HTML(w, r, view.Render("search"))
@@ -6,17 +6,26 @@ package ui // import "miniflux.app/v2/internal/ui"
 import (
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
 )
 
 func (h *handler) removeSession(w http.ResponseWriter, r *http.Request) {
-	sessionID := request.RouteStringParam(r, "sessionID")
-	err := h.store.RemoveUserWebSession(request.UserID(r), sessionID)
+	user, err := h.store.UserByID(request.UserID(r))
 	if err != nil {
 		response.HTMLServerError(w, r, err)
 		return
 	}
 
+	sessionID := request.RouteStringParam(r, "sessionID")
+	if err := h.store.RemoveUserWebSession(user.ID, sessionID); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	audit.Record(h.store, r, user, model.AuditLogActionSessionRemoved, "session:"+sessionID)
+
 	response.HTMLRedirect(w, r, h.routePath("/sessions"))
 }
@@ -46,8 +46,10 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		MediaPlaybackRate:         user.MediaPlaybackRate,
 		BlockFilterEntryRules:     user.BlockFilterEntryRules,
 		KeepFilterEntryRules:      user.KeepFilterEntryRules,
+		BlockFilterExpression:     user.BlockFilterExpression,
 		AlwaysOpenExternalLinks:   user.AlwaysOpenExternalLinks,
 		OpenExternalLinksInNewTab: user.OpenExternalLinksInNewTab,
+		RemoveReadLaterOnRead:     user.RemoveReadLaterOnRead,
 	}
 
 	creds, err := h.store.WebAuthnCredentialsByUserID(user.ID)
@@ -56,6 +58,12 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	twoFactorEnabled, err := h.store.HasTwoFactorAuthentication(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	view := view.New(h.tpl, r)
 	view.Set("form", settingsForm)
 	view.Set("readBehaviors", map[string]any{
@@ -72,11 +80,13 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 	view.Set("default_home_pages", model.HomePages())
 	view.Set("categories_sorting_options", model.CategoriesSortingOptions())
 	view.Set("maxEntriesPerPage", model.MaxEntryLimit)
 	view.Set("countWebAuthnCerts", h.store.CountWebAuthnCredentialsByUserID(user.ID))
 	view.Set("webAuthnCerts", creds)
+	view.Set("twoFactorEnabled", twoFactorEnabled)
 
 	response.HTML(w, r, view.Render("settings"))
 }
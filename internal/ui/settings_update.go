@@ -29,6 +29,12 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	twoFactorEnabled, err := h.store.HasTwoFactorAuthentication(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	settingsForm := form.NewSettingsForm(r)
 
 	view := view.New(h.tpl, r)
@@ -47,10 +53,12 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 	view.Set("default_home_pages", model.HomePages())
 	view.Set("categories_sorting_options", model.CategoriesSortingOptions())
 	view.Set("countWebAuthnCerts", h.store.CountWebAuthnCredentialsByUserID(user.ID))
 	view.Set("webAuthnCerts", creds)
+	view.Set("twoFactorEnabled", twoFactorEnabled)
 
 	if validationErr := settingsForm.Validate(); validationErr != nil {
 		view.Set("errorMessage", validationErr.Translate(user.Language))
@@ -76,6 +84,7 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 		MediaPlaybackRate:      model.OptionalNumber(settingsForm.MediaPlaybackRate),
 		BlockFilterEntryRules:  model.OptionalString(settingsForm.BlockFilterEntryRules),
 		KeepFilterEntryRules:   model.OptionalString(settingsForm.KeepFilterEntryRules),
+		BlockFilterExpression:  model.OptionalString(settingsForm.BlockFilterExpression),
 		ExternalFontHosts:      model.OptionalString(settingsForm.ExternalFontHosts),
 	}
 
@@ -235,6 +235,18 @@ function setStarredButtonState(buttonElement, newState) {
     setIconAndLabelElement(buttonElement, iconType, buttonElement.dataset[newState === "star" ? "labelUnstar" : "labelStar"]);
 }
 
+/**
+ * Set the read later button state.
+ *
+ * @param {Element} buttonElement - The button element to update.
+ * @param {string} newState - The new state to set ("queued" or "unqueued").
+ */
+function setReadLaterButtonState(buttonElement, newState) {
+    buttonElement.dataset.value = newState;
+    const iconType = newState === "queued" ? "unbookmark" : "bookmark";
+    setIconAndLabelElement(buttonElement, iconType, buttonElement.dataset[newState === "queued" ? "labelRemove" : "labelAdd"]);
+}
+
 /**
  * Set the read status button state.
  *
@@ -749,6 +761,92 @@ function handleStarAction(element) {
     });
 }
 
+/**
+ * Handle adding an entry to the read later queue, or removing it.
+ *
+ * @param {Element} element - The element that triggered the read later action.
+ */
+function handleReadLaterAction(element) {
+    const currentEntry = findEntry(element);
+    if (!currentEntry) return;
+
+    const buttonElement = currentEntry.querySelector(":is(a, button)[data-toggle-read-later]");
+    if (!buttonElement) return;
+
+    setButtonToLoadingState(buttonElement);
+
+    sendPOSTRequest(buttonElement.dataset.readLaterUrl).then(() => {
+        const isQueued = buttonElement.dataset.value === "queued";
+
+        setReadLaterButtonState(buttonElement, isQueued ? "unqueued" : "queued");
+
+        if (isEntryView()) {
+            showToastNotification(isQueued ? "bookmark" : "unbookmark", buttonElement.dataset[isQueued ? "toastRemove" : "toastAdd"]);
+        }
+    });
+}
+
+/**
+ * The last text range selected in the entry content, the selection may be gone when the button is clicked on touch devices.
+ *
+ * @type {Range|null}
+ */
+let lastEntryContentSelection = null;
+
+/**
+ * Keep track of the text selected in the entry content.
+ */
+function initializeHighlightHandlers() {
+    const contentElement = document.querySelector(".entry-content-body");
+    if (!contentElement) return;
+
+    document.addEventListener("selectionchange", () => {
+        const selection = document.getSelection();
+        if (!selection || selection.rangeCount === 0 || selection.isCollapsed) return;
+
+        const range = selection.getRangeAt(0);
+        if (contentElement.contains(range.commonAncestorContainer)) {
+            lastEntryContentSelection = range.cloneRange();
+        }
+    });
+}
+
+/**
+ * Handle highlighting the text selected in the entry content.
+ *
+ * The position of the passage is counted in characters from the beginning of the content, like on the server.
+ */
+function handleHighlightAction() {
+    const buttonElement = document.querySelector(":is(a, button)[data-highlight-entry]");
+    const contentElement = document.querySelector(".entry-content-body");
+    if (!buttonElement || !contentElement) return;
+
+    const range = lastEntryContentSelection;
+    if (!range || range.toString().trim() === "") {
+        showToastNotification("highlight", buttonElement.dataset.toastEmpty);
+        return;
+    }
+
+    const precedingRange = document.createRange();
+    precedingRange.setStart(contentElement, 0);
+    precedingRange.setEnd(range.startContainer, range.startOffset);
+
+    const originalButtonElement = setButtonToLoadingState(buttonElement);
+
+    sendPOSTRequest(buttonElement.dataset.highlightUrl, {
+        text: range.toString(),
+        start_offset: Array.from(precedingRange.toString()).length
+    }).then((response) => {
+        if (response.ok) {
+            window.location.reload();
+            return;
+        }
+
+        restoreButtonState(buttonElement, originalButtonElement);
+        showToastNotification("highlight", buttonElement.dataset.toastError);
+    });
+}
+
 /**
  * Handle fetching the original content of an entry.
  *
@@ -898,8 +996,9 @@ function scrollToCurrentItemAction() {
  */
 function updateUnreadCounterValue(delta) {
     document.querySelectorAll("span.unread-counter").forEach((element) => {
-        const oldValue = parseInt(element.textContent, 10);
-        element.textContent = oldValue + delta;
+        const newValue = parseInt(element.textContent, 10) + delta;
+        element.textContent = newValue;
+        element.parentElement.hidden = newValue === 0;
     });
 
     if (window.location.href.endsWith('/unread')) {
@@ -909,6 +1008,93 @@ function updateUnreadCounterValue(delta) {
     }
 }
 
+/**
+ * Set the value of the counters displayed in the navigation menu, the counters equal to zero are hidden.
+ *
+ * @param {string} selector - The selector of the counter elements.
+ * @param {number} value - The new value of the counters.
+ */
+function setCounterValue(selector, value) {
+    document.querySelectorAll(selector).forEach((element) => {
+        element.textContent = value;
+        element.parentElement.hidden = value === 0;
+    });
+}
+
+/**
+ * Listen to the events sent by the server to update the counters without reloading the page.
+ *
+ * The pages of the same browser share a single stream: the page holding the lock opens the stream
+ * and forwards the counters to the other pages, another page takes over when it is closed.
+ * Each page opens its own stream when the browser does not support the Web Locks and Broadcast Channel APIs.
+ */
+function initializeEventStream() {
+    const eventsURL = document.body.dataset.eventsUrl;
+    if (!eventsURL || typeof EventSource !== "function") return;
+
+    const updateCounters = (counters) => {
+        setCounterValue("span.unread-counter", counters.unread);
+        setCounterValue("span.error-feeds-counter", counters.error_feeds);
+
+        if (window.location.href.endsWith('/unread')) {
+            document.title = document.title.replace(/\(\d+\)/, `(${counters.unread})`);
+        }
+    };
+
+    const isShared = "locks" in navigator && typeof BroadcastChannel === "function";
+    const channel = isShared ? new BroadcastChannel("miniflux-event-stream") : null;
+    if (channel) {
+        channel.addEventListener("message", (event) => updateCounters(event.data));
+    }
+
+    // The browser reconnects automatically after a network error.
+    let closeStream = null;
+    const openStream = () => {
+        const eventSource = new EventSource(eventsURL);
+        eventSource.addEventListener("counters", (event) => {
+            const counters = JSON.parse(event.data);
+            updateCounters(counters);
+            if (channel) {
+                channel.postMessage(counters);
+            }
+        });
+        return eventSource;
+    };
+
+    const connect = () => {
+        if (!isShared) {
+            const eventSource = openStream();
+            closeStream = () => eventSource.close();
+            return;
+        }
+
+        // The lock is held until the stream is closed, the request waiting for the lock is aborted when the page is left.
+        const controller = new AbortController();
+        closeStream = () => controller.abort();
+        navigator.locks.request("miniflux-event-stream", { signal: controller.signal }, () => new Promise((resolve) => {
+            const eventSource = openStream();
+            closeStream = () => {
+                eventSource.close();
+                resolve();
+            };
+        })).catch(() => {});
+    };
+
+    // The stream is closed when the page is left and opened again when it is restored from the cache.
+    connect();
+    window.addEventListener("pagehide", () => {
+        if (closeStream) {
+            closeStream();
+            closeStream = null;
+        }
+    });
+    window.addEventListener("pageshow", (event) => {
+        if (event.persisted) {
+            connect();
+        }
+    });
+}
+
 /**
  * Handle confirmation messages for actions that require user confirmation.
  *
@@ -1249,6 +1435,8 @@ function initializeClickHandlers() {
     // Entry actions
     onClick(":is(a, button)[data-save-entry]", (event) => handleSaveEntryAction(event.target));
     onClick(":is(a, button)[data-toggle-starred]", (event) => handleStarAction(event.target));
+    onClick(":is(a, button)[data-toggle-read-later]", (event) => handleReadLaterAction(event.target));
+    onClick(":is(a, button)[data-highlight-entry]", handleHighlightAction);
     onClick(":is(a, button)[data-toggle-status]", (event) => handleEntryStatus("next", event.target));
     onClick(":is(a, button)[data-fetch-content-entry]", handleFetchOriginalContentAction);
     onClick(":is(a, button)[data-share-status]", handleEntryShareAction);
@@ -1290,7 +1478,9 @@ initializeWebAuthn();
 initializeKeyboardShortcuts();
 initializeTouchHandler();
 initializeClickHandlers();
+initializeHighlightHandlers();
 initializeServiceWorker();
+initializeEventStream();
 
 // Reload the page if it was restored from the back-forward cache and mark entries as read is enabled.
 window.addEventListener("pageshow", (event) => {
@@ -162,6 +162,7 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		navMetadata, _ := h.store.GetNavMetadata(user.ID)
 		view.Set("countUnread", navMetadata.CountUnread)
 		view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+		view.Set("savedSearches", navMetadata.SavedSearches)
 		view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyURLConfigured())
 
 		response.HTML(w, r, view.Render("choose_subscription"))
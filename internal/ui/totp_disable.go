@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"log/slog"
+	"net/http"
+
+	"miniflux.app/v2/internal/audit"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+)
+
+func (h *handler) disableTOTP(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	valid, err := verifyTwoFactorCode(h.store, user.ID, r.FormValue("code"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	session := request.WebSession(r)
+	if !valid {
+		session.SetErrorMessage(locale.NewLocalizedError("error.invalid_two_factor_code").Translate(user.Language))
+		response.HTMLRedirect(w, r, h.routePath("/totp"))
+		return
+	}
+
+	if err := h.store.RemoveTOTP(user.ID); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	slog.Info("Two-factor authentication disabled",
+		slog.String("client_ip", request.ClientIP(r)),
+		slog.String("user_agent", r.UserAgent()),
+		slog.Int64("user_id", user.ID),
+	)
+	audit.Record(h.store, r, user, model.AuditLogActionTwoFactorDisabled, audit.UserTarget(user))
+
+	session.SetSuccessMessage(locale.NewPrinter(user.Language).Print("alert.two_factor_disabled"))
+	response.HTMLRedirect(w, r, h.routePath("/settings"))
+}
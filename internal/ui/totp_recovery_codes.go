@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/audit"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/totp"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) regenerateTOTPRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	valid, err := verifyTwoFactorCode(h.store, user.ID, r.FormValue("code"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if !valid {
+		request.WebSession(r).SetErrorMessage(locale.NewLocalizedError("error.invalid_two_factor_code").Translate(user.Language))
+		response.HTMLRedirect(w, r, h.routePath("/totp"))
+		return
+	}
+
+	recoveryCodes := totp.GenerateRecoveryCodes()
+	if err := h.store.ReplaceTOTPRecoveryCodes(user.ID, hashRecoveryCodes(recoveryCodes)); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	audit.Record(h.store, r, user, model.AuditLogActionRecoveryCodesCreated, audit.UserTarget(user))
+
+	h.renderTOTPRecoveryCodesPage(w, r, user, recoveryCodes)
+}
+
+// renderTOTPRecoveryCodesPage shows the recovery codes, only their hashes are stored so they are shown once.
+func (h *handler) renderTOTPRecoveryCodesPage(w http.ResponseWriter, r *http.Request, user *model.User, recoveryCodes []string) {
+	view := view.New(h.tpl, r)
+	view.Set("recoveryCodes", recoveryCodes)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+
+	response.HTML(w, r, view.Render("totp_recovery_codes"))
+}
+
+func hashRecoveryCodes(recoveryCodes []string) []string {
+	hashes := make([]string, len(recoveryCodes))
+	for i, recoveryCode := range recoveryCodes {
+		hashes[i] = totp.HashRecoveryCode(recoveryCode)
+	}
+	return hashes
+}
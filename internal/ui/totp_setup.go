@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"miniflux.app/v2/internal/audit"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/totp"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+const totpIssuer = "Miniflux"
+
+func (h *handler) showTOTPSetupPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	twoFactorEnabled, err := h.store.HasTwoFactorAuthentication(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if twoFactorEnabled {
+		response.HTMLRedirect(w, r, h.routePath("/totp"))
+		return
+	}
+
+	secret := totp.GenerateSecret()
+	if err := h.store.SetPendingTOTPSecret(user.ID, secret); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	h.renderTOTPSetupPage(w, r, user, secret, "")
+}
+
+func (h *handler) confirmTOTPSetup(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	userTOTP, err := h.store.UserTOTP(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if userTOTP == nil || userTOTP.Enabled {
+		response.HTMLRedirect(w, r, h.routePath("/totp"))
+		return
+	}
+
+	step, valid := totp.Validate(userTOTP.Secret, r.FormValue("code"), time.Now(), 0)
+	if !valid {
+		errorMessage := locale.NewLocalizedError("error.invalid_two_factor_code").Translate(user.Language)
+		h.renderTOTPSetupPage(w, r, user, userTOTP.Secret, errorMessage)
+		return
+	}
+
+	recoveryCodes := totp.GenerateRecoveryCodes()
+	if err := h.store.EnableTOTP(user.ID, step, hashRecoveryCodes(recoveryCodes)); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	slog.Info("Two-factor authentication enabled",
+		slog.String("client_ip", request.ClientIP(r)),
+		slog.String("user_agent", r.UserAgent()),
+		slog.Int64("user_id", user.ID),
+	)
+	audit.Record(h.store, r, user, model.AuditLogActionTwoFactorEnabled, audit.UserTarget(user))
+
+	h.renderTOTPRecoveryCodesPage(w, r, user, recoveryCodes)
+}
+
+func (h *handler) renderTOTPSetupPage(w http.ResponseWriter, r *http.Request, user *model.User, secret, errorMessage string) {
+	view := view.New(h.tpl, r)
+	view.Set("secret", secret)
+	view.Set("provisioningURI", totp.ProvisioningURI(totpIssuer, user.Username, secret))
+	view.Set("errorMessage", errorMessage)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
+
+	response.HTML(w, r, view.Render("totp_setup"))
+}
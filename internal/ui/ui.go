@@ -40,6 +40,9 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("POST /subscriptions", handler.showChooseSubscriptionPage)
 	mux.HandleFunc("GET /bookmarklet", handler.bookmarklet)
 
+	// Event stream.
+	mux.HandleFunc("GET /events", handler.streamEvents)
+
 	// Unread page.
 	mux.HandleFunc("POST /mark-all-as-read", handler.markAllAsRead)
 	mux.HandleFunc("GET /unread", handler.showUnreadPage)
@@ -54,10 +57,21 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("GET /starred", handler.showStarredPage)
 	mux.HandleFunc("GET /starred/entry/{entryID}", handler.showStarredEntryPage)
 
+	// Read later pages.
+	mux.HandleFunc("GET /read-later", handler.showReadLaterPage)
+	mux.HandleFunc("GET /read-later/entry/{entryID}", handler.showReadLaterEntryPage)
+	mux.HandleFunc("POST /read-later/{entryID}/move", handler.moveReadLaterEntry)
+
 	// Search pages.
 	mux.HandleFunc("GET /search", handler.showSearchPage)
 	mux.HandleFunc("GET /search/entry/{entryID}", handler.showSearchEntryPage)
 
+	// Saved search pages.
+	mux.HandleFunc("POST /saved-search/save", handler.saveSavedSearch)
+	mux.HandleFunc("GET /saved-search/{savedSearchID}/entries", handler.showSavedSearchEntriesPage)
+	mux.HandleFunc("GET /saved-search/{savedSearchID}/entry/{entryID}", handler.showSavedSearchEntryPage)
+	mux.HandleFunc("POST /saved-search/{savedSearchID}/remove", handler.removeSavedSearch)
+
 	// Feed listing pages.
 	mux.HandleFunc("GET /feeds", handler.showFeedsPage)
 	mux.HandleFunc("POST /feeds/refresh", handler.refreshAllFeeds)
@@ -67,6 +81,7 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("GET /feed/{feedID}/edit", handler.showEditFeedPage)
 	mux.HandleFunc("POST /feed/{feedID}/remove", handler.removeFeed)
 	mux.HandleFunc("POST /feed/{feedID}/update", handler.updateFeed)
+	mux.HandleFunc("POST /feed/{feedID}/filter-preview", handler.previewFeedFilters)
 	mux.HandleFunc("GET /feed/{feedID}/entries", handler.showFeedEntriesPage)
 	mux.HandleFunc("GET /feed/{feedID}/entries/all", handler.showFeedEntriesAllPage)
 	mux.HandleFunc("GET /feed/{feedID}/entry/{entryID}", handler.showFeedEntryPage)
@@ -93,6 +108,7 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("POST /category/{categoryID}/update", handler.updateCategory)
 	mux.HandleFunc("POST /category/{categoryID}/remove", handler.removeCategory)
 	mux.HandleFunc("POST /category/{categoryID}/mark-all-as-read", handler.markCategoryAsRead)
+	mux.HandleFunc("GET /category/{categoryID}/export.epub", handler.exportCategoryEPUB)
 
 	// Tag pages.
 	mux.HandleFunc("GET /tags/{tagName}/entries/all", handler.showTagEntriesAllPage)
@@ -104,6 +120,19 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("POST /entry/enclosure/{enclosureID}/save-progression", handler.saveEnclosureProgression)
 	mux.HandleFunc("POST /entry/download/{entryID}", handler.fetchContent)
 	mux.HandleFunc("POST /entry/star/{entryID}", handler.toggleStarred)
+	mux.HandleFunc("POST /entry/read-later/{entryID}", handler.toggleReadLater)
+	mux.HandleFunc("GET /entry/archive/{entryID}", handler.showEntryArchivePage)
+	mux.HandleFunc("POST /entry/archive/{entryID}", handler.createEntryArchive)
+	mux.HandleFunc("GET /entry/archive/{entryID}/export.html", handler.exportEntryArchiveHTML)
+	mux.HandleFunc("GET /entry/archive/{entryID}/export.epub", handler.exportEntryArchiveEPUB)
+	mux.HandleFunc("GET /entry/revisions/{entryID}", handler.showEntryRevisionsPage)
+	mux.HandleFunc("POST /entry/highlights/{entryID}", handler.createHighlight)
+
+	// Highlight pages.
+	mux.HandleFunc("GET /highlights", handler.showHighlightsPage)
+	mux.HandleFunc("GET /highlights/{highlightID}/edit", handler.showEditHighlightPage)
+	mux.HandleFunc("POST /highlights/{highlightID}/update", handler.updateHighlight)
+	mux.HandleFunc("POST /highlights/{highlightID}/remove", handler.removeHighlight)
 
 	// Media proxy.
 	mux.HandleFunc("GET /proxy/{encodedDigest}/{encodedURL}", handler.mediaProxy)
@@ -114,8 +143,18 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("GET /share/{shareCode}", handler.sharedEntry)
 	mux.HandleFunc("GET /shares", handler.sharedEntries)
 
+	// Published feeds.
+	mux.HandleFunc("GET /published-feeds", handler.showPublishedFeedsPage)
+	mux.HandleFunc("GET /published-feeds/create", handler.showCreatePublishedFeedPage)
+	mux.HandleFunc("POST /published-feeds/save", handler.savePublishedFeed)
+	mux.HandleFunc("POST /published-feeds/{publishedFeedID}/remove", handler.removePublishedFeed)
+	mux.HandleFunc("GET /publish/{token}/{format}", handler.showPublishedFeed)
+
 	// User pages.
 	mux.HandleFunc("GET /users", handler.showUsersPage)
+	mux.HandleFunc("GET /audit-log", handler.showAuditLogPage)
+	mux.HandleFunc("GET /login-lockouts", handler.showLoginLockoutsPage)
+	mux.HandleFunc("POST /login-lockouts/unlock", handler.unlockLogin)
 	mux.HandleFunc("GET /user/create", handler.showCreateUserPage)
 	mux.HandleFunc("POST /user/save", handler.saveUser)
 	mux.HandleFunc("GET /users/{userID}/edit", handler.showEditUserPage)
@@ -129,6 +168,13 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("POST /integration", handler.updateIntegration)
 	mux.HandleFunc("GET /about", handler.showAboutPage)
 
+	// Two-factor authentication pages.
+	mux.HandleFunc("GET /totp", handler.showTOTPPage)
+	mux.HandleFunc("GET /totp/setup", handler.showTOTPSetupPage)
+	mux.HandleFunc("POST /totp/setup", handler.confirmTOTPSetup)
+	mux.HandleFunc("POST /totp/recovery-codes", handler.regenerateTOTPRecoveryCodes)
+	mux.HandleFunc("POST /totp/disable", handler.disableTOTP)
+
 	// Session pages.
 	mux.HandleFunc("GET /sessions", handler.showSessionsPage)
 	mux.HandleFunc("POST /sessions/{sessionID}/remove", handler.removeSession)
@@ -159,6 +205,8 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 
 	// Authentication pages.
 	mux.HandleFunc("POST /login", handler.checkLogin)
+	mux.HandleFunc("GET /login/totp", handler.showTOTPLoginPage)
+	mux.HandleFunc("POST /login/totp", handler.checkTOTPLogin)
 	mux.HandleFunc("POST /logout", handler.logout)
 	mux.Handle("GET /{$}", authProxyMiddleware.handle(http.HandlerFunc(handler.showLoginPage)))
 
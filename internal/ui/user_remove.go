@@ -7,8 +7,10 @@ import (
 	"errors"
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
 )
 
 func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
@@ -45,5 +47,7 @@ func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	audit.Record(h.store, r, loggedUser, model.AuditLogActionUserDeleted, audit.UserTarget(selectedUser))
+
 	response.HTMLRedirect(w, r, h.routePath("/users"))
 }
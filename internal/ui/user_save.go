@@ -6,6 +6,7 @@ package ui // import "miniflux.app/v2/internal/ui"
 import (
 	"net/http"
 
+	"miniflux.app/v2/internal/audit"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/locale"
@@ -35,6 +36,7 @@ func (h *handler) saveUser(w http.ResponseWriter, r *http.Request) {
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
 	view.Set("countUnread", navMetadata.CountUnread)
 	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("savedSearches", navMetadata.SavedSearches)
 	view.Set("form", userForm)
 
 	if validationErr := userForm.ValidateCreation(); validationErr != nil {
@@ -61,10 +63,13 @@ func (h *handler) saveUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if _, err := h.store.CreateUser(userCreationRequest); err != nil {
+	newUser, err := h.store.CreateUser(userCreationRequest)
+	if err != nil {
 		response.HTMLServerError(w, r, err)
 		return
 	}
 
+	audit.Record(h.store, r, user, model.AuditLogActionUserCreated, audit.UserTarget(newUser))
+
 	response.HTMLRedirect(w, r, h.routePath("/users"))
 }
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"strings"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ValidateActionRuleCreation validates action rule creation.
+func ValidateActionRuleCreation(store *storage.Storage, userID int64, request *model.ActionRuleCreationRequest) *locale.LocalizedError {
+	return validateActionRule(store, userID, request.Expression, request.Action, request.LabelID)
+}
+
+// ValidateActionRuleModification validates action rule modification.
+func ValidateActionRuleModification(store *storage.Storage, userID int64, rule *model.ActionRule, request *model.ActionRuleModificationRequest) *locale.LocalizedError {
+	expression := rule.Expression
+	if request.Expression != nil {
+		expression = *request.Expression
+	}
+
+	action := rule.Action
+	if request.Action != nil {
+		action = *request.Action
+	}
+
+	labelID := rule.LabelID
+	if request.LabelID != nil {
+		labelID = *request.LabelID
+	}
+
+	return validateActionRule(store, userID, expression, action, labelID)
+}
+
+func validateActionRule(store *storage.Storage, userID int64, expression, action string, labelID int64) *locale.LocalizedError {
+	if strings.TrimSpace(expression) == "" {
+		return locale.NewLocalizedError("error.action_rule_expression_required")
+	}
+
+	if err := IsValidFilterExpression(expression); err != nil {
+		return err
+	}
+
+	switch action {
+	case model.ActionRuleMarkAsRead, model.ActionRuleStar, model.ActionRuleSendEntry, model.ActionRuleSetPriority:
+	case model.ActionRuleAddLabel:
+		if exists, _ := store.LabelIDsExist(userID, []int64{labelID}); !exists {
+			return locale.NewLocalizedError("error.label_not_found")
+		}
+	default:
+		return locale.NewLocalizedError("error.invalid_action_rule_action")
+	}
+
+	return nil
+}
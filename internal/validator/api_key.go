@@ -4,6 +4,9 @@
 package validator // import "miniflux.app/v2/internal/validator"
 
 import (
+	"slices"
+	"time"
+
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
@@ -15,9 +18,35 @@ func ValidateAPIKeyCreation(store *storage.Storage, userID int64, request *model
 		return locale.NewLocalizedError("error.fields_mandatory")
 	}
 
+	if err := validateAPIKeyScopes(request.Scopes); err != nil {
+		return err
+	}
+
+	if err := validateAPIKeyExpiration(request.ExpiresAt); err != nil {
+		return err
+	}
+
 	if store.APIKeyExists(userID, request.Description) {
 		return locale.NewLocalizedError("error.api_key_already_exists")
 	}
 
 	return nil
 }
+
+func validateAPIKeyScopes(scopes []string) *locale.LocalizedError {
+	for i, scope := range scopes {
+		if !slices.Contains(model.APIKeyScopes, scope) || slices.Contains(scopes[:i], scope) {
+			return locale.NewLocalizedError("error.api_key_invalid_scope", scope)
+		}
+	}
+
+	return nil
+}
+
+func validateAPIKeyExpiration(expiresAt *time.Time) *locale.LocalizedError {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return locale.NewLocalizedError("error.api_key_expiration_in_past")
+	}
+
+	return nil
+}
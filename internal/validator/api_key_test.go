@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateAPIKeyScopes(t *testing.T) {
+	scenarios := []struct {
+		scopes []string
+		valid  bool
+	}{
+		{nil, true},
+		{[]string{model.APIKeyScopeReadOnly}, true},
+		{[]string{model.APIKeyScopeEntriesWrite, model.APIKeyScopeFeedsAdmin, model.APIKeyScopeIntegrations}, true},
+		{[]string{"admin"}, false},
+		{[]string{model.APIKeyScopeEntriesWrite, model.APIKeyScopeEntriesWrite}, false},
+	}
+
+	for _, scenario := range scenarios {
+		if err := validateAPIKeyScopes(scenario.scopes); (err == nil) != scenario.valid {
+			t.Errorf(`Unexpected validation result for %v: %v`, scenario.scopes, err)
+		}
+	}
+}
+
+func TestValidateAPIKeyExpiration(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(24 * time.Hour)
+
+	if err := validateAPIKeyExpiration(nil); err != nil {
+		t.Errorf(`An API key without expiration date should be valid: %v`, err)
+	}
+
+	if err := validateAPIKeyExpiration(&future); err != nil {
+		t.Errorf(`An expiration date in the future should be valid: %v`, err)
+	}
+
+	if err := validateAPIKeyExpiration(&past); err == nil {
+		t.Error(`An expiration date in the past should be rejected`)
+	}
+}
@@ -4,9 +4,12 @@
 package validator // import "miniflux.app/v2/internal/validator"
 
 import (
+	"slices"
+
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/urllib"
 )
 
 // ValidateCategoryCreation validates category creation.
@@ -19,7 +22,15 @@ func ValidateCategoryCreation(store *storage.Storage, userID int64, request *mod
 		return locale.NewLocalizedError("error.category_already_exists")
 	}
 
-	return nil
+	if err := validateCategoryParent(store, userID, 0, request.ParentID); err != nil {
+		return err
+	}
+
+	if err := IsValidFilterExpression(request.BlockFilterExpression); err != nil {
+		return err
+	}
+
+	return validateFeedDefaults(&request.FeedDefaults)
 }
 
 // ValidateCategoryModification validates category modification.
@@ -34,5 +45,66 @@ func ValidateCategoryModification(store *storage.Storage, userID, categoryID int
 		}
 	}
 
+	if request.ParentID != nil {
+		if err := validateCategoryParent(store, userID, categoryID, *request.ParentID); err != nil {
+			return err
+		}
+	}
+
+	if request.BlockFilterExpression != nil {
+		if err := IsValidFilterExpression(*request.BlockFilterExpression); err != nil {
+			return err
+		}
+	}
+
+	if request.FeedDefaults != nil {
+		if err := validateFeedDefaults(request.FeedDefaults); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCategoryParent checks that the parent exists and is not the category itself or one of its subcategories.
+// The category ID is zero for new categories.
+func validateCategoryParent(store *storage.Storage, userID, categoryID, parentID int64) *locale.LocalizedError {
+	if parentID == 0 {
+		return nil
+	}
+
+	if parentID < 0 {
+		return locale.NewLocalizedError("error.category_not_found")
+	}
+
+	if categoryExists, _ := store.CategoryIDExists(userID, parentID); !categoryExists {
+		return locale.NewLocalizedError("error.category_not_found")
+	}
+
+	if categoryID == 0 {
+		return nil
+	}
+
+	subtreeIDs, err := store.CategorySubtreeIDs(userID, categoryID)
+	if err != nil {
+		return locale.NewLocalizedError("error.database_error", err)
+	}
+
+	if slices.Contains(subtreeIDs, parentID) {
+		return locale.NewLocalizedError("error.invalid_parent_category")
+	}
+
+	return nil
+}
+
+func validateFeedDefaults(feedDefaults *model.FeedDefaults) *locale.LocalizedError {
+	if err := validateFeedFilters(&feedDefaults.BlocklistRules, &feedDefaults.KeeplistRules, &feedDefaults.BlockFilterEntryRules, &feedDefaults.KeepFilterEntryRules, nil); err != nil {
+		return err
+	}
+
+	if feedDefaults.ProxyURL != "" && !urllib.IsValidProxyURL(feedDefaults.ProxyURL) {
+		return locale.NewLocalizedError("error.invalid_feed_proxy_url")
+	}
+
 	return nil
 }
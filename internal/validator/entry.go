@@ -51,11 +51,11 @@ func ValidateEntryStatus(status string) error {
 // ValidateEntryOrder makes sure the sorting order is valid.
 func ValidateEntryOrder(order string) error {
 	switch order {
-	case "id", "status", "changed_at", "published_at", "created_at", "category_title", "category_id", "title", "author":
+	case "id", "status", "changed_at", "published_at", "created_at", "category_title", "category_id", "title", "author", "priority":
 		return nil
 	}
 
-	return errors.New(`invalid entry order, valid order values are: "id", "status", "changed_at", "published_at", "created_at", "category_title", "category_id", "title", "author"`)
+	return errors.New(`invalid entry order, valid order values are: "id", "status", "changed_at", "published_at", "created_at", "category_title", "category_id", "title", "author", "priority"`)
 }
 
 // ValidateEntryModification makes sure the entry modification is valid.
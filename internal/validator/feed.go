@@ -5,6 +5,7 @@ package validator // import "miniflux.app/v2/internal/validator"
 
 import (
 	"log/slog"
+	"slices"
 
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
@@ -66,6 +67,10 @@ func ValidateFeedCreation(store *storage.Storage, userID int64, request *model.F
 		}
 	}
 
+	if err := IsValidFilterExpression(request.BlockFilterExpression); err != nil {
+		return err
+	}
+
 	if request.ProxyURL != "" && !urllib.IsValidProxyURL(request.ProxyURL) {
 		return locale.NewLocalizedError("error.invalid_feed_proxy_url")
 	}
@@ -121,33 +126,64 @@ func ValidateFeedModification(store *storage.Storage, userID, feedID int64, requ
 		}
 	}
 
-	if request.BlocklistRules != nil {
-		if !IsValidRegex(*request.BlocklistRules) {
+	if err := validateFeedFilters(request.BlocklistRules, request.KeeplistRules, request.BlockFilterEntryRules, request.KeepFilterEntryRules, request.BlockFilterExpression); err != nil {
+		return err
+	}
+
+	if request.ProxyURL != nil && *request.ProxyURL != "" {
+		if !urllib.IsValidProxyURL(*request.ProxyURL) {
+			return locale.NewLocalizedError("error.invalid_feed_proxy_url")
+		}
+	}
+
+	if request.OverriddenSettings != nil {
+		for _, setting := range *request.OverriddenSettings {
+			if !slices.Contains(model.FeedDefaultSettings, setting) {
+				return locale.NewLocalizedError("error.invalid_overridden_feed_setting", setting)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateFilterPreview validates the filter settings of a preview request.
+func ValidateFilterPreview(request *model.FilterPreviewRequest) *locale.LocalizedError {
+	if request.Limit < 0 || request.Limit > model.MaxFilterPreviewLimit {
+		return locale.NewLocalizedError("error.filter_preview_limit_invalid", model.MaxFilterPreviewLimit)
+	}
+
+	return validateFeedFilters(request.BlocklistRules, request.KeeplistRules, request.BlockFilterEntryRules, request.KeepFilterEntryRules, request.BlockFilterExpression)
+}
+
+func validateFeedFilters(blocklistRules, keeplistRules, blockFilterEntryRules, keepFilterEntryRules, blockFilterExpression *string) *locale.LocalizedError {
+	if blocklistRules != nil {
+		if !IsValidRegex(*blocklistRules) {
 			return locale.NewLocalizedError("error.feed_invalid_blocklist_rule")
 		}
 	}
 
-	if request.KeeplistRules != nil {
-		if !IsValidRegex(*request.KeeplistRules) {
+	if keeplistRules != nil {
+		if !IsValidRegex(*keeplistRules) {
 			return locale.NewLocalizedError("error.feed_invalid_keeplist_rule")
 		}
 	}
 
-	if request.BlockFilterEntryRules != nil && *request.BlockFilterEntryRules != "" {
-		if err := IsValidFilterRules(*request.BlockFilterEntryRules, "block"); err != nil {
+	if blockFilterEntryRules != nil && *blockFilterEntryRules != "" {
+		if err := IsValidFilterRules(*blockFilterEntryRules, "block"); err != nil {
 			return err
 		}
 	}
 
-	if request.KeepFilterEntryRules != nil && *request.KeepFilterEntryRules != "" {
-		if err := IsValidFilterRules(*request.KeepFilterEntryRules, "keep"); err != nil {
+	if keepFilterEntryRules != nil && *keepFilterEntryRules != "" {
+		if err := IsValidFilterRules(*keepFilterEntryRules, "keep"); err != nil {
 			return err
 		}
 	}
 
-	if request.ProxyURL != nil && *request.ProxyURL != "" {
-		if !urllib.IsValidProxyURL(*request.ProxyURL) {
-			return locale.NewLocalizedError("error.invalid_feed_proxy_url")
+	if blockFilterExpression != nil {
+		if err := IsValidFilterExpression(*blockFilterExpression); err != nil {
+			return err
 		}
 	}
 
@@ -41,3 +41,36 @@ func TestValidateFeedModificationProxyURL(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFeedModificationOverriddenSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings []string
+		wantErr  bool
+	}{
+		{
+			name:     "no overridden settings",
+			settings: []string{},
+			wantErr:  false,
+		},
+		{
+			name:     "valid overridden settings",
+			settings: []string{"crawler", "user_agent"},
+			wantErr:  false,
+		},
+		{
+			name:     "unknown overridden setting",
+			settings: []string{"title"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := &model.FeedModificationRequest{OverriddenSettings: &tc.settings}
+			if err := ValidateFeedModification(nil, 0, 0, request); (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
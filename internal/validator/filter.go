@@ -4,12 +4,28 @@
 package validator // import "miniflux.app/v2/internal/validator"
 
 import (
+	"errors"
 	"slices"
 	"strings"
 
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/reader/filter"
 )
 
+// IsValidFilterExpression checks the syntax of a filter expression.
+// The returned error includes the position of the first problem.
+func IsValidFilterExpression(expression string) *locale.LocalizedError {
+	if _, err := filter.ParseExpression(expression); err != nil {
+		var expressionErr *filter.ExpressionError
+		if errors.As(err, &expressionErr) {
+			return locale.NewLocalizedError("error.invalid_filter_expression", expressionErr.Position, expressionErr.Message)
+		}
+		return locale.NewLocalizedError("error.invalid_filter_expression", 0, err.Error())
+	}
+
+	return nil
+}
+
 func IsValidFilterRules(filterEntryRules string, filterType string) *locale.LocalizedError {
 	// Valid Format: FieldName=RegEx\nFieldName=RegEx...
 	fieldNames := []string{"EntryTitle", "EntryURL", "EntryCommentsURL", "EntryContent", "EntryAuthor", "EntryTag", "EntryDate"}
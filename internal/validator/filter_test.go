@@ -3,7 +3,10 @@
 
 package validator // import "miniflux.app/v2/internal/validator"
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestIsValidFilterRules(t *testing.T) {
 	tests := []struct {
@@ -53,3 +56,22 @@ func TestIsValidFilterRules(t *testing.T) {
 		})
 	}
 }
+
+func TestIsValidFilterExpression(t *testing.T) {
+	if err := IsValidFilterExpression(""); err != nil {
+		t.Fatalf("An empty expression should be valid, got %v", err)
+	}
+
+	if err := IsValidFilterExpression(`title ~ "rust" and not author == "bot" and age < 7d`); err != nil {
+		t.Fatalf("The expression should be valid, got %v", err)
+	}
+
+	err := IsValidFilterExpression(`title ~ "rust" and age < 7y`)
+	if err == nil {
+		t.Fatal("An invalid duration should be rejected")
+	}
+
+	if !strings.Contains(err.String(), "position 26") {
+		t.Fatalf("The error should contain the position, got %q", err.String())
+	}
+}
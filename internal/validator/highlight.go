@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"errors"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// ValidateHighlightCreation validates the passage and the position of a new highlight.
+func ValidateHighlightCreation(request *model.HighlightCreationRequest) error {
+	if strings.TrimSpace(request.Text) == "" {
+		return errors.New(`the highlighted text cannot be empty`)
+	}
+
+	if request.StartOffset < 0 {
+		return errors.New(`the start offset must be greater than or equal to 0`)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateHighlightCreation(t *testing.T) {
+	if err := ValidateHighlightCreation(&model.HighlightCreationRequest{Text: "Some text", StartOffset: 12}); err != nil {
+		t.Error(`A valid request should not be rejected`)
+	}
+
+	if err := ValidateHighlightCreation(&model.HighlightCreationRequest{Text: " \n "}); err == nil {
+		t.Error(`An empty text is not valid`)
+	}
+
+	if err := ValidateHighlightCreation(&model.HighlightCreationRequest{Text: "Some text", StartOffset: -1}); err == nil {
+		t.Error(`A negative offset is not valid`)
+	}
+}
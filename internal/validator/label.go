@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"errors"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ValidateLabelCreation validates label creation.
+func ValidateLabelCreation(store *storage.Storage, userID int64, request *model.LabelCreationRequest) *locale.LocalizedError {
+	if request.Title == "" {
+		return locale.NewLocalizedError("error.title_required")
+	}
+
+	if store.LabelTitleExists(userID, request.Title) {
+		return locale.NewLocalizedError("error.label_already_exists")
+	}
+
+	return nil
+}
+
+// ValidateLabelModification validates label modification.
+func ValidateLabelModification(store *storage.Storage, userID, labelID int64, request *model.LabelModificationRequest) *locale.LocalizedError {
+	if request.Title != nil {
+		if *request.Title == "" {
+			return locale.NewLocalizedError("error.title_required")
+		}
+
+		if store.AnotherLabelExists(userID, labelID, *request.Title) {
+			return locale.NewLocalizedError("error.label_already_exists")
+		}
+	}
+
+	return nil
+}
+
+// ValidateEntryLabelsUpdateRequest validates the replacement of the labels of an entry.
+// The list of labels is mandatory, an empty list removes all the labels of the entry.
+func ValidateEntryLabelsUpdateRequest(request *model.EntryLabelsUpdateRequest) error {
+	if request.LabelIDs == nil {
+		return errors.New(`the list of labels is required, use an empty list to remove all the labels`)
+	}
+
+	return nil
+}
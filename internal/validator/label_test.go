@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"encoding/json"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateEntryLabelsUpdateRequest(t *testing.T) {
+	scenarios := []struct {
+		body  string
+		valid bool
+	}{
+		{`{"label_ids": [1, 2]}`, true},
+		{`{"label_ids": []}`, true},
+		{`{"label_ids": null}`, false},
+		{`{}`, false},
+	}
+
+	for _, scenario := range scenarios {
+		var request model.EntryLabelsUpdateRequest
+		if err := json.Unmarshal([]byte(scenario.body), &request); err != nil {
+			t.Fatalf(`Unable to decode %s: %v`, scenario.body, err)
+		}
+
+		err := ValidateEntryLabelsUpdateRequest(&request)
+		if scenario.valid && err != nil {
+			t.Errorf(`The request %s should be valid, got %v`, scenario.body, err)
+		}
+		if !scenario.valid && err == nil {
+			t.Errorf(`The request %s should not be valid`, scenario.body)
+		}
+	}
+}
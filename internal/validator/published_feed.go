@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ValidatePublishedFeedCreation ensures the category, the label or the tag required by the kind of feed is valid.
+func ValidatePublishedFeedCreation(store *storage.Storage, userID int64, request *model.PublishedFeedCreationRequest) *locale.LocalizedError {
+	switch request.Kind {
+	case model.PublishedFeedKindStarred, model.PublishedFeedKindShared:
+	case model.PublishedFeedKindCategory:
+		if request.CategoryID == 0 {
+			return locale.NewLocalizedError("error.category_not_found")
+		}
+
+		if exists, _ := store.CategoryIDExists(userID, request.CategoryID); !exists {
+			return locale.NewLocalizedError("error.category_not_found")
+		}
+	case model.PublishedFeedKindLabel:
+		if request.LabelID == 0 {
+			return locale.NewLocalizedError("error.label_not_found")
+		}
+
+		if exists, _ := store.LabelIDsExist(userID, []int64{request.LabelID}); !exists {
+			return locale.NewLocalizedError("error.label_not_found")
+		}
+	case model.PublishedFeedKindTag:
+		if request.Tag == "" {
+			return locale.NewLocalizedError("error.published_feed_tag_required")
+		}
+	default:
+		return locale.NewLocalizedError("error.published_feed_kind_invalid")
+	}
+
+	return nil
+}
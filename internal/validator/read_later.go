@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"errors"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// ValidateReadLaterAdditionRequest validates the entries added to the read later queue.
+func ValidateReadLaterAdditionRequest(request *model.ReadLaterAdditionRequest) error {
+	if len(request.EntryIDs) == 0 {
+		return errors.New(`the list of entries cannot be empty`)
+	}
+
+	return nil
+}
+
+// ValidateReadLaterMoveRequest validates the new position of an entry in the read later queue.
+func ValidateReadLaterMoveRequest(request *model.ReadLaterMoveRequest) error {
+	if request.Position < 1 {
+		return errors.New(`the position must be greater than or equal to 1`)
+	}
+
+	return nil
+}
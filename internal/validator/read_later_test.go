@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateReadLaterAdditionRequest(t *testing.T) {
+	if err := ValidateReadLaterAdditionRequest(&model.ReadLaterAdditionRequest{EntryIDs: []int64{123}}); err != nil {
+		t.Error(`A valid request should not be rejected`)
+	}
+
+	if err := ValidateReadLaterAdditionRequest(&model.ReadLaterAdditionRequest{}); err == nil {
+		t.Error(`An empty list of entries is not valid`)
+	}
+}
+
+func TestValidateReadLaterMoveRequest(t *testing.T) {
+	if err := ValidateReadLaterMoveRequest(&model.ReadLaterMoveRequest{Position: 1}); err != nil {
+		t.Error(`The first position should be accepted`)
+	}
+
+	if err := ValidateReadLaterMoveRequest(&model.ReadLaterMoveRequest{Position: 0}); err == nil {
+		t.Error(`The positions start at 1`)
+	}
+}
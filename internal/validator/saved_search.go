@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ValidateSavedSearchCreation validates saved search creation.
+func ValidateSavedSearchCreation(store *storage.Storage, userID int64, request *model.SavedSearchCreationRequest) *locale.LocalizedError {
+	if request.Title == "" {
+		return locale.NewLocalizedError("error.title_required")
+	}
+
+	if store.SavedSearchTitleExists(userID, request.Title) {
+		return locale.NewLocalizedError("error.saved_search_already_exists")
+	}
+
+	if err := ValidateSearchQuery(request.Query); err != nil {
+		return err
+	}
+
+	return validateSavedSearchConstraints(store, userID, request.Status, request.CategoryID, request.FeedID, request.MaxAgeDays)
+}
+
+// ValidateSavedSearchModification validates saved search modification.
+func ValidateSavedSearchModification(store *storage.Storage, userID int64, savedSearch *model.SavedSearch, request *model.SavedSearchModificationRequest) *locale.LocalizedError {
+	if request.Title != nil {
+		if *request.Title == "" {
+			return locale.NewLocalizedError("error.title_required")
+		}
+
+		if store.AnotherSavedSearchExists(userID, savedSearch.ID, *request.Title) {
+			return locale.NewLocalizedError("error.saved_search_already_exists")
+		}
+	}
+
+	if request.Query != nil {
+		if err := ValidateSearchQuery(*request.Query); err != nil {
+			return err
+		}
+	}
+
+	status := savedSearch.Status
+	if request.Status != nil {
+		status = *request.Status
+	}
+
+	var categoryID, feedID int64
+	if request.CategoryID != nil {
+		categoryID = *request.CategoryID
+	}
+
+	if request.FeedID != nil {
+		feedID = *request.FeedID
+	}
+
+	maxAgeDays := savedSearch.MaxAgeDays
+	if request.MaxAgeDays != nil {
+		maxAgeDays = *request.MaxAgeDays
+	}
+
+	return validateSavedSearchConstraints(store, userID, status, categoryID, feedID, maxAgeDays)
+}
+
+func validateSavedSearchConstraints(store *storage.Storage, userID int64, status string, categoryID, feedID int64, maxAgeDays int) *locale.LocalizedError {
+	if status != "" && ValidateEntryStatus(status) != nil {
+		return locale.NewLocalizedError("error.invalid_entry_status")
+	}
+
+	if maxAgeDays < 0 {
+		return locale.NewLocalizedError("error.saved_search_max_age_invalid")
+	}
+
+	if categoryID != 0 {
+		if exists, _ := store.CategoryIDExists(userID, categoryID); !exists {
+			return locale.NewLocalizedError("error.category_not_found")
+		}
+	}
+
+	if feedID != 0 {
+		if exists, _ := store.FeedExists(userID, feedID); !exists {
+			return locale.NewLocalizedError("error.feed_not_found")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/searchquery"
+)
+
+// ValidateSearchQuery makes sure the search query can be parsed.
+func ValidateSearchQuery(query string) *locale.LocalizedError {
+	if _, err := searchquery.Parse(query); err != nil {
+		return locale.NewLocalizedError("error.invalid_search_query", err)
+	}
+
+	return nil
+}
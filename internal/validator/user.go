@@ -144,6 +144,12 @@ func ValidateUserModification(store *storage.Storage, userID int64, changes *mod
 		}
 	}
 
+	if changes.BlockFilterExpression != nil {
+		if err := IsValidFilterExpression(*changes.BlockFilterExpression); err != nil {
+			return err
+		}
+	}
+
 	if changes.ExternalFontHosts != nil {
 		if !IsValidDomainList(*changes.ExternalFontHosts) {
 			return locale.NewLocalizedError("error.settings_invalid_domain_list")
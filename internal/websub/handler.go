@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package websub // import "miniflux.app/v2/internal/websub"
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	feedHandler "miniflux.app/v2/internal/reader/handler"
+	"miniflux.app/v2/internal/storage"
+)
+
+// NewHandler returns the handler of the callback URLs given to the hubs.
+func NewHandler(store *storage.Storage) http.Handler {
+	h := &handler{store: store}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /websub/{token}", h.verifyIntent)
+	mux.HandleFunc("POST /websub/{token}", h.receiveContent)
+
+	return mux
+}
+
+type handler struct {
+	store *storage.Storage
+}
+
+// verifyIntent answers the verification requests sent by the hub when it receives a subscription,
+// and records the subscriptions denied by the hub.
+func (h *handler) verifyIntent(w http.ResponseWriter, r *http.Request) {
+	subscription, err := h.store.WebSubSubscriptionByToken(request.RouteStringParam(r, "token"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if subscription == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	query := r.URL.Query()
+	switch query.Get("hub.mode") {
+	case "subscribe":
+		challenge := query.Get("hub.challenge")
+		if query.Get("hub.topic") != subscription.TopicURL || challenge == "" {
+			response.HTMLNotFound(w, r)
+			return
+		}
+
+		var leaseExpiresAt *time.Time
+		if leaseSeconds, err := strconv.Atoi(query.Get("hub.lease_seconds")); err == nil && leaseSeconds > 0 {
+			expiresAt := time.Now().Add(time.Duration(leaseSeconds) * time.Second)
+			leaseExpiresAt = &expiresAt
+		}
+
+		if err := h.store.ConfirmWebSubSubscription(subscription.FeedID, leaseExpiresAt); err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		slog.Debug("WebSub subscription verified",
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("hub_url", subscription.HubURL),
+			slog.Any("lease_expires_at", leaseExpiresAt),
+		)
+
+		response.Text(w, r, challenge)
+	case "denied":
+		if err := h.store.DenyWebSubSubscription(subscription.FeedID); err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		slog.Warn("WebSub subscription denied by the hub",
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("hub_url", subscription.HubURL),
+			slog.String("reason", query.Get("hub.reason")),
+		)
+
+		response.NoContent(w, r)
+	default:
+		// The subscription is still used, other requests like unsubscriptions are refused.
+		response.HTMLNotFound(w, r)
+	}
+}
+
+// receiveContent processes the feed content pushed by the hub.
+// The content with an invalid signature is acknowledged but ignored, as required by the specification.
+func (h *handler) receiveContent(w http.ResponseWriter, r *http.Request) {
+	subscription, err := h.store.WebSubSubscriptionByToken(request.RouteStringParam(r, "token"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if subscription == nil || subscription.State != model.WebSubStateSubscribed {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, config.Opts.HTTPClientMaxBodySize()))
+	if err != nil {
+		response.HTMLBadRequest(w, r, err)
+		return
+	}
+
+	if !validSignature(r.Header.Get("X-Hub-Signature"), subscription.Secret, body) {
+		slog.Warn("Ignoring WebSub content with an invalid signature",
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("hub_url", subscription.HubURL),
+		)
+		response.NoContent(w, r)
+		return
+	}
+
+	if err := feedHandler.ProcessPushedFeed(h.store, subscription.UserID, subscription.FeedID, body); err != nil {
+		slog.Warn("Unable to process the content pushed by the WebSub hub",
+			slog.Int64("user_id", subscription.UserID),
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("hub_url", subscription.HubURL),
+			slog.Any("error", err),
+		)
+		response.NoContent(w, r)
+		return
+	}
+
+	// The feed is polled again when the hub stays silent longer than the fallback interval.
+	if err := h.store.RecordWebSubPush(subscription.FeedID, time.Now().Add(config.Opts.WebSubFallbackPollingInterval())); err != nil {
+		slog.Error("Unable to record the WebSub push",
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.Any("error", err),
+		)
+	}
+
+	response.NoContent(w, r)
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package websub // import "miniflux.app/v2/internal/websub"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"strings"
+)
+
+// validSignature checks the X-Hub-Signature header of the content pushed by the hub,
+// the header contains the hash function and the HMAC of the body computed with the secret of the subscription.
+func validSignature(header, secret string, body []byte) bool {
+	method, signature, found := strings.Cut(strings.TrimSpace(header), "=")
+	if !found {
+		return false
+	}
+
+	var hashFunc func() hash.Hash
+	switch strings.ToLower(method) {
+	case "sha1":
+		hashFunc = sha1.New
+	case "sha256":
+		hashFunc = sha256.New
+	case "sha384":
+		hashFunc = sha512.New384
+	case "sha512":
+		hashFunc = sha512.New
+	default:
+		return false
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(hashFunc, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package websub // import "miniflux.app/v2/internal/websub"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+func sign(hashFunc func() hash.Hash, secret string, body []byte) string {
+	mac := hmac.New(hashFunc, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidSignature(t *testing.T) {
+	secret := "secret"
+	body := []byte(`<feed xmlns="http://www.w3.org/2005/Atom"></feed>`)
+
+	scenarios := []struct {
+		header   string
+		expected bool
+	}{
+		{"sha1=" + sign(sha1.New, secret, body), true},
+		{"sha256=" + sign(sha256.New, secret, body), true},
+		{"sha384=" + sign(sha512.New384, secret, body), true},
+		{"sha512=" + sign(sha512.New, secret, body), true},
+		{"SHA256=" + sign(sha256.New, secret, body), true},
+		{"sha256=" + sign(sha256.New, "other secret", body), false},
+		{"sha256=" + sign(sha256.New, secret, []byte("other body")), false},
+		{"sha1=" + sign(sha256.New, secret, body), false},
+		{"md5=" + sign(sha256.New, secret, body), false},
+		{"sha256=not-hexadecimal", false},
+		{sign(sha256.New, secret, body), false},
+		{"", false},
+	}
+
+	for _, scenario := range scenarios {
+		if result := validSignature(scenario.header, secret, body); result != scenario.expected {
+			t.Errorf(`Unexpected result for header %q: got %v instead of %v`, scenario.header, result, scenario.expected)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package websub subscribes the feeds to the WebSub hubs they announce, and receives the content pushed by the hubs.
+// https://www.w3.org/TR/websub/
+package websub // import "miniflux.app/v2/internal/websub"
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/client"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// leaseDuration is the duration of the subscriptions requested to the hubs, they may grant another duration.
+const leaseDuration = 10 * 24 * time.Hour
+
+// retryDelay is the delay before requesting again a subscription denied or not verified by the hub.
+const retryDelay = 24 * time.Hour
+
+// CallbackURL returns the URL receiving the verification requests and the content pushed by the hub.
+func CallbackURL(subscription *model.WebSubSubscription) string {
+	return config.Opts.BaseURL() + "/websub/" + subscription.Token
+}
+
+// Subscribe asks the hub to push the updates of the topic to the callback URL of the subscription.
+// The hub verifies the intent of the subscriber before it starts pushing.
+func Subscribe(subscription *model.WebSubSubscription) error {
+	values := url.Values{}
+	values.Set("hub.mode", "subscribe")
+	values.Set("hub.topic", subscription.TopicURL)
+	values.Set("hub.callback", CallbackURL(subscription))
+	values.Set("hub.secret", subscription.Secret)
+	values.Set("hub.lease_seconds", strconv.Itoa(int(leaseDuration.Seconds())))
+
+	request, err := http.NewRequest(http.MethodPost, subscription.HubURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return fmt.Errorf("websub: unable to create request: %w", err)
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("User-Agent", config.Opts.HTTPClientUserAgent())
+
+	httpClient := client.NewClientWithOptions(client.Options{
+		Timeout:              config.Opts.HTTPClientTimeout(),
+		BlockPrivateNetworks: !config.Opts.FetcherAllowPrivateNetworks(),
+	})
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("websub: unable to send subscription request to %q: %w", subscription.HubURL, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("websub: hub %q refused the subscription with status code %d", subscription.HubURL, response.StatusCode)
+	}
+
+	return nil
+}
+
+// RequestSubscriptions sends the subscriptions never requested, expiring before the next run of the job,
+// or not verified by the hub since the retry delay.
+func RequestSubscriptions(store *storage.Storage, frequency time.Duration, batchSize int) {
+	now := time.Now()
+	subscriptions, err := store.WebSubSubscriptionsToRequest(now.Add(2*frequency), now.Add(-retryDelay), batchSize)
+	if err != nil {
+		slog.Error("Unable to fetch the WebSub subscriptions to request", slog.Any("error", err))
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		// The hub may verify the intent before answering the request, the request is recorded first.
+		if err := store.MarkWebSubSubscriptionRequested(subscription.FeedID); err != nil {
+			slog.Error("Unable to update the WebSub subscription", slog.Int64("feed_id", subscription.FeedID), slog.Any("error", err))
+			continue
+		}
+
+		if err := Subscribe(subscription); err != nil {
+			slog.Warn("Unable to subscribe to the WebSub hub",
+				slog.Int64("user_id", subscription.UserID),
+				slog.Int64("feed_id", subscription.FeedID),
+				slog.String("hub_url", subscription.HubURL),
+				slog.String("topic_url", subscription.TopicURL),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		slog.Debug("Subscription sent to the WebSub hub",
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("hub_url", subscription.HubURL),
+			slog.String("topic_url", subscription.TopicURL),
+		)
+	}
+}
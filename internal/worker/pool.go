@@ -12,18 +12,19 @@ import (
 
 // Pool manages a set of background workers that process feed refresh jobs.
 type Pool struct {
-	queue        chan model.Job
+	queue        chan model.JobList
 	shutdown     chan struct{}
 	shutdownOnce sync.Once
 	wg           sync.WaitGroup
 }
 
 // Push sends a list of jobs to the queue.
+// The jobs of the same feed URL are processed together by a worker, so the subscribers share a single request.
 // Jobs pushed after Shutdown are discarded.
 func (p *Pool) Push(jobs model.JobList) {
-	for _, job := range jobs {
+	for _, group := range jobs.GroupByFeedURL() {
 		select {
-		case p.queue <- job:
+		case p.queue <- group:
 		case <-p.shutdown:
 			return
 		}
@@ -41,7 +42,7 @@ func (p *Pool) Shutdown() {
 // NewPool creates a pool of background workers.
 func NewPool(store *storage.Storage, nbWorkers int) *Pool {
 	workerPool := &Pool{
-		queue:    make(chan model.Job),
+		queue:    make(chan model.JobList),
 		shutdown: make(chan struct{}),
 	}
 
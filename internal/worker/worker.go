@@ -21,7 +21,7 @@ type worker struct {
 }
 
 // Run processes feed refresh jobs from the channel until the pool is shut down.
-func (w *worker) Run(c <-chan model.Job, shutdown <-chan struct{}, wg *sync.WaitGroup) {
+func (w *worker) Run(c <-chan model.JobList, shutdown <-chan struct{}, wg *sync.WaitGroup) {
 	defer wg.Done()
 
 	slog.Debug("Worker started",
@@ -29,29 +29,33 @@ func (w *worker) Run(c <-chan model.Job, shutdown <-chan struct{}, wg *sync.Wait
 	)
 
 	for {
-		var job model.Job
+		var jobs model.JobList
 		select {
 		case <-shutdown:
 			return
-		case job = <-c:
+		case jobs = <-c:
 		}
 
-		slog.Debug("Job received by worker",
-			slog.Int("worker_id", w.id),
-			slog.Int64("user_id", job.UserID),
-			slog.Int64("feed_id", job.FeedID),
-			slog.String("feed_url", job.FeedURL),
-		)
-
-		startTime := time.Now()
-		localizedError := feedHandler.RefreshFeed(w.store, job.UserID, job.FeedID, false)
-
-		if config.Opts.HasMetricsCollector() {
-			status := metric.StatusSuccess
-			if localizedError != nil {
-				status = metric.StatusError
+		// The response is fetched once for the subscribers of the same feed.
+		fetchGroup := feedHandler.NewFetchGroup()
+		for _, job := range jobs {
+			slog.Debug("Job received by worker",
+				slog.Int("worker_id", w.id),
+				slog.Int64("user_id", job.UserID),
+				slog.Int64("feed_id", job.FeedID),
+				slog.String("feed_url", job.FeedURL),
+			)
+
+			startTime := time.Now()
+			localizedError := fetchGroup.RefreshFeed(w.store, job.UserID, job.FeedID)
+
+			if config.Opts.HasMetricsCollector() {
+				status := metric.StatusSuccess
+				if localizedError != nil {
+					status = metric.StatusError
+				}
+				metric.BackgroundFeedRefreshDuration.WithLabelValues(status).Observe(time.Since(startTime).Seconds())
 			}
-			metric.BackgroundFeedRefreshDuration.WithLabelValues(status).Observe(time.Since(startTime).Seconds())
 		}
 	}
 }